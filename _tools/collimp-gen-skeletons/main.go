@@ -45,18 +45,21 @@ var (
 func main() {
 	const (
 		srcImpLib = `
-func libs_%s(xn *xmlx.Node) {
+func (me *importState) libs_%s(xn *xmlx.Node) {
 	var (
 		lib *cdom.Lib%sDefs
 		def *cdom.%sDef
 		id  string
 	)
+	if me.bag.SkipLibs["%s"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_%s") {
 		id = xas(ln, "id")
-		if lib = cdom.All%sDefLibs[id]; lib == nil {
-			lib = cdom.All%sDefLibs.AddNew(id)
+		if lib = me.reg.%sDefLibs[id]; lib == nil {
+			lib = me.reg.%sDefLibs.AddNew(id)
 		}
-		for _, def = range objs_%sDef(ln, "%s") {
+		for _, def = range me.objs_%sDef(ln, "%s") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -66,36 +69,36 @@ func libs_%s(xn *xmlx.Node) {
 }
 `
 		srcImpObj = `
-func obj_%s(xn *xmlx.Node, n string) (obj *cdom.%s) {
+func (me *importState) obj_%s(xn *xmlx.Node, n string) (obj *cdom.%s) {
 	if (xn != nil) && (len(n) > 0) {
 		xn = xcn(xn, n)
 	}
 	if xn != nil {
-		obj = init_%s(xn)
+		obj = me.init_%s(xn)
 	}
 	return
 }
 `
 		srcImpInitCtor = `
-func init_%s(xn *xmlx.Node) (obj *cdom.%s) {
+func (me *importState) init_%s(xn *xmlx.Node) (obj *cdom.%s) {
 	obj = cdom.New%s()
 `
 		srcImpInitNew = `
-func init_%s(xn *xmlx.Node) (obj *cdom.%s) {
+func (me *importState) init_%s(xn *xmlx.Node) (obj *cdom.%s) {
 	obj = new(cdom.%s)
 `
 		srcImpN = `
-func objs_%s(xn *xmlx.Node, n string) (objs []*cdom.%s) {
+func (me *importState) objs_%s(xn *xmlx.Node, n string) (objs []*cdom.%s) {
 	xns := xcns(xn, n)
 	objs = make([]*cdom.%s, len(xns))
 	for i, xn := range xns {
-		objs[i] = obj_%s(xn, "")
+		objs[i] = me.obj_%s(xn, "")
 	}
 	return
 }
 `
 		srcLoad = `
-func load_%s(xn *xmlx.Node, obj *cdom.%s) {
+func (me *importState) load_%s(xn *xmlx.Node, obj *cdom.%s) {
 
 }
 `
@@ -106,10 +109,10 @@ func load_%s(xn *xmlx.Node, obj *cdom.%s) {
 		srcLibs, srcInits, srcObjs, srcLoads string
 		ctorFunc                             reflect.Value
 	)
-	has := []string{"Asset", "Extras", "FxParamDefs", "ID", "Inputs", "Name", "ParamDefs", "ParamInsts", "Sid", "Sources", "Techniques"}
+	has := []string{"Asset", "Extras", "FxParamDefs", "Id", "Inputs", "Name", "ParamDefs", "ParamInsts", "Sid", "Sources", "Techniques"}
 	flag.Parse()
 	for n, t := range cdr.Types {
-		if canDirty = false; !(strings.HasPrefix(n, "Lib") || strings.HasPrefix(n, "Mesh") || strings.HasPrefix(n, "Base") || strings.HasSuffix(n, "Base") || strings.HasPrefix(n, "Has") || strings.HasPrefix(n, "Ref")) {
+		if canDirty = false; !(strings.HasPrefix(n, "Lib") || strings.HasPrefix(n, "Mesh") || strings.HasPrefix(n, "Base") || strings.HasSuffix(n, "Base") || strings.HasPrefix(n, "Has") || strings.HasPrefix(n, "Ref") || (n == "Registry")) {
 			srcObjs += fmt.Sprintf(srcImpObj, n, n, n)
 			if ctorFunc, ok = cdr.Functions["New"+n]; ok && ctorFunc.Type().NumIn() == 0 {
 				srcInits += fmt.Sprintf(srcImpInitCtor, n, n, n)
@@ -127,16 +130,16 @@ func load_%s(xn *xmlx.Node, obj *cdom.%s) {
 						srcInits += "\tobj.Init()\n"
 					}
 					if strings.HasSuffix(n, "Inst") {
-						srcInits += "\tsetInstDefRef(xn, &obj.BaseInst)\n"
+						srcInits += "\tme.setInstDefRef(xn, &obj.BaseInst)\n"
 					}
 				}
 				for _, h := range has {
 					if _, ok = t.FieldByName("Has" + h); ok {
-						srcInits += fmt.Sprintf("\thas_%s(xn, &obj.Has%s)\n", h, h)
+						srcInits += fmt.Sprintf("\tme.has_%s(xn, &obj.Has%s)\n", h, h)
 					}
 				}
 			}
-			srcInits += fmt.Sprintf("\n\tload_%s(xn, obj)", n)
+			srcInits += fmt.Sprintf("\n\tme.load_%s(xn, obj)", n)
 			if canDirty {
 				srcInits += "\n\tobj.SetDirty()"
 			}
@@ -146,12 +149,17 @@ func load_%s(xn *xmlx.Node, obj *cdom.%s) {
 		}
 	}
 	for _, lm := range libs {
-		//	animations Animation Animation animations Animation Animation Animation animation
-		srcLibs += fmt.Sprintf(srcImpLib, lm.xnPlural, lm.tn, lm.tn, lm.xnPlural, lm.tn, lm.tn, lm.tn, lm.xnSingular)
+		//	animations Animation Animation animations animations Animation Animation Animation animation
+		srcLibs += fmt.Sprintf(srcImpLib, lm.xnPlural, lm.tn, lm.tn, lm.xnPlural, lm.xnPlural, lm.tn, lm.tn, lm.tn, lm.xnSingular)
+	}
+	srcLibs += "\nfunc (me *importState) libs_All(xn *xmlx.Node) {\n"
+	for _, lm := range libs {
+		srcLibs += fmt.Sprintf("\tme.libs_%s(xn)\n", lm.xnPlural)
 	}
-	srcLibs += "\nfunc libs_All (xn *xmlx.Node) {\n"
+	srcLibs += "}\n"
+	srcLibs += "\nvar libsByKind = map[string]func(*importState, *xmlx.Node){\n"
 	for _, lm := range libs {
-		srcLibs += fmt.Sprintf("\tlibs_%s(xn)\n", lm.xnPlural)
+		srcLibs += fmt.Sprintf("\t%q: (*importState).libs_%s,\n", lm.xnPlural, lm.xnPlural)
 	}
 	srcLibs += "}\n"
 	ufs.WriteTextFile(filepath.Join(*outDirPath, "-skel-libs.txt"), srcLibs)
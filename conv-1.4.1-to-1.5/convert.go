@@ -15,6 +15,8 @@ import (
 
 const ns = "http://www.collada.org/2005/11/COLLADASchema"
 
+//	The package-level defaults for all fields of newly created Options instances:
+//	these are used by NewOptions() and hence by the ConvertBytes() and ConvertDoc() functions.
 var (
 	//	If true, conversion-logic is always run against the given input document's entire node tree;
 	//	if false, conversion is only performed if the input "COLLADA" root element's "version" attribute is not "1.5" or higher.
@@ -41,16 +43,54 @@ var (
 	//	If lax, obsoleted elements and attributes are not removed, for use-cases where your 1.5 loader consuming the conversion result is known to simply ignore or discard them quietly.
 	//	Note in practice there won't be any noticeable difference in performance or output for approximately 95% of "common use-case" Collada documents...
 	Strict = true
+)
+
+//	Provides options for converting Collada 1.4.1 documents. Every conversion carries its own
+//	Options, so any number of conversions may run concurrently with differing settings.
+//	For a description of each field, see the package-level variable of the same name.
+type Options struct {
+	Force                  bool
+	HexFormat              string
+	Log                    func(format string, fmtArgs ...interface{})
+	ShaderCompilerPlatform string
+	Strict                 bool
+}
+
+//	Initializes and returns a newly created Options instance,
+//	with all fields set to the current values of the corresponding package-level variables.
+func NewOptions() (me *Options) {
+	me = &Options{Force: Force, HexFormat: HexFormat, Log: Log, ShaderCompilerPlatform: ShaderCompilerPlatform, Strict: Strict}
+	return
+}
+
+//	Converts the specified Collada 1.4.1 document to Collada 1.5, using the conversion options in me.
+func (me *Options) ConvertBytes(srcFile []byte) (dstFile []byte, err error) {
+	_, dstFile, err = me.convert(srcFile, false, true)
+	return
+}
 
-	skipped       = false
+//	Converts the specified Collada 1.4.1 document to Collada 1.5, using the conversion options in me.
+func (me *Options) ConvertDoc(srcFile []byte) (doc *xmlx.Document, err error) {
+	doc, _, err = me.convert(srcFile, true, false)
+	return
+}
+
+func (me *Options) convert(srcFile []byte, retDoc, retBytes bool) (doc *xmlx.Document, dstFile []byte, err error) {
+	conv := &converter{opt: *me}
+	return conv.convert(srcFile, retDoc, retBytes)
+}
+
+type converter struct {
+	opt           Options
+	skipped       bool
 	srcDoc        *xmlx.Document
 	surfaceNodes  []*xmlx.Node
 	surfaceImages map[string]string
-)
+}
 
-func logFmt(format string, fmtArgs ...interface{}) {
-	if Log != nil {
-		Log(format, fmtArgs...)
+func (me *converter) logFmt(format string, fmtArgs ...interface{}) {
+	if me.opt.Log != nil {
+		me.opt.Log(format, fmtArgs...)
 	}
 }
 
@@ -72,22 +112,22 @@ func attValU64(xn *xmlx.Node, name string) (val uint64) {
 	return
 }
 
-func convert(srcFile []byte, retDoc, retBytes bool) (doc *xmlx.Document, dstFile []byte, err error) {
-	doc, surfaceImages, surfaceNodes, skipped = xmlx.New(), map[string]string{}, nil, false
+func (me *converter) convert(srcFile []byte, retDoc, retBytes bool) (doc *xmlx.Document, dstFile []byte, err error) {
+	doc, me.surfaceImages, me.surfaceNodes, me.skipped = xmlx.New(), map[string]string{}, nil, false
 	if err = doc.LoadBytes(srcFile, nil); err != nil {
 		doc = nil
 		return
 	}
-	srcDoc = doc
-	processNode(doc.Root)
-	srcDoc = nil
-	if skipped {
+	me.srcDoc = doc
+	me.processNode(doc.Root)
+	me.srcDoc = nil
+	if me.skipped {
 		if retBytes {
 			dstFile = srcFile
 		}
 	} else {
-		for _, sn := range surfaceNodes {
-			delNode(sn.Parent)
+		for _, sn := range me.surfaceNodes {
+			me.delNode(sn.Parent)
 		}
 		if retBytes {
 			dstFile = doc.SaveBytes()
@@ -96,23 +136,22 @@ func convert(srcFile []byte, retDoc, retBytes bool) (doc *xmlx.Document, dstFile
 	if !retDoc {
 		doc = nil
 	}
-	surfaceImages, surfaceNodes = nil, nil
 	return
 }
 
-//	Converts the specified Collada 1.4.1 document to Collada 1.5.
+//	Converts the specified Collada 1.4.1 document to Collada 1.5,
+//	using the conversion options in the package-level variables.
 func ConvertBytes(srcFile []byte) (dstFile []byte, err error) {
-	_, dstFile, err = convert(srcFile, false, true)
-	return
+	return NewOptions().ConvertBytes(srcFile)
 }
 
-//	Converts the specified Collada 1.4.1 document to Collada 1.5.
+//	Converts the specified Collada 1.4.1 document to Collada 1.5,
+//	using the conversion options in the package-level variables.
 func ConvertDoc(srcFile []byte) (doc *xmlx.Document, err error) {
-	doc, _, err = convert(srcFile, true, false)
-	return
+	return NewOptions().ConvertDoc(srcFile)
 }
 
-func convertImage(xn *xmlx.Node) {
+func (me *converter) convertImage(xn *xmlx.Node) {
 	hexFormat := attVal(xn, "format")
 	imgHeight := attValU64(xn, "height")
 	imgWidth := attValU64(xn, "width")
@@ -120,19 +159,19 @@ func convertImage(xn *xmlx.Node) {
 	if imgDepth == 0 {
 		imgDepth = 1
 	}
-	delAtts(xn, "height", "width", "depth", "format")
+	me.delAtts(xn, "height", "width", "depth", "format")
 	if len(hexFormat) == 0 {
-		hexFormat = HexFormat
+		hexFormat = me.opt.HexFormat
 	}
 	hexData, refUrl, initNode, hexNode := "", "", subNode(xn, "init_from"), subNode(xn, "data")
 	if initNode != nil {
 		refUrl = initNode.Value
-		delNodeForce(initNode, true)
+		me.delNodeForce(initNode, true)
 		initNode = nil
 	}
 	if hexNode != nil {
 		hexData = hexNode.Value
-		delNodeForce(hexNode, true)
+		me.delNodeForce(hexNode, true)
 		hexNode = nil
 	}
 	if (len(refUrl) > 0) || (len(hexData) > 0) {
@@ -143,7 +182,7 @@ func convertImage(xn *xmlx.Node) {
 		}
 		if len(hexData) > 0 {
 			hex := ensureChild(initNode, "hex")
-			setAttr(hex, "format", hexFormat, false)
+			me.setAttr(hex, "format", hexFormat, false)
 			hex.Value = hexData
 			hexData = ""
 		}
@@ -159,8 +198,8 @@ func convertImage(xn *xmlx.Node) {
 			cn.Name.Local = "create_cube"
 		}
 		sn := ensureChild(cn, "size_exact")
-		setAttr(sn, "width", fmt.Sprintf("%v", imgWidth), false)
-		setAttr(sn, "height", fmt.Sprintf("%v", imgHeight), false)
+		me.setAttr(sn, "width", fmt.Sprintf("%v", imgWidth), false)
+		me.setAttr(sn, "height", fmt.Sprintf("%v", imgHeight), false)
 		if initNode != nil {
 			cn.AddChild(initNode)
 		}
@@ -169,55 +208,55 @@ func convertImage(xn *xmlx.Node) {
 		xn.AddChild(initNode)
 	}
 	if oldParent := xn.Parent; oldParent.Name.Local != "library_images" {
-		logFmt("!!MOVE image!!; this may be BUGGY, please report your use-case at GitHub Issues for this package!!\n")
+		me.logFmt("!!MOVE image!!; this may be BUGGY, please report your use-case at GitHub Issues for this package!!\n")
 		id := attVal(xn, "id")
 		if len(id) == 0 {
 			id = fmt.Sprintf("img_moved_%v", time.Now().UnixNano())
 		}
-		if _, pos := moveNode(xn, nil, "library_images"); pos >= 0 {
+		if _, pos := me.moveNode(xn, nil, "library_images"); pos >= 0 {
 			xn = xmlx.NewNode(xn.Type)
 			xn.Name.Local = "instance_image"
-			setAttr(xn, "url", "#"+id, false)
+			me.setAttr(xn, "url", "#"+id, false)
 			xn.Parent = oldParent
 			oldParent.Children[pos] = xn
 		}
 	}
 }
 
-func convertShader(xn *xmlx.Node) {
+func (me *converter) convertShader(xn *xmlx.Node) {
 	var tn, sn *xmlx.Node
 	if av, tmp := attVal(xn, "stage"), "PROGRAM"; strings.HasSuffix(av, tmp) {
-		setAttr(xn, "stage", strings.Replace(av, tmp, "", -1), false)
+		me.setAttr(xn, "stage", strings.Replace(av, tmp, "", -1), false)
 	}
 	for _, tn = range subNodes(xn, "bind") {
-		renameNode(tn, "bind_uniform")
+		me.renameNode(tn, "bind_uniform")
 	}
 	for _, tn = range subNodes(xn, "annotate") {
-		delNode(tn)
+		me.delNode(tn)
 	}
 	if tn = subNode(xn, "compiler_target"); tn != nil {
 		sn = ensureChild(xn, "compiler")
-		setAttr(sn, "target", tn.Value, false)
-		setAttr(sn, "platform", ShaderCompilerPlatform, false)
-		delNode(tn)
+		me.setAttr(sn, "target", tn.Value, false)
+		me.setAttr(sn, "platform", me.opt.ShaderCompilerPlatform, false)
+		me.delNode(tn)
 	}
 	if tn = subNode(xn, "compiler_options"); tn != nil {
 		sn = ensureChild(xn, "compiler")
-		setAttr(sn, "options", tn.Value, false)
-		setAttr(sn, "platform", ShaderCompilerPlatform, false)
-		delNode(tn)
+		me.setAttr(sn, "options", tn.Value, false)
+		me.setAttr(sn, "platform", me.opt.ShaderCompilerPlatform, false)
+		me.delNode(tn)
 	}
 	if tn = subNode(xn, "name"); tn != nil {
 		sn = ensureChild(xn, "sources")
-		setAttr(sn, "entry", tn.Value, false)
+		me.setAttr(sn, "entry", tn.Value, false)
 		if src := attVal(tn, "source"); len(src) > 0 {
-			setAttr(ensureChild(sn, "import"), "ref", src, false)
+			me.setAttr(ensureChild(sn, "import"), "ref", src, false)
 		}
-		delNode(tn)
+		me.delNode(tn)
 	}
 }
 
-func convertSurface(xn *xmlx.Node) {
+func (me *converter) convertSurface(xn *xmlx.Node) {
 	var (
 		imgNode, imgCreateNode, imgCreateFormatNode, rn, sn, tn *xmlx.Node
 		ensureCreateNode                                        = func() *xmlx.Node {
@@ -243,7 +282,7 @@ func convertSurface(xn *xmlx.Node) {
 			return imgCreateFormatNode
 		}
 	)
-	surfaceNodes = append(surfaceNodes, xn)
+	me.surfaceNodes = append(me.surfaceNodes, xn)
 	myID, imgID, initNode := attVal(xn.Parent, "sid"), "", subNode(xn, "init_as_target")
 	if len(myID) == 0 {
 		myID = attVal(xn.Parent, "ref")
@@ -253,10 +292,10 @@ func convertSurface(xn *xmlx.Node) {
 			imgID = fmt.Sprintf("img_target_%v", time.Now().UnixNano())
 			imgNode, rn = xmlx.NewNode(xn.Type), xmlx.NewNode(xn.Type)
 			imgNode.Name.Local, rn.Name.Local = "image", "renderable"
-			setAttr(imgNode, "id", imgID, false)
-			setAttr(rn, "share", "true", false)
+			me.setAttr(imgNode, "id", imgID, false)
+			me.setAttr(rn, "share", "true", false)
 			imgNode.AddChild(rn)
-			moveNode(imgNode, nil, "library_images")
+			me.moveNode(imgNode, nil, "library_images")
 		} else if initNode = subNode(xn, "init_from"); initNode != nil {
 			imgID = initNode.Value
 		} else {
@@ -275,9 +314,9 @@ func convertSurface(xn *xmlx.Node) {
 			}
 		}
 		if imgID = ustr.StripPrefix(imgID, "#"); len(imgID) > 0 {
-			surfaceImages[myID] = imgID
+			me.surfaceImages[myID] = imgID
 			if imgNode == nil {
-				for _, sn = range subNode(srcDoc.Root.Children[0], "library_images").Children {
+				for _, sn = range subNode(me.srcDoc.Root.Children[0], "library_images").Children {
 					if attVal(sn, "id") == imgID {
 						imgNode = sn
 						break
@@ -292,49 +331,49 @@ func convertSurface(xn *xmlx.Node) {
 			if tn = subNode(xn, "format_hint"); tn != nil {
 				rn = subNode(ensureCreateFormatNode(false, true), "hint")
 				if sn = subNode(tn, "channels"); sn != nil {
-					setAttr(rn, "channels", sn.Value, false)
+					me.setAttr(rn, "channels", sn.Value, false)
 				}
 				if sn = subNode(tn, "range"); sn != nil {
-					setAttr(rn, "range", sn.Value, false)
+					me.setAttr(rn, "range", sn.Value, false)
 				}
 				if sn = subNode(tn, "precision"); sn != nil {
-					setAttr(rn, "precision", sn.Value, false)
+					me.setAttr(rn, "precision", sn.Value, false)
 				}
 				if sn = subNode(tn, "option"); sn != nil {
-					setAttr(rn, "space", sn.Value, false)
+					me.setAttr(rn, "space", sn.Value, false)
 				}
 			}
 			if tn = subNode(xn, "size"); tn != nil {
 				vals := ustr.Split(tn.Value, " ")
 				rn = ensureChild(ensureCreateNode(), "size_exact")
 				if len(vals) > 0 {
-					setAttr(rn, "width", vals[0], false)
+					me.setAttr(rn, "width", vals[0], false)
 				}
 				if len(vals) > 1 {
-					setAttr(rn, "height", vals[1], false)
+					me.setAttr(rn, "height", vals[1], false)
 				}
 				if len(vals) > 2 {
-					setAttr(rn, "depth", vals[2], false)
+					me.setAttr(rn, "depth", vals[2], false)
 				}
 			}
 			if tn = subNode(xn, "viewport_ratio"); tn != nil {
 				vals := ustr.Split(tn.Value, " ")
 				rn = ensureChild(ensureCreateNode(), "size_ratio")
-				setAttr(rn, "width", vals[0], false)
-				setAttr(rn, "height", vals[1], false)
+				me.setAttr(rn, "width", vals[0], false)
+				me.setAttr(rn, "height", vals[1], false)
 			}
 			if tn = subNode(xn, "mip_levels"); tn != nil {
-				setAttr(ensureChild(ensureCreateNode(), "mips"), "levels", tn.Value, false)
+				me.setAttr(ensureChild(ensureCreateNode(), "mips"), "levels", tn.Value, false)
 			}
 			if tn = subNode(xn, "mipmap_generate"); tn != nil {
-				setAttr(ensureChild(ensureCreateNode(), "mips"), "auto_generate", tn.Value, false)
+				me.setAttr(ensureChild(ensureCreateNode(), "mips"), "auto_generate", tn.Value, false)
 			}
 		}
 	}
 }
 
-func delAttr(xn *xmlx.Node, name string) {
-	if Strict {
+func (me *converter) delAttr(xn *xmlx.Node, name string) {
+	if me.opt.Strict {
 		pos := -1
 		for i, att := range xn.Attributes {
 			if att.Name.Local == name {
@@ -343,25 +382,25 @@ func delAttr(xn *xmlx.Node, name string) {
 			}
 		}
 		if pos >= 0 {
-			logFmt("\t\tdelAttr %s.%s\n", xn.Name.Local, name)
+			me.logFmt("\t\tdelAttr %s.%s\n", xn.Name.Local, name)
 			nuAtts := append(xn.Attributes[:pos], xn.Attributes[pos+1:]...)
 			xn.Attributes = nuAtts
 		}
 	}
 }
 
-func delAtts(xn *xmlx.Node, names ...string) {
+func (me *converter) delAtts(xn *xmlx.Node, names ...string) {
 	for _, name := range names {
-		delAttr(xn, name)
+		me.delAttr(xn, name)
 	}
 }
 
-func delNode(xn *xmlx.Node) {
-	delNodeForce(xn, false)
+func (me *converter) delNode(xn *xmlx.Node) {
+	me.delNodeForce(xn, false)
 }
 
-func delNodeForce(xn *xmlx.Node, force bool) {
-	if (Strict || force) && (xn != nil) {
+func (me *converter) delNodeForce(xn *xmlx.Node, force bool) {
+	if (me.opt.Strict || force) && (xn != nil) {
 		pos := -1
 		for i, sn := range xn.Parent.Children {
 			if sn == xn {
@@ -370,7 +409,7 @@ func delNodeForce(xn *xmlx.Node, force bool) {
 			}
 		}
 		if pos >= 0 {
-			logFmt("\t\tdelNode %s>%s = '%s'\n", xn.Parent.Name.Local, xn.Name.Local, xn.Value)
+			me.logFmt("\t\tdelNode %s>%s = '%s'\n", xn.Parent.Name.Local, xn.Name.Local, xn.Value)
 			nuNodes := append(xn.Parent.Children[:pos], xn.Parent.Children[pos+1:]...)
 			xn.Parent.Children = nuNodes
 		}
@@ -398,9 +437,9 @@ func ensureSiblings(xn *xmlx.Node, namesVals map[string]string) {
 	}
 }
 
-func moveNode(xn, parent *xmlx.Node, parentName string) (newParent *xmlx.Node, oldPos int) {
+func (me *converter) moveNode(xn, parent *xmlx.Node, parentName string) (newParent *xmlx.Node, oldPos int) {
 	oldPos = -1
-	if root := srcDoc.Root.Children[0]; parent == nil {
+	if root := me.srcDoc.Root.Children[0]; parent == nil {
 		if parent = subNode(root, parentName); parent == nil {
 			parent = xmlx.NewNode(xn.Type)
 			parent.Name.Local = parentName
@@ -427,18 +466,18 @@ func moveNode(xn, parent *xmlx.Node, parentName string) (newParent *xmlx.Node, o
 	return
 }
 
-func renameAttr(xn *xmlx.Node, name, newName string) {
+func (me *converter) renameAttr(xn *xmlx.Node, name, newName string) {
 	for _, att := range xn.Attributes {
 		if att.Name.Local == name {
-			logFmt("\t\trenameAttr %s.%s => %s.%s\n", xn.Name.Local, name, xn.Name.Local, newName)
+			me.logFmt("\t\trenameAttr %s.%s => %s.%s\n", xn.Name.Local, name, xn.Name.Local, newName)
 			att.Name.Local = newName
 			break
 		}
 	}
 }
 
-func renameNode(xn *xmlx.Node, newName string) {
-	logFmt("\t\trenameNode %s => %s", xn.Name.Local, newName)
+func (me *converter) renameNode(xn *xmlx.Node, newName string) {
+	me.logFmt("\t\trenameNode %s => %s", xn.Name.Local, newName)
 	xn.Name.Local = newName
 }
 
@@ -457,12 +496,12 @@ func replaceNode(xn, nn *xmlx.Node) {
 	}
 }
 
-func restrictAttr(xn *xmlx.Node, name string, min, max int64) {
-	if Strict {
+func (me *converter) restrictAttr(xn *xmlx.Node, name string, min, max int64) {
+	if me.opt.Strict {
 		for _, att := range xn.Attributes {
 			if att.Name.Local == name {
 				if val, err := strconv.ParseInt(att.Value, 10, 64); err == nil {
-					logFmt("\t\trestrictAttr %s.%s\n", xn.Name.Local, name)
+					me.logFmt("\t\trestrictAttr %s.%s\n", xn.Name.Local, name)
 					if val < min {
 						att.Value = strconv.FormatInt(min, 10)
 					}
@@ -476,17 +515,17 @@ func restrictAttr(xn *xmlx.Node, name string, min, max int64) {
 	}
 }
 
-func setAttr(xn *xmlx.Node, name, value string, onlySetIfEmpty bool) {
+func (me *converter) setAttr(xn *xmlx.Node, name, value string, onlySetIfEmpty bool) {
 	for _, att := range xn.Attributes {
 		if att.Name.Local == name {
 			if (!onlySetIfEmpty) || (len(att.Value) == 0) {
-				logFmt("\t\tsetAttr %s.%s = %s\n", xn.Name.Local, name, value)
+				me.logFmt("\t\tsetAttr %s.%s = %s\n", xn.Name.Local, name, value)
 				att.Value = value
 			}
 			return
 		}
 	}
-	logFmt("\t\taddAttr %s.%s = %s\n", xn.Name.Local, name, value)
+	me.logFmt("\t\taddAttr %s.%s = %s\n", xn.Name.Local, name, value)
 	att := &xmlx.Attr{Value: value}
 	att.Name.Local = name
 	xn.Attributes = append(xn.Attributes, att)
@@ -510,10 +549,10 @@ func subNodes(xn *xmlx.Node, name string) (sn []*xmlx.Node) {
 	return
 }
 
-func processNode(xn *xmlx.Node) {
-	if (!Force) && (xn.Name.Local == "COLLADA") {
+func (me *converter) processNode(xn *xmlx.Node) {
+	if (!me.opt.Force) && (xn.Name.Local == "COLLADA") {
 		if _, ver := umisc.ParseVersion(attVal(xn, "version")); ver >= 1.5 {
-			skipped = true
+			me.skipped = true
 			return
 		}
 	}
@@ -523,45 +562,45 @@ func processNode(xn *xmlx.Node) {
 	}
 	switch xn.Name.Local {
 	case "COLLADA":
-		setAttr(xn, "version", "1.5", false)
-		setAttr(xn, "xmlns", "http://www.collada.org/2008/03/COLLADASchema", false)
+		me.setAttr(xn, "version", "1.5", false)
+		me.setAttr(xn, "xmlns", "http://www.collada.org/2008/03/COLLADASchema", false)
 	case "array":
 		if !ustr.IsOneOf(xn.Parent.Name.Local, "array", "newparam", "setparam") {
-			delNode(xn)
+			me.delNode(xn)
 		}
 	case "argument", "texenv":
-		delAttr(xn, "unit")
+		me.delAttr(xn, "unit")
 	case "cg_value_type", "connect_param", "generator", "tapered_capsule", "tapered_cylinder", "texture_unit":
-		delNode(xn)
+		me.delNode(xn)
 	case "code", "include":
 		if !ustr.IsOneOf(xn.Parent.Name.Local, "profile_CG", "profile_GLES2", "profile_GLSL") {
-			delNode(xn)
+			me.delNode(xn)
 		}
 	case "color_target", "depth_target", "stencil_target":
-		if val := surfaceImages[xn.Value]; len(val) == 0 {
-			setAttr(ensureChild(xn, "param"), "ref", xn.Value, false)
+		if val := me.surfaceImages[xn.Value]; len(val) == 0 {
+			me.setAttr(ensureChild(xn, "param"), "ref", xn.Value, false)
 		} else {
-			setAttr(ensureChild(xn, "instance_image"), "url", "#"+val, false)
+			me.setAttr(ensureChild(xn, "instance_image"), "url", "#"+val, false)
 		}
 		xn.Value = ""
 	case "float_array":
-		restrictAttr(xn, "digits", 1, 17)
-		restrictAttr(xn, "magnitude", -324, 308)
+		me.restrictAttr(xn, "digits", 1, 17)
+		me.restrictAttr(xn, "magnitude", -324, 308)
 	case "image":
-		convertImage(xn)
+		me.convertImage(xn)
 	case "instance_effect":
 		if xn.Parent.Name.Local == "render" {
 			id := fmt.Sprintf("render_%v", time.Now().UnixNano())
 			matNode := xmlx.NewNode(xn.Type)
 			matNode.Name.Local = "material"
-			setAttr(matNode, "id", id, false)
-			matsLibNode := ensureChild(srcDoc.Root.Children[0], "library_materials")
+			me.setAttr(matNode, "id", id, false)
+			matsLibNode := ensureChild(me.srcDoc.Root.Children[0], "library_materials")
 			matsLibNode.AddChild(matNode)
 			oldParent := xn.Parent
-			_, pos := moveNode(xn, matNode, "")
+			_, pos := me.moveNode(xn, matNode, "")
 			instNode := xmlx.NewNode(xn.Type)
 			instNode.Name.Local = "instance_material"
-			setAttr(instNode, "url", "#"+id, false)
+			me.setAttr(instNode, "url", "#"+id, false)
 			instNode.Parent = oldParent
 			oldParent.Children[pos] = instNode
 		}
@@ -581,12 +620,12 @@ func processNode(xn *xmlx.Node) {
 			}
 		}
 	case "mipmap_bias":
-		renameNode(xn, "mip_bias")
+		me.renameNode(xn, "mip_bias")
 	case "mipmap_maxlevel":
-		renameNode(xn, "mip_max_level")
+		me.renameNode(xn, "mip_max_level")
 	case "newparam":
 		if !ustr.IsOneOf(xn.Parent.Name.Local, "effect", "profile_CG", "profile_COMMON", "profile_GLSL", "profile_GLES", "profile_GLES2") {
-			delNode(xn)
+			me.delNode(xn)
 		}
 	case "radius":
 		if vals := ustr.Split(xn.Value, " "); (xn.Parent.Name.Local == "capsule") && (len(vals) > 0) && (len(vals) < 3) {
@@ -597,26 +636,26 @@ func processNode(xn *xmlx.Node) {
 		}
 	case "setparam":
 		if !ustr.IsOneOf(xn.Parent.Name.Local, "instance_effect", "usertype") {
-			delNode(xn)
+			me.delNode(xn)
 		}
 	case "shader":
-		convertShader(xn)
+		me.convertShader(xn)
 	case "surface":
-		convertSurface(xn)
+		me.convertSurface(xn)
 	case "texture_pipeline":
 		if xn.Parent.Name.Local != "states" {
-			delNode(xn)
+			me.delNode(xn)
 		}
 	case "transparent":
-		setAttr(xn, "opaque", "A_ONE", true)
+		me.setAttr(xn, "opaque", "A_ONE", true)
 	case "usertype":
-		renameAttr(xn, "name", "typename")
+		me.renameAttr(xn, "name", "typename")
 		if !ustr.IsOneOf(xn.Parent.Name.Local, "newparam", "setparam", "array", "bind_uniform") {
-			delNode(xn)
+			me.delNode(xn)
 		} else {
 			for _, sn := range xn.Children {
 				if sn.Name.Local != "setparam" {
-					delNode(sn)
+					me.delNode(sn)
 				}
 			}
 		}
@@ -626,11 +665,11 @@ func processNode(xn *xmlx.Node) {
 			case "annotate", "extra", "evaluate", "states", "program":
 				break
 			case "color_target", "depth_target", "stencil_target", "color_clear", "depth_clear", "stencil_clear", "draw":
-				moveNode(xn, ensureChild(xn.Parent, "evaluate"), "")
+				me.moveNode(xn, ensureChild(xn.Parent, "evaluate"), "")
 			case "shader":
-				moveNode(xn, ensureChild(xn.Parent, "program"), "")
+				me.moveNode(xn, ensureChild(xn.Parent, "program"), "")
 			default:
-				moveNode(xn, ensureChild(xn.Parent, "states"), "")
+				me.moveNode(xn, ensureChild(xn.Parent, "states"), "")
 			}
 		}
 		if strings.HasPrefix(xn.Name.Local, "wrap_") && (xn.Value == "NONE") {
@@ -639,12 +678,12 @@ func processNode(xn *xmlx.Node) {
 		if (xn.Name.Local != "sampler") && strings.HasPrefix(xn.Name.Local, "sampler") && !strings.HasPrefix(xn.Name.Local, "sampler_") {
 			if sn := subNode(xn, "source"); sn != nil {
 				sn.Name.Local = "instance_image"
-				setAttr(sn, "url", "#"+surfaceImages[sn.Value], false)
+				me.setAttr(sn, "url", "#"+me.surfaceImages[sn.Value], false)
 				sn.Value = ""
 			}
 		}
 	}
 	for _, sn := range xn.Children {
-		processNode(sn)
+		me.processNode(sn)
 	}
 }
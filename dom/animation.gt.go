@@ -102,9 +102,9 @@ func newAnimationDef(id string) (me *AnimationDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *AnimationDef) DefaultInst() (inst *AnimationInst) {
-	if inst = defaultAnimationInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*AnimationInst); inst == nil {
 		inst = me.NewInst()
-		defaultAnimationInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -119,39 +119,50 @@ func (me *AnimationDef) NewInst() (inst *AnimationInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct AnimationDef
-//	according to the current me.DefRef value (by searching AllAnimationDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.AnimationDefLibs,
+//	or AllAnimationDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every AnimationInst's Def is nil initially, unless it was created via AnimationDef.NewInst().)
-func (me *AnimationInst) EnsureDef() *AnimationDef {
+func (me *AnimationInst) EnsureDef(reg *Registry) *AnimationDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.AnimationDef()
+		me.Def = me.DefRef.AnimationDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibAnimationDefs libraries associated by their Id.
-	AllAnimationDefLibs = LibsAnimationDef{}
+	//	This is the AnimationDefLibs of the DefaultRegistry.
+	AllAnimationDefLibs = DefaultRegistry.AnimationDefLibs
 
 	//	The "default" LibAnimationDefs library for AnimationDefs.
-	AnimationDefs = AllAnimationDefLibs.AddNew("")
-
-	defaultAnimationInsts = map[*AnimationDef]*AnimationInst{}
+	//	This is the AnimationDefs of the DefaultRegistry.
+	AnimationDefs = DefaultRegistry.AnimationDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllAnimationDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.AnimationDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.AnimationDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initAnimationDefs() {
+	me.AnimationDefLibs = LibsAnimationDef{}
+	me.AnimationDefs = me.AnimationDefLibs.AddNew("")
 }
 
-//	Searches (all LibAnimationDefs contained in AllAnimationDefLibs) for the AnimationDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) AnimationDef() (def *AnimationDef) {
+//	Searches (all LibAnimationDefs contained in reg.AnimationDefLibs, or AllAnimationDefLibs if reg is nil)
+//	for the AnimationDef whose Id is referenced by me, returning the first match found.
+func (me RefId) AnimationDef(reg *Registry) (def *AnimationDef) {
 	id := me.S()
-	for _, lib := range AllAnimationDefLibs {
+	for _, lib := range reg.orDefault().AnimationDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -159,7 +170,7 @@ func (me RefId) AnimationDef() (def *AnimationDef) {
 	return
 }
 
-//	The underlying type of the global AllAnimationDefLibs variable:
+//	The underlying type of the global AllAnimationDefLibs variable and the Registry.AnimationDefLibs field:
 //	a hash-table that contains LibAnimationDefs libraries associated by their Id.
 type LibsAnimationDef map[string]*LibAnimationDefs
 
@@ -201,6 +212,7 @@ func newLibAnimationDefs(id string) (me *LibAnimationDefs) {
 func (me *LibAnimationDefs) Add(d *AnimationDef) (n *AnimationDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -217,8 +229,18 @@ func (me *LibAnimationDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibAnimationDefs.
 func (me *LibAnimationDefs) New(id string) (def *AnimationDef) { def = newAnimationDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the AnimationDef definitions in this LibAnimationDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in AnimationDef definitions already contained in me.
+func (me *LibAnimationDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the AnimationDef with the specified Id from this LibAnimationDefs.
-func (me *LibAnimationDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibAnimationDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibAnimationDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibAnimationDefs
@@ -52,9 +52,9 @@ func newAnimationClipDef(id string) (me *AnimationClipDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *AnimationClipDef) DefaultInst() (inst *AnimationClipInst) {
-	if inst = defaultAnimationClipInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*AnimationClipInst); inst == nil {
 		inst = me.NewInst()
-		defaultAnimationClipInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -69,39 +69,50 @@ func (me *AnimationClipDef) NewInst() (inst *AnimationClipInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct AnimationClipDef
-//	according to the current me.DefRef value (by searching AllAnimationClipDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.AnimationClipDefLibs,
+//	or AllAnimationClipDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every AnimationClipInst's Def is nil initially, unless it was created via AnimationClipDef.NewInst().)
-func (me *AnimationClipInst) EnsureDef() *AnimationClipDef {
+func (me *AnimationClipInst) EnsureDef(reg *Registry) *AnimationClipDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.AnimationClipDef()
+		me.Def = me.DefRef.AnimationClipDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibAnimationClipDefs libraries associated by their Id.
-	AllAnimationClipDefLibs = LibsAnimationClipDef{}
+	//	This is the AnimationClipDefLibs of the DefaultRegistry.
+	AllAnimationClipDefLibs = DefaultRegistry.AnimationClipDefLibs
 
 	//	The "default" LibAnimationClipDefs library for AnimationClipDefs.
-	AnimationClipDefs = AllAnimationClipDefLibs.AddNew("")
-
-	defaultAnimationClipInsts = map[*AnimationClipDef]*AnimationClipInst{}
+	//	This is the AnimationClipDefs of the DefaultRegistry.
+	AnimationClipDefs = DefaultRegistry.AnimationClipDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllAnimationClipDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.AnimationClipDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.AnimationClipDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initAnimationClipDefs() {
+	me.AnimationClipDefLibs = LibsAnimationClipDef{}
+	me.AnimationClipDefs = me.AnimationClipDefLibs.AddNew("")
 }
 
-//	Searches (all LibAnimationClipDefs contained in AllAnimationClipDefLibs) for the AnimationClipDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) AnimationClipDef() (def *AnimationClipDef) {
+//	Searches (all LibAnimationClipDefs contained in reg.AnimationClipDefLibs, or AllAnimationClipDefLibs if reg is nil)
+//	for the AnimationClipDef whose Id is referenced by me, returning the first match found.
+func (me RefId) AnimationClipDef(reg *Registry) (def *AnimationClipDef) {
 	id := me.S()
-	for _, lib := range AllAnimationClipDefLibs {
+	for _, lib := range reg.orDefault().AnimationClipDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -109,7 +120,7 @@ func (me RefId) AnimationClipDef() (def *AnimationClipDef) {
 	return
 }
 
-//	The underlying type of the global AllAnimationClipDefLibs variable:
+//	The underlying type of the global AllAnimationClipDefLibs variable and the Registry.AnimationClipDefLibs field:
 //	a hash-table that contains LibAnimationClipDefs libraries associated by their Id.
 type LibsAnimationClipDef map[string]*LibAnimationClipDefs
 
@@ -151,6 +162,7 @@ func newLibAnimationClipDefs(id string) (me *LibAnimationClipDefs) {
 func (me *LibAnimationClipDefs) Add(d *AnimationClipDef) (n *AnimationClipDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -167,8 +179,18 @@ func (me *LibAnimationClipDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibAnimationClipDefs.
 func (me *LibAnimationClipDefs) New(id string) (def *AnimationClipDef) { def = newAnimationClipDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the AnimationClipDef definitions in this LibAnimationClipDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in AnimationClipDef definitions already contained in me.
+func (me *LibAnimationClipDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the AnimationClipDef with the specified Id from this LibAnimationClipDefs.
-func (me *LibAnimationClipDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibAnimationClipDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibAnimationClipDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibAnimationClipDefs
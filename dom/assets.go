@@ -15,11 +15,24 @@ var (
 	//	gets called after SyncChanges() has finished syncing.
 	OnAfterSyncAll func()
 
-	syncHandlers []func()
+	syncHandlers []func(*Registry)
+
+	libsOfRegistry []func(*Registry) []*BaseLib
 )
 
-func index2D(x, y, ysize int) int {
-	return ugfx.Index2D(x, y, ysize)
+//	Returns the index selected by a RefSidIndexer.AccessIndex(x, y) call into n row-major values with ysize columns:
+//	x itself if y is negative, else the index of row x and column y. Returns -1 (an invalid index) if that is not
+//	within the n values, or if y is out of range (rather than an index into the next row).
+func accessIndex(x, y, ysize, n int) (i int) {
+	if i = x; y >= 0 {
+		if i = -1; (x >= 0) && (y < ysize) {
+			i = ugfx.Index2D(x, y, ysize)
+		}
+	}
+	if (i < 0) || (i >= n) {
+		i = -1
+	}
+	return
 }
 
 func init() {
@@ -39,10 +52,9 @@ func SidF(f float64) (sf *SidFloat) {
 
 //	Signals to the core package (or your custom package) that changes have been made that need to be
 //	picked up. Call this after you have made any number of changes to your Defs, Insts or Libs.
+//	Syncs only the DefaultRegistry: for other Registry instances, call their SyncChanges() method.
 func SyncChanges() {
 	OnBeforeSyncAll()
-	for _, syncer := range syncHandlers {
-		syncer()
-	}
+	DefaultRegistry.SyncChanges()
 	OnAfterSyncAll()
 }
@@ -65,6 +65,8 @@ type BaseDef struct {
 
 	//	Extras
 	HasExtras
+
+	defaultInst interface{}
 }
 
 //	Provides a common base for resource instantiations.
@@ -95,9 +97,12 @@ type BaseLib struct {
 
 	//	Name
 	HasName
+
+	index refIndex
 }
 
 func (me *BaseLib) init(id string) {
 	me.Id = id
+	me.index.init()
 	me.BaseSync.init()
 }
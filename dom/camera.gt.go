@@ -99,9 +99,9 @@ func newCameraDef(id string) (me *CameraDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *CameraDef) DefaultInst() (inst *CameraInst) {
-	if inst = defaultCameraInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*CameraInst); inst == nil {
 		inst = me.NewInst()
-		defaultCameraInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -116,39 +116,50 @@ func (me *CameraDef) NewInst() (inst *CameraInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct CameraDef
-//	according to the current me.DefRef value (by searching AllCameraDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.CameraDefLibs,
+//	or AllCameraDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every CameraInst's Def is nil initially, unless it was created via CameraDef.NewInst().)
-func (me *CameraInst) EnsureDef() *CameraDef {
+func (me *CameraInst) EnsureDef(reg *Registry) *CameraDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.CameraDef()
+		me.Def = me.DefRef.CameraDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibCameraDefs libraries associated by their Id.
-	AllCameraDefLibs = LibsCameraDef{}
+	//	This is the CameraDefLibs of the DefaultRegistry.
+	AllCameraDefLibs = DefaultRegistry.CameraDefLibs
 
 	//	The "default" LibCameraDefs library for CameraDefs.
-	CameraDefs = AllCameraDefLibs.AddNew("")
-
-	defaultCameraInsts = map[*CameraDef]*CameraInst{}
+	//	This is the CameraDefs of the DefaultRegistry.
+	CameraDefs = DefaultRegistry.CameraDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllCameraDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.CameraDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.CameraDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initCameraDefs() {
+	me.CameraDefLibs = LibsCameraDef{}
+	me.CameraDefs = me.CameraDefLibs.AddNew("")
 }
 
-//	Searches (all LibCameraDefs contained in AllCameraDefLibs) for the CameraDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) CameraDef() (def *CameraDef) {
+//	Searches (all LibCameraDefs contained in reg.CameraDefLibs, or AllCameraDefLibs if reg is nil)
+//	for the CameraDef whose Id is referenced by me, returning the first match found.
+func (me RefId) CameraDef(reg *Registry) (def *CameraDef) {
 	id := me.S()
-	for _, lib := range AllCameraDefLibs {
+	for _, lib := range reg.orDefault().CameraDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -156,7 +167,7 @@ func (me RefId) CameraDef() (def *CameraDef) {
 	return
 }
 
-//	The underlying type of the global AllCameraDefLibs variable:
+//	The underlying type of the global AllCameraDefLibs variable and the Registry.CameraDefLibs field:
 //	a hash-table that contains LibCameraDefs libraries associated by their Id.
 type LibsCameraDef map[string]*LibCameraDefs
 
@@ -198,6 +209,7 @@ func newLibCameraDefs(id string) (me *LibCameraDefs) {
 func (me *LibCameraDefs) Add(d *CameraDef) (n *CameraDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -214,8 +226,18 @@ func (me *LibCameraDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibCameraDefs.
 func (me *LibCameraDefs) New(id string) (def *CameraDef) { def = newCameraDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the CameraDef definitions in this LibCameraDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in CameraDef definitions already contained in me.
+func (me *LibCameraDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the CameraDef with the specified Id from this LibCameraDefs.
-func (me *LibCameraDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibCameraDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibCameraDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibCameraDefs
@@ -1,5 +1,10 @@
 package cdom
 
+import (
+	"strconv"
+	"strings"
+)
+
 //	Used in all resources that require asset-management information.
 type HasAsset struct {
 	//	Resource-specific asset-management information and meta-data.
@@ -172,12 +177,23 @@ type IndexedInputs struct {
 
 	//	Indices that describe the attributes for a number of primitives.
 	//	The indices reference into the Sources that are referenced by the Inputs.
+	//	In the VertexWeights of a ControllerSkin, the joint index -1 (referring to the bind shape) is math.MaxUint64.
 	Indices []uint64
 
 	//	Number of sub-primitives, if used.
 	Vcount []int64
 }
 
+//	Returns the number of Indices per vertex, that is, the highest Offset in Inputs plus one.
+func (me *IndexedInputs) Stride() (stride uint64) {
+	for _, in := range me.Inputs {
+		if in.Offset >= stride {
+			stride = in.Offset + 1
+		}
+	}
+	return
+}
+
 //	Declares unshared input semantics of a data source and connects a consumer to that source.
 type Input struct {
 	//	The user-defined meaning of the input connection.
@@ -237,6 +253,22 @@ type Param struct {
 	Type string
 }
 
+//	Returns the number of values that me spans within each element of a SourceAccessor, as per its Type:
+//	for example, 1 for "float" or "name", 3 for "float3" and 16 for "float4x4".
+func (me *Param) Width() (n uint64) {
+	n = 1
+	if pos := strings.IndexAny(me.Type, "123456789"); pos > 0 {
+		for _, dim := range strings.Split(me.Type[pos:], "x") {
+			if d, err := strconv.ParseUint(dim, 10, 8); (err == nil) && (d > 0) {
+				n *= d
+			} else {
+				return 1
+			}
+		}
+	}
+	return
+}
+
 //	Declares a new parameter for its parent resource, and assigns it an initial value.
 type ParamDef struct {
 	//	Sid
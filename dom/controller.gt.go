@@ -117,9 +117,9 @@ func newControllerDef(id string) (me *ControllerDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *ControllerDef) DefaultInst() (inst *ControllerInst) {
-	if inst = defaultControllerInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*ControllerInst); inst == nil {
 		inst = me.NewInst()
-		defaultControllerInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -134,39 +134,50 @@ func (me *ControllerDef) NewInst() (inst *ControllerInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct ControllerDef
-//	according to the current me.DefRef value (by searching AllControllerDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.ControllerDefLibs,
+//	or AllControllerDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every ControllerInst's Def is nil initially, unless it was created via ControllerDef.NewInst().)
-func (me *ControllerInst) EnsureDef() *ControllerDef {
+func (me *ControllerInst) EnsureDef(reg *Registry) *ControllerDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.ControllerDef()
+		me.Def = me.DefRef.ControllerDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibControllerDefs libraries associated by their Id.
-	AllControllerDefLibs = LibsControllerDef{}
+	//	This is the ControllerDefLibs of the DefaultRegistry.
+	AllControllerDefLibs = DefaultRegistry.ControllerDefLibs
 
 	//	The "default" LibControllerDefs library for ControllerDefs.
-	ControllerDefs = AllControllerDefLibs.AddNew("")
-
-	defaultControllerInsts = map[*ControllerDef]*ControllerInst{}
+	//	This is the ControllerDefs of the DefaultRegistry.
+	ControllerDefs = DefaultRegistry.ControllerDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllControllerDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.ControllerDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.ControllerDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initControllerDefs() {
+	me.ControllerDefLibs = LibsControllerDef{}
+	me.ControllerDefs = me.ControllerDefLibs.AddNew("")
 }
 
-//	Searches (all LibControllerDefs contained in AllControllerDefLibs) for the ControllerDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) ControllerDef() (def *ControllerDef) {
+//	Searches (all LibControllerDefs contained in reg.ControllerDefLibs, or AllControllerDefLibs if reg is nil)
+//	for the ControllerDef whose Id is referenced by me, returning the first match found.
+func (me RefId) ControllerDef(reg *Registry) (def *ControllerDef) {
 	id := me.S()
-	for _, lib := range AllControllerDefLibs {
+	for _, lib := range reg.orDefault().ControllerDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -174,7 +185,7 @@ func (me RefId) ControllerDef() (def *ControllerDef) {
 	return
 }
 
-//	The underlying type of the global AllControllerDefLibs variable:
+//	The underlying type of the global AllControllerDefLibs variable and the Registry.ControllerDefLibs field:
 //	a hash-table that contains LibControllerDefs libraries associated by their Id.
 type LibsControllerDef map[string]*LibControllerDefs
 
@@ -216,6 +227,7 @@ func newLibControllerDefs(id string) (me *LibControllerDefs) {
 func (me *LibControllerDefs) Add(d *ControllerDef) (n *ControllerDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -232,8 +244,18 @@ func (me *LibControllerDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibControllerDefs.
 func (me *LibControllerDefs) New(id string) (def *ControllerDef) { def = newControllerDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the ControllerDef definitions in this LibControllerDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in ControllerDef definitions already contained in me.
+func (me *LibControllerDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the ControllerDef with the specified Id from this LibControllerDefs.
-func (me *LibControllerDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibControllerDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibControllerDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibControllerDefs
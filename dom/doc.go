@@ -48,13 +48,18 @@
 // LibsGeometryDef), AllFxImageDefLibs (of type LibsFxImageDef), AllLightDefLibs (of type LibsLightDef),
 // AllFxMaterialDefLibs (of type LibsFxMaterialDef) etc.
 //
+// 6. All of the above globals belong to the DefaultRegistry. To keep the libraries of different Documents apart
+// (for example, when importing many documents whose Ids collide), create a separate Registry via NewRegistry()
+// for each: every Registry has its own FooDefLibs hash-tables and FooDefs libraries. All lookup methods, such as
+// RefId.GeometryDef() or FooInst.EnsureDef(), take the *Registry to search, with nil denoting DefaultRegistry.
+//
 // Any exported types in this package not following the above pattern should be
 // considered "auxiliary helpers" rather than primary / "first-class" resource types.
 package cdom
 
 //	Encapsulates a complete, fully self-contained scene graph.
-//	Note, resource definition libraries are organized in "globals" (exported package variables)
-//	rather than Document struct instances.
+//	Note, resource definition libraries are organized in Registry instances rather than
+//	Document struct instances: any number of Documents may share the same Registry.
 type Document struct {
 	//	Asset
 	HasAsset
@@ -62,6 +67,14 @@ type Document struct {
 	//	Extras
 	HasExtras
 
+	//	The resource definition libraries used by this Document. If nil, DefaultRegistry is used.
+	Registry *Registry
+
 	//	Describes a complete, fully self-contained scene graph.
 	Scene *Scene
 }
+
+//	Returns me.Registry if it is not nil, otherwise DefaultRegistry.
+func (me *Document) Libs() *Registry {
+	return me.Registry.orDefault()
+}
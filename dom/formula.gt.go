@@ -72,9 +72,9 @@ func newFormulaDef(id string) (me *FormulaDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *FormulaDef) DefaultInst() (inst *FormulaInst) {
-	if inst = defaultFormulaInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*FormulaInst); inst == nil {
 		inst = me.NewInst()
-		defaultFormulaInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -89,39 +89,50 @@ func (me *FormulaDef) NewInst() (inst *FormulaInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct FormulaDef
-//	according to the current me.DefRef value (by searching AllFormulaDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.FormulaDefLibs,
+//	or AllFormulaDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every FormulaInst's Def is nil initially, unless it was created via FormulaDef.NewInst().)
-func (me *FormulaInst) EnsureDef() *FormulaDef {
+func (me *FormulaInst) EnsureDef(reg *Registry) *FormulaDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.FormulaDef()
+		me.Def = me.DefRef.FormulaDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibFormulaDefs libraries associated by their Id.
-	AllFormulaDefLibs = LibsFormulaDef{}
+	//	This is the FormulaDefLibs of the DefaultRegistry.
+	AllFormulaDefLibs = DefaultRegistry.FormulaDefLibs
 
 	//	The "default" LibFormulaDefs library for FormulaDefs.
-	FormulaDefs = AllFormulaDefLibs.AddNew("")
-
-	defaultFormulaInsts = map[*FormulaDef]*FormulaInst{}
+	//	This is the FormulaDefs of the DefaultRegistry.
+	FormulaDefs = DefaultRegistry.FormulaDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllFormulaDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.FormulaDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.FormulaDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initFormulaDefs() {
+	me.FormulaDefLibs = LibsFormulaDef{}
+	me.FormulaDefs = me.FormulaDefLibs.AddNew("")
 }
 
-//	Searches (all LibFormulaDefs contained in AllFormulaDefLibs) for the FormulaDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) FormulaDef() (def *FormulaDef) {
+//	Searches (all LibFormulaDefs contained in reg.FormulaDefLibs, or AllFormulaDefLibs if reg is nil)
+//	for the FormulaDef whose Id is referenced by me, returning the first match found.
+func (me RefId) FormulaDef(reg *Registry) (def *FormulaDef) {
 	id := me.S()
-	for _, lib := range AllFormulaDefLibs {
+	for _, lib := range reg.orDefault().FormulaDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -129,7 +140,7 @@ func (me RefId) FormulaDef() (def *FormulaDef) {
 	return
 }
 
-//	The underlying type of the global AllFormulaDefLibs variable:
+//	The underlying type of the global AllFormulaDefLibs variable and the Registry.FormulaDefLibs field:
 //	a hash-table that contains LibFormulaDefs libraries associated by their Id.
 type LibsFormulaDef map[string]*LibFormulaDefs
 
@@ -171,6 +182,7 @@ func newLibFormulaDefs(id string) (me *LibFormulaDefs) {
 func (me *LibFormulaDefs) Add(d *FormulaDef) (n *FormulaDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -187,8 +199,18 @@ func (me *LibFormulaDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibFormulaDefs.
 func (me *LibFormulaDefs) New(id string) (def *FormulaDef) { def = newFormulaDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the FormulaDef definitions in this LibFormulaDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in FormulaDef definitions already contained in me.
+func (me *LibFormulaDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the FormulaDef with the specified Id from this LibFormulaDefs.
-func (me *LibFormulaDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibFormulaDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibFormulaDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibFormulaDefs
@@ -581,9 +581,9 @@ func newFxEffectDef(id string) (me *FxEffectDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *FxEffectDef) DefaultInst() (inst *FxEffectInst) {
-	if inst = defaultFxEffectInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*FxEffectInst); inst == nil {
 		inst = me.NewInst()
-		defaultFxEffectInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -598,39 +598,50 @@ func (me *FxEffectDef) NewInst() (inst *FxEffectInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct FxEffectDef
-//	according to the current me.DefRef value (by searching AllFxEffectDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.FxEffectDefLibs,
+//	or AllFxEffectDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every FxEffectInst's Def is nil initially, unless it was created via FxEffectDef.NewInst().)
-func (me *FxEffectInst) EnsureDef() *FxEffectDef {
+func (me *FxEffectInst) EnsureDef(reg *Registry) *FxEffectDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.FxEffectDef()
+		me.Def = me.DefRef.FxEffectDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibFxEffectDefs libraries associated by their Id.
-	AllFxEffectDefLibs = LibsFxEffectDef{}
+	//	This is the FxEffectDefLibs of the DefaultRegistry.
+	AllFxEffectDefLibs = DefaultRegistry.FxEffectDefLibs
 
 	//	The "default" LibFxEffectDefs library for FxEffectDefs.
-	FxEffectDefs = AllFxEffectDefLibs.AddNew("")
-
-	defaultFxEffectInsts = map[*FxEffectDef]*FxEffectInst{}
+	//	This is the FxEffectDefs of the DefaultRegistry.
+	FxEffectDefs = DefaultRegistry.FxEffectDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllFxEffectDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.FxEffectDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.FxEffectDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initFxEffectDefs() {
+	me.FxEffectDefLibs = LibsFxEffectDef{}
+	me.FxEffectDefs = me.FxEffectDefLibs.AddNew("")
 }
 
-//	Searches (all LibFxEffectDefs contained in AllFxEffectDefLibs) for the FxEffectDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxEffectDef() (def *FxEffectDef) {
+//	Searches (all LibFxEffectDefs contained in reg.FxEffectDefLibs, or AllFxEffectDefLibs if reg is nil)
+//	for the FxEffectDef whose Id is referenced by me, returning the first match found.
+func (me RefId) FxEffectDef(reg *Registry) (def *FxEffectDef) {
 	id := me.S()
-	for _, lib := range AllFxEffectDefLibs {
+	for _, lib := range reg.orDefault().FxEffectDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -638,7 +649,7 @@ func (me RefId) FxEffectDef() (def *FxEffectDef) {
 	return
 }
 
-//	The underlying type of the global AllFxEffectDefLibs variable:
+//	The underlying type of the global AllFxEffectDefLibs variable and the Registry.FxEffectDefLibs field:
 //	a hash-table that contains LibFxEffectDefs libraries associated by their Id.
 type LibsFxEffectDef map[string]*LibFxEffectDefs
 
@@ -680,6 +691,7 @@ func newLibFxEffectDefs(id string) (me *LibFxEffectDefs) {
 func (me *LibFxEffectDefs) Add(d *FxEffectDef) (n *FxEffectDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -696,8 +708,18 @@ func (me *LibFxEffectDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibFxEffectDefs.
 func (me *LibFxEffectDefs) New(id string) (def *FxEffectDef) { def = newFxEffectDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the FxEffectDef definitions in this LibFxEffectDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in FxEffectDef definitions already contained in me.
+func (me *LibFxEffectDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the FxEffectDef with the specified Id from this LibFxEffectDefs.
-func (me *LibFxEffectDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibFxEffectDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibFxEffectDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibFxEffectDefs
@@ -359,9 +359,9 @@ func newFxImageDef(id string) (me *FxImageDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *FxImageDef) DefaultInst() (inst *FxImageInst) {
-	if inst = defaultFxImageInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*FxImageInst); inst == nil {
 		inst = me.NewInst()
-		defaultFxImageInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -376,39 +376,50 @@ func (me *FxImageDef) NewInst() (inst *FxImageInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct FxImageDef
-//	according to the current me.DefRef value (by searching AllFxImageDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.FxImageDefLibs,
+//	or AllFxImageDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every FxImageInst's Def is nil initially, unless it was created via FxImageDef.NewInst().)
-func (me *FxImageInst) EnsureDef() *FxImageDef {
+func (me *FxImageInst) EnsureDef(reg *Registry) *FxImageDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.FxImageDef()
+		me.Def = me.DefRef.FxImageDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibFxImageDefs libraries associated by their Id.
-	AllFxImageDefLibs = LibsFxImageDef{}
+	//	This is the FxImageDefLibs of the DefaultRegistry.
+	AllFxImageDefLibs = DefaultRegistry.FxImageDefLibs
 
 	//	The "default" LibFxImageDefs library for FxImageDefs.
-	FxImageDefs = AllFxImageDefLibs.AddNew("")
-
-	defaultFxImageInsts = map[*FxImageDef]*FxImageInst{}
+	//	This is the FxImageDefs of the DefaultRegistry.
+	FxImageDefs = DefaultRegistry.FxImageDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllFxImageDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.FxImageDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.FxImageDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initFxImageDefs() {
+	me.FxImageDefLibs = LibsFxImageDef{}
+	me.FxImageDefs = me.FxImageDefLibs.AddNew("")
 }
 
-//	Searches (all LibFxImageDefs contained in AllFxImageDefLibs) for the FxImageDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxImageDef() (def *FxImageDef) {
+//	Searches (all LibFxImageDefs contained in reg.FxImageDefLibs, or AllFxImageDefLibs if reg is nil)
+//	for the FxImageDef whose Id is referenced by me, returning the first match found.
+func (me RefId) FxImageDef(reg *Registry) (def *FxImageDef) {
 	id := me.S()
-	for _, lib := range AllFxImageDefLibs {
+	for _, lib := range reg.orDefault().FxImageDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -416,7 +427,7 @@ func (me RefId) FxImageDef() (def *FxImageDef) {
 	return
 }
 
-//	The underlying type of the global AllFxImageDefLibs variable:
+//	The underlying type of the global AllFxImageDefLibs variable and the Registry.FxImageDefLibs field:
 //	a hash-table that contains LibFxImageDefs libraries associated by their Id.
 type LibsFxImageDef map[string]*LibFxImageDefs
 
@@ -458,6 +469,7 @@ func newLibFxImageDefs(id string) (me *LibFxImageDefs) {
 func (me *LibFxImageDefs) Add(d *FxImageDef) (n *FxImageDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -474,8 +486,18 @@ func (me *LibFxImageDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibFxImageDefs.
 func (me *LibFxImageDefs) New(id string) (def *FxImageDef) { def = newFxImageDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the FxImageDef definitions in this LibFxImageDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in FxImageDef definitions already contained in me.
+func (me *LibFxImageDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the FxImageDef with the specified Id from this LibFxImageDefs.
-func (me *LibFxImageDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibFxImageDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibFxImageDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibFxImageDefs
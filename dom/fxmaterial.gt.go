@@ -72,9 +72,9 @@ func newFxMaterialDef(id string) (me *FxMaterialDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *FxMaterialDef) DefaultInst() (inst *FxMaterialInst) {
-	if inst = defaultFxMaterialInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*FxMaterialInst); inst == nil {
 		inst = me.NewInst()
-		defaultFxMaterialInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -89,39 +89,50 @@ func (me *FxMaterialDef) NewInst() (inst *FxMaterialInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct FxMaterialDef
-//	according to the current me.DefRef value (by searching AllFxMaterialDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.FxMaterialDefLibs,
+//	or AllFxMaterialDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every FxMaterialInst's Def is nil initially, unless it was created via FxMaterialDef.NewInst().)
-func (me *FxMaterialInst) EnsureDef() *FxMaterialDef {
+func (me *FxMaterialInst) EnsureDef(reg *Registry) *FxMaterialDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.FxMaterialDef()
+		me.Def = me.DefRef.FxMaterialDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibFxMaterialDefs libraries associated by their Id.
-	AllFxMaterialDefLibs = LibsFxMaterialDef{}
+	//	This is the FxMaterialDefLibs of the DefaultRegistry.
+	AllFxMaterialDefLibs = DefaultRegistry.FxMaterialDefLibs
 
 	//	The "default" LibFxMaterialDefs library for FxMaterialDefs.
-	FxMaterialDefs = AllFxMaterialDefLibs.AddNew("")
-
-	defaultFxMaterialInsts = map[*FxMaterialDef]*FxMaterialInst{}
+	//	This is the FxMaterialDefs of the DefaultRegistry.
+	FxMaterialDefs = DefaultRegistry.FxMaterialDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllFxMaterialDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.FxMaterialDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.FxMaterialDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initFxMaterialDefs() {
+	me.FxMaterialDefLibs = LibsFxMaterialDef{}
+	me.FxMaterialDefs = me.FxMaterialDefLibs.AddNew("")
 }
 
-//	Searches (all LibFxMaterialDefs contained in AllFxMaterialDefLibs) for the FxMaterialDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxMaterialDef() (def *FxMaterialDef) {
+//	Searches (all LibFxMaterialDefs contained in reg.FxMaterialDefLibs, or AllFxMaterialDefLibs if reg is nil)
+//	for the FxMaterialDef whose Id is referenced by me, returning the first match found.
+func (me RefId) FxMaterialDef(reg *Registry) (def *FxMaterialDef) {
 	id := me.S()
-	for _, lib := range AllFxMaterialDefLibs {
+	for _, lib := range reg.orDefault().FxMaterialDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -129,7 +140,7 @@ func (me RefId) FxMaterialDef() (def *FxMaterialDef) {
 	return
 }
 
-//	The underlying type of the global AllFxMaterialDefLibs variable:
+//	The underlying type of the global AllFxMaterialDefLibs variable and the Registry.FxMaterialDefLibs field:
 //	a hash-table that contains LibFxMaterialDefs libraries associated by their Id.
 type LibsFxMaterialDef map[string]*LibFxMaterialDefs
 
@@ -171,6 +182,7 @@ func newLibFxMaterialDefs(id string) (me *LibFxMaterialDefs) {
 func (me *LibFxMaterialDefs) Add(d *FxMaterialDef) (n *FxMaterialDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -187,8 +199,18 @@ func (me *LibFxMaterialDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibFxMaterialDefs.
 func (me *LibFxMaterialDefs) New(id string) (def *FxMaterialDef) { def = newFxMaterialDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the FxMaterialDef definitions in this LibFxMaterialDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in FxMaterialDef definitions already contained in me.
+func (me *LibFxMaterialDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the FxMaterialDef with the specified Id from this LibFxMaterialDefs.
-func (me *LibFxMaterialDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibFxMaterialDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibFxMaterialDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibFxMaterialDefs
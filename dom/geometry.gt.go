@@ -185,9 +185,9 @@ func newGeometryDef(id string) (me *GeometryDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *GeometryDef) DefaultInst() (inst *GeometryInst) {
-	if inst = defaultGeometryInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*GeometryInst); inst == nil {
 		inst = me.NewInst()
-		defaultGeometryInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -202,39 +202,50 @@ func (me *GeometryDef) NewInst() (inst *GeometryInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct GeometryDef
-//	according to the current me.DefRef value (by searching AllGeometryDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.GeometryDefLibs,
+//	or AllGeometryDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every GeometryInst's Def is nil initially, unless it was created via GeometryDef.NewInst().)
-func (me *GeometryInst) EnsureDef() *GeometryDef {
+func (me *GeometryInst) EnsureDef(reg *Registry) *GeometryDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.GeometryDef()
+		me.Def = me.DefRef.GeometryDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibGeometryDefs libraries associated by their Id.
-	AllGeometryDefLibs = LibsGeometryDef{}
+	//	This is the GeometryDefLibs of the DefaultRegistry.
+	AllGeometryDefLibs = DefaultRegistry.GeometryDefLibs
 
 	//	The "default" LibGeometryDefs library for GeometryDefs.
-	GeometryDefs = AllGeometryDefLibs.AddNew("")
-
-	defaultGeometryInsts = map[*GeometryDef]*GeometryInst{}
+	//	This is the GeometryDefs of the DefaultRegistry.
+	GeometryDefs = DefaultRegistry.GeometryDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllGeometryDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.GeometryDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.GeometryDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initGeometryDefs() {
+	me.GeometryDefLibs = LibsGeometryDef{}
+	me.GeometryDefs = me.GeometryDefLibs.AddNew("")
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryDef() (def *GeometryDef) {
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or AllGeometryDefLibs if reg is nil)
+//	for the GeometryDef whose Id is referenced by me, returning the first match found.
+func (me RefId) GeometryDef(reg *Registry) (def *GeometryDef) {
 	id := me.S()
-	for _, lib := range AllGeometryDefLibs {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -242,7 +253,7 @@ func (me RefId) GeometryDef() (def *GeometryDef) {
 	return
 }
 
-//	The underlying type of the global AllGeometryDefLibs variable:
+//	The underlying type of the global AllGeometryDefLibs variable and the Registry.GeometryDefLibs field:
 //	a hash-table that contains LibGeometryDefs libraries associated by their Id.
 type LibsGeometryDef map[string]*LibGeometryDefs
 
@@ -284,6 +295,7 @@ func newLibGeometryDefs(id string) (me *LibGeometryDefs) {
 func (me *LibGeometryDefs) Add(d *GeometryDef) (n *GeometryDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -300,8 +312,18 @@ func (me *LibGeometryDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibGeometryDefs.
 func (me *LibGeometryDefs) New(id string) (def *GeometryDef) { def = newGeometryDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the GeometryDef definitions in this LibGeometryDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in GeometryDef definitions already contained in me.
+func (me *LibGeometryDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the GeometryDef with the specified Id from this LibGeometryDefs.
-func (me *LibGeometryDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibGeometryDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibGeometryDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibGeometryDefs
@@ -293,9 +293,9 @@ func newKxArticulatedSystemDef(id string) (me *KxArticulatedSystemDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *KxArticulatedSystemDef) DefaultInst() (inst *KxArticulatedSystemInst) {
-	if inst = defaultKxArticulatedSystemInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*KxArticulatedSystemInst); inst == nil {
 		inst = me.NewInst()
-		defaultKxArticulatedSystemInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -310,39 +310,50 @@ func (me *KxArticulatedSystemDef) NewInst() (inst *KxArticulatedSystemInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct KxArticulatedSystemDef
-//	according to the current me.DefRef value (by searching AllKxArticulatedSystemDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.KxArticulatedSystemDefLibs,
+//	or AllKxArticulatedSystemDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every KxArticulatedSystemInst's Def is nil initially, unless it was created via KxArticulatedSystemDef.NewInst().)
-func (me *KxArticulatedSystemInst) EnsureDef() *KxArticulatedSystemDef {
+func (me *KxArticulatedSystemInst) EnsureDef(reg *Registry) *KxArticulatedSystemDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.KxArticulatedSystemDef()
+		me.Def = me.DefRef.KxArticulatedSystemDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibKxArticulatedSystemDefs libraries associated by their Id.
-	AllKxArticulatedSystemDefLibs = LibsKxArticulatedSystemDef{}
+	//	This is the KxArticulatedSystemDefLibs of the DefaultRegistry.
+	AllKxArticulatedSystemDefLibs = DefaultRegistry.KxArticulatedSystemDefLibs
 
 	//	The "default" LibKxArticulatedSystemDefs library for KxArticulatedSystemDefs.
-	KxArticulatedSystemDefs = AllKxArticulatedSystemDefLibs.AddNew("")
-
-	defaultKxArticulatedSystemInsts = map[*KxArticulatedSystemDef]*KxArticulatedSystemInst{}
+	//	This is the KxArticulatedSystemDefs of the DefaultRegistry.
+	KxArticulatedSystemDefs = DefaultRegistry.KxArticulatedSystemDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllKxArticulatedSystemDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.KxArticulatedSystemDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.KxArticulatedSystemDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initKxArticulatedSystemDefs() {
+	me.KxArticulatedSystemDefLibs = LibsKxArticulatedSystemDef{}
+	me.KxArticulatedSystemDefs = me.KxArticulatedSystemDefLibs.AddNew("")
 }
 
-//	Searches (all LibKxArticulatedSystemDefs contained in AllKxArticulatedSystemDefLibs) for the KxArticulatedSystemDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) KxArticulatedSystemDef() (def *KxArticulatedSystemDef) {
+//	Searches (all LibKxArticulatedSystemDefs contained in reg.KxArticulatedSystemDefLibs, or AllKxArticulatedSystemDefLibs if reg is nil)
+//	for the KxArticulatedSystemDef whose Id is referenced by me, returning the first match found.
+func (me RefId) KxArticulatedSystemDef(reg *Registry) (def *KxArticulatedSystemDef) {
 	id := me.S()
-	for _, lib := range AllKxArticulatedSystemDefLibs {
+	for _, lib := range reg.orDefault().KxArticulatedSystemDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -350,7 +361,7 @@ func (me RefId) KxArticulatedSystemDef() (def *KxArticulatedSystemDef) {
 	return
 }
 
-//	The underlying type of the global AllKxArticulatedSystemDefLibs variable:
+//	The underlying type of the global AllKxArticulatedSystemDefLibs variable and the Registry.KxArticulatedSystemDefLibs field:
 //	a hash-table that contains LibKxArticulatedSystemDefs libraries associated by their Id.
 type LibsKxArticulatedSystemDef map[string]*LibKxArticulatedSystemDefs
 
@@ -392,6 +403,7 @@ func newLibKxArticulatedSystemDefs(id string) (me *LibKxArticulatedSystemDefs) {
 func (me *LibKxArticulatedSystemDefs) Add(d *KxArticulatedSystemDef) (n *KxArticulatedSystemDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -408,8 +420,18 @@ func (me *LibKxArticulatedSystemDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibKxArticulatedSystemDefs.
 func (me *LibKxArticulatedSystemDefs) New(id string) (def *KxArticulatedSystemDef) { def = newKxArticulatedSystemDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the KxArticulatedSystemDef definitions in this LibKxArticulatedSystemDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in KxArticulatedSystemDef definitions already contained in me.
+func (me *LibKxArticulatedSystemDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the KxArticulatedSystemDef with the specified Id from this LibKxArticulatedSystemDefs.
-func (me *LibKxArticulatedSystemDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibKxArticulatedSystemDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibKxArticulatedSystemDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibKxArticulatedSystemDefs
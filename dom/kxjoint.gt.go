@@ -90,9 +90,9 @@ func newKxJointDef(id string) (me *KxJointDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *KxJointDef) DefaultInst() (inst *KxJointInst) {
-	if inst = defaultKxJointInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*KxJointInst); inst == nil {
 		inst = me.NewInst()
-		defaultKxJointInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -107,39 +107,50 @@ func (me *KxJointDef) NewInst() (inst *KxJointInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct KxJointDef
-//	according to the current me.DefRef value (by searching AllKxJointDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.KxJointDefLibs,
+//	or AllKxJointDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every KxJointInst's Def is nil initially, unless it was created via KxJointDef.NewInst().)
-func (me *KxJointInst) EnsureDef() *KxJointDef {
+func (me *KxJointInst) EnsureDef(reg *Registry) *KxJointDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.KxJointDef()
+		me.Def = me.DefRef.KxJointDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibKxJointDefs libraries associated by their Id.
-	AllKxJointDefLibs = LibsKxJointDef{}
+	//	This is the KxJointDefLibs of the DefaultRegistry.
+	AllKxJointDefLibs = DefaultRegistry.KxJointDefLibs
 
 	//	The "default" LibKxJointDefs library for KxJointDefs.
-	KxJointDefs = AllKxJointDefLibs.AddNew("")
-
-	defaultKxJointInsts = map[*KxJointDef]*KxJointInst{}
+	//	This is the KxJointDefs of the DefaultRegistry.
+	KxJointDefs = DefaultRegistry.KxJointDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllKxJointDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.KxJointDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.KxJointDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initKxJointDefs() {
+	me.KxJointDefLibs = LibsKxJointDef{}
+	me.KxJointDefs = me.KxJointDefLibs.AddNew("")
 }
 
-//	Searches (all LibKxJointDefs contained in AllKxJointDefLibs) for the KxJointDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) KxJointDef() (def *KxJointDef) {
+//	Searches (all LibKxJointDefs contained in reg.KxJointDefLibs, or AllKxJointDefLibs if reg is nil)
+//	for the KxJointDef whose Id is referenced by me, returning the first match found.
+func (me RefId) KxJointDef(reg *Registry) (def *KxJointDef) {
 	id := me.S()
-	for _, lib := range AllKxJointDefLibs {
+	for _, lib := range reg.orDefault().KxJointDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -147,7 +158,7 @@ func (me RefId) KxJointDef() (def *KxJointDef) {
 	return
 }
 
-//	The underlying type of the global AllKxJointDefLibs variable:
+//	The underlying type of the global AllKxJointDefLibs variable and the Registry.KxJointDefLibs field:
 //	a hash-table that contains LibKxJointDefs libraries associated by their Id.
 type LibsKxJointDef map[string]*LibKxJointDefs
 
@@ -189,6 +200,7 @@ func newLibKxJointDefs(id string) (me *LibKxJointDefs) {
 func (me *LibKxJointDefs) Add(d *KxJointDef) (n *KxJointDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -205,8 +217,18 @@ func (me *LibKxJointDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibKxJointDefs.
 func (me *LibKxJointDefs) New(id string) (def *KxJointDef) { def = newKxJointDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the KxJointDef definitions in this LibKxJointDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in KxJointDef definitions already contained in me.
+func (me *LibKxJointDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the KxJointDef with the specified Id from this LibKxJointDefs.
-func (me *LibKxJointDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibKxJointDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibKxJointDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibKxJointDefs
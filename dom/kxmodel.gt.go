@@ -112,9 +112,9 @@ func newKxModelDef(id string) (me *KxModelDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *KxModelDef) DefaultInst() (inst *KxModelInst) {
-	if inst = defaultKxModelInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*KxModelInst); inst == nil {
 		inst = me.NewInst()
-		defaultKxModelInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -129,39 +129,50 @@ func (me *KxModelDef) NewInst() (inst *KxModelInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct KxModelDef
-//	according to the current me.DefRef value (by searching AllKxModelDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.KxModelDefLibs,
+//	or AllKxModelDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every KxModelInst's Def is nil initially, unless it was created via KxModelDef.NewInst().)
-func (me *KxModelInst) EnsureDef() *KxModelDef {
+func (me *KxModelInst) EnsureDef(reg *Registry) *KxModelDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.KxModelDef()
+		me.Def = me.DefRef.KxModelDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibKxModelDefs libraries associated by their Id.
-	AllKxModelDefLibs = LibsKxModelDef{}
+	//	This is the KxModelDefLibs of the DefaultRegistry.
+	AllKxModelDefLibs = DefaultRegistry.KxModelDefLibs
 
 	//	The "default" LibKxModelDefs library for KxModelDefs.
-	KxModelDefs = AllKxModelDefLibs.AddNew("")
-
-	defaultKxModelInsts = map[*KxModelDef]*KxModelInst{}
+	//	This is the KxModelDefs of the DefaultRegistry.
+	KxModelDefs = DefaultRegistry.KxModelDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllKxModelDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.KxModelDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.KxModelDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initKxModelDefs() {
+	me.KxModelDefLibs = LibsKxModelDef{}
+	me.KxModelDefs = me.KxModelDefLibs.AddNew("")
 }
 
-//	Searches (all LibKxModelDefs contained in AllKxModelDefLibs) for the KxModelDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) KxModelDef() (def *KxModelDef) {
+//	Searches (all LibKxModelDefs contained in reg.KxModelDefLibs, or AllKxModelDefLibs if reg is nil)
+//	for the KxModelDef whose Id is referenced by me, returning the first match found.
+func (me RefId) KxModelDef(reg *Registry) (def *KxModelDef) {
 	id := me.S()
-	for _, lib := range AllKxModelDefLibs {
+	for _, lib := range reg.orDefault().KxModelDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -169,7 +180,7 @@ func (me RefId) KxModelDef() (def *KxModelDef) {
 	return
 }
 
-//	The underlying type of the global AllKxModelDefLibs variable:
+//	The underlying type of the global AllKxModelDefLibs variable and the Registry.KxModelDefLibs field:
 //	a hash-table that contains LibKxModelDefs libraries associated by their Id.
 type LibsKxModelDef map[string]*LibKxModelDefs
 
@@ -211,6 +222,7 @@ func newLibKxModelDefs(id string) (me *LibKxModelDefs) {
 func (me *LibKxModelDefs) Add(d *KxModelDef) (n *KxModelDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -227,8 +239,18 @@ func (me *LibKxModelDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibKxModelDefs.
 func (me *LibKxModelDefs) New(id string) (def *KxModelDef) { def = newKxModelDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the KxModelDef definitions in this LibKxModelDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in KxModelDef definitions already contained in me.
+func (me *LibKxModelDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the KxModelDef with the specified Id from this LibKxModelDefs.
-func (me *LibKxModelDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibKxModelDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibKxModelDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibKxModelDefs
@@ -91,9 +91,9 @@ func newKxSceneDef(id string) (me *KxSceneDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *KxSceneDef) DefaultInst() (inst *KxSceneInst) {
-	if inst = defaultKxSceneInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*KxSceneInst); inst == nil {
 		inst = me.NewInst()
-		defaultKxSceneInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -108,39 +108,50 @@ func (me *KxSceneDef) NewInst() (inst *KxSceneInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct KxSceneDef
-//	according to the current me.DefRef value (by searching AllKxSceneDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.KxSceneDefLibs,
+//	or AllKxSceneDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every KxSceneInst's Def is nil initially, unless it was created via KxSceneDef.NewInst().)
-func (me *KxSceneInst) EnsureDef() *KxSceneDef {
+func (me *KxSceneInst) EnsureDef(reg *Registry) *KxSceneDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.KxSceneDef()
+		me.Def = me.DefRef.KxSceneDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibKxSceneDefs libraries associated by their Id.
-	AllKxSceneDefLibs = LibsKxSceneDef{}
+	//	This is the KxSceneDefLibs of the DefaultRegistry.
+	AllKxSceneDefLibs = DefaultRegistry.KxSceneDefLibs
 
 	//	The "default" LibKxSceneDefs library for KxSceneDefs.
-	KxSceneDefs = AllKxSceneDefLibs.AddNew("")
-
-	defaultKxSceneInsts = map[*KxSceneDef]*KxSceneInst{}
+	//	This is the KxSceneDefs of the DefaultRegistry.
+	KxSceneDefs = DefaultRegistry.KxSceneDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllKxSceneDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.KxSceneDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.KxSceneDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initKxSceneDefs() {
+	me.KxSceneDefLibs = LibsKxSceneDef{}
+	me.KxSceneDefs = me.KxSceneDefLibs.AddNew("")
 }
 
-//	Searches (all LibKxSceneDefs contained in AllKxSceneDefLibs) for the KxSceneDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) KxSceneDef() (def *KxSceneDef) {
+//	Searches (all LibKxSceneDefs contained in reg.KxSceneDefLibs, or AllKxSceneDefLibs if reg is nil)
+//	for the KxSceneDef whose Id is referenced by me, returning the first match found.
+func (me RefId) KxSceneDef(reg *Registry) (def *KxSceneDef) {
 	id := me.S()
-	for _, lib := range AllKxSceneDefLibs {
+	for _, lib := range reg.orDefault().KxSceneDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -148,7 +159,7 @@ func (me RefId) KxSceneDef() (def *KxSceneDef) {
 	return
 }
 
-//	The underlying type of the global AllKxSceneDefLibs variable:
+//	The underlying type of the global AllKxSceneDefLibs variable and the Registry.KxSceneDefLibs field:
 //	a hash-table that contains LibKxSceneDefs libraries associated by their Id.
 type LibsKxSceneDef map[string]*LibKxSceneDefs
 
@@ -190,6 +201,7 @@ func newLibKxSceneDefs(id string) (me *LibKxSceneDefs) {
 func (me *LibKxSceneDefs) Add(d *KxSceneDef) (n *KxSceneDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -206,8 +218,18 @@ func (me *LibKxSceneDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibKxSceneDefs.
 func (me *LibKxSceneDefs) New(id string) (def *KxSceneDef) { def = newKxSceneDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the KxSceneDef definitions in this LibKxSceneDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in KxSceneDef definitions already contained in me.
+func (me *LibKxSceneDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the KxSceneDef with the specified Id from this LibKxSceneDefs.
-func (me *LibKxSceneDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibKxSceneDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibKxSceneDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibKxSceneDefs
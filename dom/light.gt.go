@@ -145,9 +145,9 @@ func newLightDef(id string) (me *LightDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *LightDef) DefaultInst() (inst *LightInst) {
-	if inst = defaultLightInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*LightInst); inst == nil {
 		inst = me.NewInst()
-		defaultLightInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -162,39 +162,50 @@ func (me *LightDef) NewInst() (inst *LightInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct LightDef
-//	according to the current me.DefRef value (by searching AllLightDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.LightDefLibs,
+//	or AllLightDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every LightInst's Def is nil initially, unless it was created via LightDef.NewInst().)
-func (me *LightInst) EnsureDef() *LightDef {
+func (me *LightInst) EnsureDef(reg *Registry) *LightDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.LightDef()
+		me.Def = me.DefRef.LightDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibLightDefs libraries associated by their Id.
-	AllLightDefLibs = LibsLightDef{}
+	//	This is the LightDefLibs of the DefaultRegistry.
+	AllLightDefLibs = DefaultRegistry.LightDefLibs
 
 	//	The "default" LibLightDefs library for LightDefs.
-	LightDefs = AllLightDefLibs.AddNew("")
-
-	defaultLightInsts = map[*LightDef]*LightInst{}
+	//	This is the LightDefs of the DefaultRegistry.
+	LightDefs = DefaultRegistry.LightDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllLightDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.LightDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.LightDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initLightDefs() {
+	me.LightDefLibs = LibsLightDef{}
+	me.LightDefs = me.LightDefLibs.AddNew("")
 }
 
-//	Searches (all LibLightDefs contained in AllLightDefLibs) for the LightDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) LightDef() (def *LightDef) {
+//	Searches (all LibLightDefs contained in reg.LightDefLibs, or AllLightDefLibs if reg is nil)
+//	for the LightDef whose Id is referenced by me, returning the first match found.
+func (me RefId) LightDef(reg *Registry) (def *LightDef) {
 	id := me.S()
-	for _, lib := range AllLightDefLibs {
+	for _, lib := range reg.orDefault().LightDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -202,7 +213,7 @@ func (me RefId) LightDef() (def *LightDef) {
 	return
 }
 
-//	The underlying type of the global AllLightDefLibs variable:
+//	The underlying type of the global AllLightDefLibs variable and the Registry.LightDefLibs field:
 //	a hash-table that contains LibLightDefs libraries associated by their Id.
 type LibsLightDef map[string]*LibLightDefs
 
@@ -244,6 +255,7 @@ func newLibLightDefs(id string) (me *LibLightDefs) {
 func (me *LibLightDefs) Add(d *LightDef) (n *LightDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -260,8 +272,18 @@ func (me *LibLightDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibLightDefs.
 func (me *LibLightDefs) New(id string) (def *LightDef) { def = newLightDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the LightDef definitions in this LibLightDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in LightDef definitions already contained in me.
+func (me *LibLightDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the LightDef with the specified Id from this LibLightDefs.
-func (me *LibLightDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibLightDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibLightDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibLightDefs
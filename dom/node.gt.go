@@ -82,9 +82,9 @@ func newNodeDef(id string) (me *NodeDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *NodeDef) DefaultInst() (inst *NodeInst) {
-	if inst = defaultNodeInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*NodeInst); inst == nil {
 		inst = me.NewInst()
-		defaultNodeInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -99,39 +99,50 @@ func (me *NodeDef) NewInst() (inst *NodeInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct NodeDef
-//	according to the current me.DefRef value (by searching AllNodeDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.NodeDefLibs,
+//	or AllNodeDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every NodeInst's Def is nil initially, unless it was created via NodeDef.NewInst().)
-func (me *NodeInst) EnsureDef() *NodeDef {
+func (me *NodeInst) EnsureDef(reg *Registry) *NodeDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.NodeDef()
+		me.Def = me.DefRef.NodeDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibNodeDefs libraries associated by their Id.
-	AllNodeDefLibs = LibsNodeDef{}
+	//	This is the NodeDefLibs of the DefaultRegistry.
+	AllNodeDefLibs = DefaultRegistry.NodeDefLibs
 
 	//	The "default" LibNodeDefs library for NodeDefs.
-	NodeDefs = AllNodeDefLibs.AddNew("")
-
-	defaultNodeInsts = map[*NodeDef]*NodeInst{}
+	//	This is the NodeDefs of the DefaultRegistry.
+	NodeDefs = DefaultRegistry.NodeDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllNodeDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.NodeDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.NodeDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initNodeDefs() {
+	me.NodeDefLibs = LibsNodeDef{}
+	me.NodeDefs = me.NodeDefLibs.AddNew("")
 }
 
-//	Searches (all LibNodeDefs contained in AllNodeDefLibs) for the NodeDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) NodeDef() (def *NodeDef) {
+//	Searches (all LibNodeDefs contained in reg.NodeDefLibs, or AllNodeDefLibs if reg is nil)
+//	for the NodeDef whose Id is referenced by me, returning the first match found.
+func (me RefId) NodeDef(reg *Registry) (def *NodeDef) {
 	id := me.S()
-	for _, lib := range AllNodeDefLibs {
+	for _, lib := range reg.orDefault().NodeDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -139,7 +150,7 @@ func (me RefId) NodeDef() (def *NodeDef) {
 	return
 }
 
-//	The underlying type of the global AllNodeDefLibs variable:
+//	The underlying type of the global AllNodeDefLibs variable and the Registry.NodeDefLibs field:
 //	a hash-table that contains LibNodeDefs libraries associated by their Id.
 type LibsNodeDef map[string]*LibNodeDefs
 
@@ -181,6 +192,7 @@ func newLibNodeDefs(id string) (me *LibNodeDefs) {
 func (me *LibNodeDefs) Add(d *NodeDef) (n *NodeDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -197,8 +209,18 @@ func (me *LibNodeDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibNodeDefs.
 func (me *LibNodeDefs) New(id string) (def *NodeDef) { def = newNodeDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the NodeDef definitions in this LibNodeDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in NodeDef definitions already contained in me.
+func (me *LibNodeDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the NodeDef with the specified Id from this LibNodeDefs.
-func (me *LibNodeDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibNodeDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibNodeDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibNodeDefs
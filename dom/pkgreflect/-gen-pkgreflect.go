@@ -115,6 +115,7 @@ var Types = map[string]reflect.Type{
 	"HasFxParamDefs": reflect.TypeOf((*cdom.HasFxParamDefs)(nil)).Elem(),
 	"ParamInsts": reflect.TypeOf((*cdom.ParamInsts)(nil)).Elem(),
 	"Scene": reflect.TypeOf((*cdom.Scene)(nil)).Elem(),
+	"Registry": reflect.TypeOf((*cdom.Registry)(nil)).Elem(),
 	"ParamInst": reflect.TypeOf((*cdom.ParamInst)(nil)).Elem(),
 	"AssetGeographicLocation": reflect.TypeOf((*cdom.AssetGeographicLocation)(nil)).Elem(),
 	"HasExtras": reflect.TypeOf((*cdom.HasExtras)(nil)).Elem(),
@@ -322,6 +323,7 @@ var Functions = map[string]reflect.Value{
 	"NewFxSamplerStates": reflect.ValueOf(cdom.NewFxSamplerStates),
 	"NewFxImageInitFrom": reflect.ValueOf(cdom.NewFxImageInitFrom),
 	"NewAsset": reflect.ValueOf(cdom.NewAsset),
+	"NewRegistry": reflect.ValueOf(cdom.NewRegistry),
 	"NewPxRigidConstraintSpring": reflect.ValueOf(cdom.NewPxRigidConstraintSpring),
 	"NewKxEffector": reflect.ValueOf(cdom.NewKxEffector),
 	"NewKxMotionAxis": reflect.ValueOf(cdom.NewKxMotionAxis),
@@ -44,9 +44,9 @@ func newPxForceFieldDef(id string) (me *PxForceFieldDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *PxForceFieldDef) DefaultInst() (inst *PxForceFieldInst) {
-	if inst = defaultPxForceFieldInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*PxForceFieldInst); inst == nil {
 		inst = me.NewInst()
-		defaultPxForceFieldInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -61,39 +61,50 @@ func (me *PxForceFieldDef) NewInst() (inst *PxForceFieldInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct PxForceFieldDef
-//	according to the current me.DefRef value (by searching AllPxForceFieldDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.PxForceFieldDefLibs,
+//	or AllPxForceFieldDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every PxForceFieldInst's Def is nil initially, unless it was created via PxForceFieldDef.NewInst().)
-func (me *PxForceFieldInst) EnsureDef() *PxForceFieldDef {
+func (me *PxForceFieldInst) EnsureDef(reg *Registry) *PxForceFieldDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.PxForceFieldDef()
+		me.Def = me.DefRef.PxForceFieldDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibPxForceFieldDefs libraries associated by their Id.
-	AllPxForceFieldDefLibs = LibsPxForceFieldDef{}
+	//	This is the PxForceFieldDefLibs of the DefaultRegistry.
+	AllPxForceFieldDefLibs = DefaultRegistry.PxForceFieldDefLibs
 
 	//	The "default" LibPxForceFieldDefs library for PxForceFieldDefs.
-	PxForceFieldDefs = AllPxForceFieldDefLibs.AddNew("")
-
-	defaultPxForceFieldInsts = map[*PxForceFieldDef]*PxForceFieldInst{}
+	//	This is the PxForceFieldDefs of the DefaultRegistry.
+	PxForceFieldDefs = DefaultRegistry.PxForceFieldDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllPxForceFieldDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.PxForceFieldDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.PxForceFieldDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initPxForceFieldDefs() {
+	me.PxForceFieldDefLibs = LibsPxForceFieldDef{}
+	me.PxForceFieldDefs = me.PxForceFieldDefLibs.AddNew("")
 }
 
-//	Searches (all LibPxForceFieldDefs contained in AllPxForceFieldDefLibs) for the PxForceFieldDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) PxForceFieldDef() (def *PxForceFieldDef) {
+//	Searches (all LibPxForceFieldDefs contained in reg.PxForceFieldDefLibs, or AllPxForceFieldDefLibs if reg is nil)
+//	for the PxForceFieldDef whose Id is referenced by me, returning the first match found.
+func (me RefId) PxForceFieldDef(reg *Registry) (def *PxForceFieldDef) {
 	id := me.S()
-	for _, lib := range AllPxForceFieldDefLibs {
+	for _, lib := range reg.orDefault().PxForceFieldDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -101,7 +112,7 @@ func (me RefId) PxForceFieldDef() (def *PxForceFieldDef) {
 	return
 }
 
-//	The underlying type of the global AllPxForceFieldDefLibs variable:
+//	The underlying type of the global AllPxForceFieldDefLibs variable and the Registry.PxForceFieldDefLibs field:
 //	a hash-table that contains LibPxForceFieldDefs libraries associated by their Id.
 type LibsPxForceFieldDef map[string]*LibPxForceFieldDefs
 
@@ -143,6 +154,7 @@ func newLibPxForceFieldDefs(id string) (me *LibPxForceFieldDefs) {
 func (me *LibPxForceFieldDefs) Add(d *PxForceFieldDef) (n *PxForceFieldDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -159,8 +171,18 @@ func (me *LibPxForceFieldDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibPxForceFieldDefs.
 func (me *LibPxForceFieldDefs) New(id string) (def *PxForceFieldDef) { def = newPxForceFieldDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the PxForceFieldDef definitions in this LibPxForceFieldDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in PxForceFieldDef definitions already contained in me.
+func (me *LibPxForceFieldDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the PxForceFieldDef with the specified Id from this LibPxForceFieldDefs.
-func (me *LibPxForceFieldDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibPxForceFieldDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibPxForceFieldDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibPxForceFieldDefs
@@ -55,9 +55,9 @@ func newPxMaterialDef(id string) (me *PxMaterialDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *PxMaterialDef) DefaultInst() (inst *PxMaterialInst) {
-	if inst = defaultPxMaterialInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*PxMaterialInst); inst == nil {
 		inst = me.NewInst()
-		defaultPxMaterialInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -72,39 +72,50 @@ func (me *PxMaterialDef) NewInst() (inst *PxMaterialInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct PxMaterialDef
-//	according to the current me.DefRef value (by searching AllPxMaterialDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.PxMaterialDefLibs,
+//	or AllPxMaterialDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every PxMaterialInst's Def is nil initially, unless it was created via PxMaterialDef.NewInst().)
-func (me *PxMaterialInst) EnsureDef() *PxMaterialDef {
+func (me *PxMaterialInst) EnsureDef(reg *Registry) *PxMaterialDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.PxMaterialDef()
+		me.Def = me.DefRef.PxMaterialDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibPxMaterialDefs libraries associated by their Id.
-	AllPxMaterialDefLibs = LibsPxMaterialDef{}
+	//	This is the PxMaterialDefLibs of the DefaultRegistry.
+	AllPxMaterialDefLibs = DefaultRegistry.PxMaterialDefLibs
 
 	//	The "default" LibPxMaterialDefs library for PxMaterialDefs.
-	PxMaterialDefs = AllPxMaterialDefLibs.AddNew("")
-
-	defaultPxMaterialInsts = map[*PxMaterialDef]*PxMaterialInst{}
+	//	This is the PxMaterialDefs of the DefaultRegistry.
+	PxMaterialDefs = DefaultRegistry.PxMaterialDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllPxMaterialDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.PxMaterialDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.PxMaterialDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initPxMaterialDefs() {
+	me.PxMaterialDefLibs = LibsPxMaterialDef{}
+	me.PxMaterialDefs = me.PxMaterialDefLibs.AddNew("")
 }
 
-//	Searches (all LibPxMaterialDefs contained in AllPxMaterialDefLibs) for the PxMaterialDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) PxMaterialDef() (def *PxMaterialDef) {
+//	Searches (all LibPxMaterialDefs contained in reg.PxMaterialDefLibs, or AllPxMaterialDefLibs if reg is nil)
+//	for the PxMaterialDef whose Id is referenced by me, returning the first match found.
+func (me RefId) PxMaterialDef(reg *Registry) (def *PxMaterialDef) {
 	id := me.S()
-	for _, lib := range AllPxMaterialDefLibs {
+	for _, lib := range reg.orDefault().PxMaterialDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -112,7 +123,7 @@ func (me RefId) PxMaterialDef() (def *PxMaterialDef) {
 	return
 }
 
-//	The underlying type of the global AllPxMaterialDefLibs variable:
+//	The underlying type of the global AllPxMaterialDefLibs variable and the Registry.PxMaterialDefLibs field:
 //	a hash-table that contains LibPxMaterialDefs libraries associated by their Id.
 type LibsPxMaterialDef map[string]*LibPxMaterialDefs
 
@@ -154,6 +165,7 @@ func newLibPxMaterialDefs(id string) (me *LibPxMaterialDefs) {
 func (me *LibPxMaterialDefs) Add(d *PxMaterialDef) (n *PxMaterialDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -170,8 +182,18 @@ func (me *LibPxMaterialDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibPxMaterialDefs.
 func (me *LibPxMaterialDefs) New(id string) (def *PxMaterialDef) { def = newPxMaterialDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the PxMaterialDef definitions in this LibPxMaterialDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in PxMaterialDef definitions already contained in me.
+func (me *LibPxMaterialDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the PxMaterialDef with the specified Id from this LibPxMaterialDefs.
-func (me *LibPxMaterialDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibPxMaterialDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibPxMaterialDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibPxMaterialDefs
@@ -70,9 +70,9 @@ func newPxModelDef(id string) (me *PxModelDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *PxModelDef) DefaultInst() (inst *PxModelInst) {
-	if inst = defaultPxModelInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*PxModelInst); inst == nil {
 		inst = me.NewInst()
-		defaultPxModelInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -87,39 +87,50 @@ func (me *PxModelDef) NewInst() (inst *PxModelInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct PxModelDef
-//	according to the current me.DefRef value (by searching AllPxModelDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.PxModelDefLibs,
+//	or AllPxModelDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every PxModelInst's Def is nil initially, unless it was created via PxModelDef.NewInst().)
-func (me *PxModelInst) EnsureDef() *PxModelDef {
+func (me *PxModelInst) EnsureDef(reg *Registry) *PxModelDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.PxModelDef()
+		me.Def = me.DefRef.PxModelDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibPxModelDefs libraries associated by their Id.
-	AllPxModelDefLibs = LibsPxModelDef{}
+	//	This is the PxModelDefLibs of the DefaultRegistry.
+	AllPxModelDefLibs = DefaultRegistry.PxModelDefLibs
 
 	//	The "default" LibPxModelDefs library for PxModelDefs.
-	PxModelDefs = AllPxModelDefLibs.AddNew("")
-
-	defaultPxModelInsts = map[*PxModelDef]*PxModelInst{}
+	//	This is the PxModelDefs of the DefaultRegistry.
+	PxModelDefs = DefaultRegistry.PxModelDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllPxModelDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.PxModelDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.PxModelDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initPxModelDefs() {
+	me.PxModelDefLibs = LibsPxModelDef{}
+	me.PxModelDefs = me.PxModelDefLibs.AddNew("")
 }
 
-//	Searches (all LibPxModelDefs contained in AllPxModelDefLibs) for the PxModelDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) PxModelDef() (def *PxModelDef) {
+//	Searches (all LibPxModelDefs contained in reg.PxModelDefLibs, or AllPxModelDefLibs if reg is nil)
+//	for the PxModelDef whose Id is referenced by me, returning the first match found.
+func (me RefId) PxModelDef(reg *Registry) (def *PxModelDef) {
 	id := me.S()
-	for _, lib := range AllPxModelDefLibs {
+	for _, lib := range reg.orDefault().PxModelDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -127,7 +138,7 @@ func (me RefId) PxModelDef() (def *PxModelDef) {
 	return
 }
 
-//	The underlying type of the global AllPxModelDefLibs variable:
+//	The underlying type of the global AllPxModelDefLibs variable and the Registry.PxModelDefLibs field:
 //	a hash-table that contains LibPxModelDefs libraries associated by their Id.
 type LibsPxModelDef map[string]*LibPxModelDefs
 
@@ -169,6 +180,7 @@ func newLibPxModelDefs(id string) (me *LibPxModelDefs) {
 func (me *LibPxModelDefs) Add(d *PxModelDef) (n *PxModelDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -185,8 +197,18 @@ func (me *LibPxModelDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibPxModelDefs.
 func (me *LibPxModelDefs) New(id string) (def *PxModelDef) { def = newPxModelDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the PxModelDef definitions in this LibPxModelDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in PxModelDef definitions already contained in me.
+func (me *LibPxModelDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the PxModelDef with the specified Id from this LibPxModelDefs.
-func (me *LibPxModelDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibPxModelDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibPxModelDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibPxModelDefs
@@ -123,7 +123,7 @@ type PxRigidBodyInst struct {
 
 	//	A pointer to the resource definition referenced by this instance.
 	//	Is nil by default (unless created via Def.NewInst()) and meant to be set ONLY by
-	//	the EnsureDef() method (which uses BaseInst.DefRef to find it).
+	//	the EnsureDef(reg) method (which uses BaseInst.DefRef to find it).
 	Def *PxRigidBodyDef
 
 	//	Techniques
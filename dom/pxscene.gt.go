@@ -60,9 +60,9 @@ func newPxSceneDef(id string) (me *PxSceneDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *PxSceneDef) DefaultInst() (inst *PxSceneInst) {
-	if inst = defaultPxSceneInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*PxSceneInst); inst == nil {
 		inst = me.NewInst()
-		defaultPxSceneInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -77,39 +77,50 @@ func (me *PxSceneDef) NewInst() (inst *PxSceneInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct PxSceneDef
-//	according to the current me.DefRef value (by searching AllPxSceneDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.PxSceneDefLibs,
+//	or AllPxSceneDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every PxSceneInst's Def is nil initially, unless it was created via PxSceneDef.NewInst().)
-func (me *PxSceneInst) EnsureDef() *PxSceneDef {
+func (me *PxSceneInst) EnsureDef(reg *Registry) *PxSceneDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.PxSceneDef()
+		me.Def = me.DefRef.PxSceneDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibPxSceneDefs libraries associated by their Id.
-	AllPxSceneDefLibs = LibsPxSceneDef{}
+	//	This is the PxSceneDefLibs of the DefaultRegistry.
+	AllPxSceneDefLibs = DefaultRegistry.PxSceneDefLibs
 
 	//	The "default" LibPxSceneDefs library for PxSceneDefs.
-	PxSceneDefs = AllPxSceneDefLibs.AddNew("")
-
-	defaultPxSceneInsts = map[*PxSceneDef]*PxSceneInst{}
+	//	This is the PxSceneDefs of the DefaultRegistry.
+	PxSceneDefs = DefaultRegistry.PxSceneDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllPxSceneDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.PxSceneDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.PxSceneDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initPxSceneDefs() {
+	me.PxSceneDefLibs = LibsPxSceneDef{}
+	me.PxSceneDefs = me.PxSceneDefLibs.AddNew("")
 }
 
-//	Searches (all LibPxSceneDefs contained in AllPxSceneDefLibs) for the PxSceneDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) PxSceneDef() (def *PxSceneDef) {
+//	Searches (all LibPxSceneDefs contained in reg.PxSceneDefLibs, or AllPxSceneDefLibs if reg is nil)
+//	for the PxSceneDef whose Id is referenced by me, returning the first match found.
+func (me RefId) PxSceneDef(reg *Registry) (def *PxSceneDef) {
 	id := me.S()
-	for _, lib := range AllPxSceneDefLibs {
+	for _, lib := range reg.orDefault().PxSceneDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -117,7 +128,7 @@ func (me RefId) PxSceneDef() (def *PxSceneDef) {
 	return
 }
 
-//	The underlying type of the global AllPxSceneDefLibs variable:
+//	The underlying type of the global AllPxSceneDefLibs variable and the Registry.PxSceneDefLibs field:
 //	a hash-table that contains LibPxSceneDefs libraries associated by their Id.
 type LibsPxSceneDef map[string]*LibPxSceneDefs
 
@@ -159,6 +170,7 @@ func newLibPxSceneDefs(id string) (me *LibPxSceneDefs) {
 func (me *LibPxSceneDefs) Add(d *PxSceneDef) (n *PxSceneDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -175,8 +187,18 @@ func (me *LibPxSceneDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibPxSceneDefs.
 func (me *LibPxSceneDefs) New(id string) (def *PxSceneDef) { def = newPxSceneDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the PxSceneDef definitions in this LibPxSceneDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in PxSceneDef definitions already contained in me.
+func (me *LibPxSceneDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the PxSceneDef with the specified Id from this LibPxSceneDefs.
-func (me *LibPxSceneDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibPxSceneDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibPxSceneDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibPxSceneDefs
@@ -0,0 +1,219 @@
+package cdom
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//	Implemented by select RefSidFielders whose supported field names are not simply
+//	the names of their struct fields, such as the "X" of a Transform or the "R" of an FxColor.
+type refSidFieldNamer interface {
+	accessFieldNames() []string
+}
+
+func (me *FxColor) accessFieldNames() []string {
+	return []string{"R", "G", "B", "A"}
+}
+
+func (me *SidVec3) accessFieldNames() []string {
+	return []string{"X", "Y", "Z"}
+}
+
+func (me *Transform) accessFieldNames() []string {
+	return []string{"X", "Y", "Z", "ANGLE"}
+}
+
+//	Returns the shortest Sid path that resolves to ptr when passed to RefSid.Resolve() along with root, or nil
+//	if there is none. ptr must be a pointer, such as a *Transform or a *float64 pointing into its F.
+//	
+//	The candidates are found via the same Sid tables that RefSid.Resolve() uses, and are tried in order of
+//	length (then alphabetically): so if root is itself a resource definition (or another non-lib RefSidRoot),
+//	paths are relative to it (such as "translate.X" or "./arm/translate.X"). If root is a lib or a Registry,
+//	paths start with the Id of an object found by root (such as "some-node-id/translate.X"). A member selector
+//	(such as ".X" or ".ANGLE") is used if ptr is a field of the Sid-addressed value, otherwise an array selector:
+//	"(row)(column)" for the elements of matrices (TransformKindMatrix Transforms and the FooRxC types such as
+//	Float4x4), or else "(index)".
+func SidPathOf(root RefSidRoot, ptr interface{}) (path *SidPath) {
+	if (root == nil) || (ptr == nil) || (reflect.ValueOf(ptr).Kind() != reflect.Ptr) {
+		return
+	}
+	var ids []string
+	if sidResolverOf(root, ".") != nil {
+		ids = []string{"."}
+	} else {
+		ids = sidRootIds(root)
+	}
+	var candidates []*SidPath
+	for _, id := range ids {
+		if resolver := sidResolverOf(root, id); resolver != nil {
+			bag := &refSidBag{visit: func(sids []string, val interface{}) {
+				for _, sels := range sidSelectorsTo(val, ptr) {
+					candidates = append(candidates, newSidPath(id, sids, sels))
+				}
+			}}
+			resolver.sidResolve(nil, bag)
+		}
+	}
+	sort.Sort(sidPathsByLength(candidates))
+	for _, candidate := range candidates {
+		if candidate.Resolve(root) == ptr {
+			path = candidate
+			return
+		}
+	}
+	return
+}
+
+//	Returns the Ids of all objects in the libraries of root (if root is a Registry) or in root (if root is a lib).
+func sidRootIds(root RefSidRoot) (ids []string) {
+	var libs []*BaseLib
+	if reg, _ := root.(*Registry); reg != nil {
+		for _, libsOf := range libsOfRegistry {
+			libs = append(libs, libsOf(reg)...)
+		}
+	} else if rv := reflect.ValueOf(root); rv.Kind() == reflect.Ptr {
+		if bl := rv.Elem().FieldByName("BaseLib"); bl.IsValid() && bl.CanAddr() {
+			libs = append(libs, bl.Addr().Interface().(*BaseLib))
+		}
+	}
+	for _, lib := range libs {
+		for id := range lib.index.entries {
+			ids = append(ids, id)
+		}
+	}
+	return
+}
+
+//	Returns the selectors with which val yields ptr: one empty selector list if val is ptr, and otherwise
+//	at most one member selector and one array selector.
+func sidSelectorsTo(val, ptr interface{}) (sels [][]SidSelector) {
+	if val == ptr {
+		sels = append(sels, nil)
+		return
+	}
+	if _, ok := val.(RefSidFielder); ok {
+		var names []string
+		if namer, _ := val.(refSidFieldNamer); namer != nil {
+			names = namer.accessFieldNames()
+		} else if rt := reflect.TypeOf(val).Elem(); rt.Kind() == reflect.Struct {
+			for i := 0; i < rt.NumField(); i++ {
+				if sf := rt.Field(i); (len(sf.PkgPath) == 0) && !sf.Anonymous {
+					names = append(names, sf.Name)
+				}
+			}
+		}
+		for _, name := range names {
+			if sel := (SidSelector{Member: name}); sel.apply(val) == ptr {
+				sels = append(sels, []SidSelector{sel})
+				break
+			}
+		}
+	}
+	if _, ok := val.(RefSidIndexer); ok {
+		var res interface{}
+		for i := 0; ; i++ {
+			sel := SidSelector{Index: []int{i}}
+			if res = sel.apply(val); res == nil {
+				break
+			} else if res == ptr {
+				if cols := matrixCols(val); cols > 0 {
+					sel.Index = []int{i / cols, i % cols}
+				}
+				sels = append(sels, []SidSelector{sel})
+				break
+			}
+		}
+	}
+	return
+}
+
+//	Returns the number of columns if val is a matrix that supports two-dimensional indices, or else 0.
+func matrixCols(val interface{}) int {
+	switch v := val.(type) {
+	case *Transform:
+		if v.Kind == TransformKindMatrix {
+			return 4
+		}
+	case *Float2x2, *Float3x2, *Float4x2, *Int2x2:
+		return 2
+	case *Float2x3, *Float3x3, *Float4x3, *Int3x3:
+		return 3
+	case *Float2x4, *Float3x4, *Float4x4, *Int4x4:
+		return 4
+	}
+	return 0
+}
+
+//	Creates a SidPath from its parts, setting its S to the shortest form that ParseSidPath() parses back into them.
+func newSidPath(id string, sids []string, selectors []SidSelector) (me *SidPath) {
+	escape := func(s, special string) string {
+		var buf []byte
+		for i := 0; i < len(s); i++ {
+			if strings.IndexByte(special, s[i]) >= 0 {
+				buf = append(buf, '\\')
+			}
+			buf = append(buf, s[i])
+		}
+		return string(buf)
+	}
+	me = &SidPath{Id: id, Sids: sids, Selectors: selectors}
+	parts := make([]string, 0, len(sids)+1)
+	if (id != ".") || (len(sids) > 1) {
+		parts = append(parts, escape(id, "/\\"))
+	}
+	for _, sid := range sids {
+		parts = append(parts, escape(sid, "/.()\\"))
+	}
+	me.S = strings.Join(parts, "/")
+	for _, sel := range selectors {
+		if len(sel.Member) > 0 {
+			me.S += "." + escape(sel.Member, "/.()\\")
+		} else {
+			for _, i := range sel.Index {
+				me.S += sfmt("(%d)", i)
+			}
+		}
+	}
+	return
+}
+
+type sidPathsByLength []*SidPath
+
+func (me sidPathsByLength) Len() int { return len(me) }
+
+func (me sidPathsByLength) Less(i, j int) bool {
+	if len(me[i].S) == len(me[j].S) {
+		return me[i].S < me[j].S
+	}
+	return len(me[i].S) < len(me[j].S)
+}
+
+func (me sidPathsByLength) Swap(i, j int) {
+	me[i], me[j] = me[j], me[i]
+}
+
+//	Returns the canonical url of obj within a Collada document, that is, "#" followed by its Id, if obj is a
+//	resource definition in the libraries of me (or an object with its own Id contained in one, such as a Source
+//	or a NodeDef inside a VisualSceneDef), or else an empty string. Without the leading "#", the result
+//	is the RefId referring to obj, such as the DefRef of an instance of obj.
+func (me *Registry) UrlOf(obj interface{}) (url string) {
+	if (obj == nil) || (reflect.ValueOf(obj).Kind() != reflect.Ptr) {
+		return
+	}
+	me = me.orDefault()
+	if rv := reflect.ValueOf(obj).Elem(); rv.Kind() == reflect.Struct {
+		if hid := rv.FieldByName("HasId"); hid.IsValid() && (hid.Type() == typeHasId) {
+			id := hid.FieldByName("Id").String()
+			for _, libs := range libsOfRegistry {
+				for _, lib := range libs(me) {
+					if lib.index.contains(id, obj) {
+						url = "#" + id
+						return
+					}
+				}
+			}
+		}
+	}
+	return
+}
@@ -1,339 +1,205 @@
 package cdom
 
 //	References a resource by its unique identifier (Id).
+//	All lookup methods of RefId use the Id indices maintained by the Add() and Remove() methods of the
+//	searched libraries, so each lookup costs one hash-table access per library searched. If the index of
+//	a library has no entry for the Id (such as for a Source added in-place to an already indexed GeometryDef),
+//	the lookup walks all resource definitions in that library instead, finding such objects even before a Reindex().
 type RefId string
 
-//	Searches (all LibAnimationDefs contained in AllAnimationDefLibs) for the AnimationSampler
+//	Searches (all LibAnimationDefs contained in reg.AnimationDefLibs, or DefaultRegistry if reg is nil) for the AnimationSampler
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) AnimationSampler() (as *AnimationSampler) {
-	var (
-		def *AnimationDef
-		id  = me.S()
-	)
-	for _, lib := range AllAnimationDefLibs {
-		for _, def = range lib.M {
-			for _, as = range def.Samplers {
-				if as.Id == id {
-					return
-				}
+func (me RefId) AnimationSampler(reg *Registry) (as *AnimationSampler) {
+	for _, lib := range reg.orDefault().AnimationDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if as, _ = entry.obj.(*AnimationSampler); as != nil {
+				return
 			}
-			as = nil
 		}
 	}
 	return
 }
 
-//	Searches (all LibAnimationDefs contained in AllAnimationDefLibs) for the SourceArray
+//	Searches (all LibAnimationDefs contained in reg.AnimationDefLibs, or DefaultRegistry if reg is nil) for the SourceArray
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) ArrayInAnimationDef() *SourceArray {
-	var (
-		s   *Source
-		def *AnimationDef
-		id  = me.S()
-	)
-	for _, lib := range AllAnimationDefLibs {
-		for _, def = range lib.M {
-			for _, s = range def.Sources {
-				if s.Array.Id == id {
-					return &s.Array
-				}
-			}
+func (me RefId) ArrayInAnimationDef(reg *Registry) (sa *SourceArray) {
+	for _, lib := range reg.orDefault().AnimationDefLibs {
+		if sa = lib.index.sourceArray(me.S()); sa != nil {
+			return
 		}
 	}
-	return nil
+	return
 }
 
 //	Calls the ArrayInAnimationDef(), ArrayInControllerDef() and ArrayInGeometryDef() methods in that order to find srcArr.
-func (me RefId) ArrayInAnyDef() (srcArr *SourceArray) {
-	if srcArr = me.ArrayInAnimationDef(); srcArr == nil {
-		if srcArr = me.ArrayInControllerDef(); srcArr == nil {
-			srcArr = me.ArrayInGeometryDef()
+func (me RefId) ArrayInAnyDef(reg *Registry) (srcArr *SourceArray) {
+	if srcArr = me.ArrayInAnimationDef(reg); srcArr == nil {
+		if srcArr = me.ArrayInControllerDef(reg); srcArr == nil {
+			srcArr = me.ArrayInGeometryDef(reg)
 		}
 	}
 	return
 }
 
-//	Searches (all LibControllerDefs contained in AllControllerDefLibs) for the SourceArray
+//	Searches (all LibControllerDefs contained in reg.ControllerDefLibs, or DefaultRegistry if reg is nil) for the SourceArray
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) ArrayInControllerDef() *SourceArray {
-	var (
-		s   *Source
-		cs  Sources
-		def *ControllerDef
-		id  = me.S()
-	)
-	for _, lib := range AllControllerDefLibs {
-		for _, def = range lib.M {
-			if cs = nil; def.Morph != nil {
-				cs = def.Morph.Sources
-			} else if def.Skin != nil {
-				cs = def.Skin.Sources
-			}
-			if cs != nil {
-				for _, s = range cs {
-					if s.Array.Id == id {
-						return &s.Array
-					}
-				}
-			}
+func (me RefId) ArrayInControllerDef(reg *Registry) (sa *SourceArray) {
+	for _, lib := range reg.orDefault().ControllerDefLibs {
+		if sa = lib.index.sourceArray(me.S()); sa != nil {
+			return
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the SourceArray
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the SourceArray
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) ArrayInGeometryDef() (sa *SourceArray) {
-	var (
-		gbc *GeometryBrepCurve
-		gbs *GeometryBrepSurface
-		def *GeometryDef
-		s   *Source
-		id  = me.S()
-		ret = func(cs Sources) bool {
-			for _, s = range cs {
-				if s.Array.Id == id {
-					sa = &s.Array
-					return true
-				}
-			}
-			return false
-		}
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Mesh != nil) && ret(def.Mesh.Sources) {
-				return
-			}
-			if (def.Spline != nil) && ret(def.Spline.Sources) {
-				return
-			}
-			if def.Brep != nil {
-				if ret(def.Brep.Sources) {
-					return
-				}
-				if def.Brep.Surfaces != nil {
-					for _, gbs = range def.Brep.Surfaces.All {
-						if (gbs.Element.NurbsSurface != nil) && ret(gbs.Element.NurbsSurface.Sources) {
-							return
-						} else if (gbs.Element.SweptSurface != nil) && (gbs.Element.SweptSurface.Curve != nil) && (gbs.Element.SweptSurface.Curve.Element.Nurbs != nil) && ret(gbs.Element.SweptSurface.Curve.Element.Nurbs.Sources) {
-							return
-						}
-					}
-				}
-				if def.Brep.Curves != nil {
-					for _, gbc = range def.Brep.Curves.All {
-						if (gbc.Element.Nurbs != nil) && ret(gbc.Element.Nurbs.Sources) {
-							return
-						}
-					}
-				}
-				if def.Brep.SurfaceCurves != nil {
-					for _, gbc = range def.Brep.SurfaceCurves.All {
-						if (gbc.Element.Nurbs != nil) && ret(gbc.Element.Nurbs.Sources) {
-							return
-						}
-					}
-				}
-			}
+func (me RefId) ArrayInGeometryDef(reg *Registry) (sa *SourceArray) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		if sa = lib.index.sourceArray(me.S()); sa != nil {
+			return
 		}
 	}
 	return
 }
 
-//	Searches (all LibFxEffectDefs contained in AllFxEffectDefLibs) for the FxProfile
+//	Searches (all LibFxEffectDefs contained in reg.FxEffectDefLibs, or DefaultRegistry if reg is nil) for the FxProfile
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxProfile() (fp *FxProfile) {
-	var (
-		def *FxEffectDef
-		id  = me.S()
-	)
-	for _, lib := range AllFxEffectDefLibs {
-		for _, def = range lib.M {
-			for _, fp = range def.Profiles {
-				if fp.Id == id {
-					return
-				}
+func (me RefId) FxProfile(reg *Registry) (fp *FxProfile) {
+	for _, lib := range reg.orDefault().FxEffectDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if fp, _ = entry.obj.(*FxProfile); fp != nil {
+				return
 			}
-			fp = nil
 		}
 	}
 	return
 }
 
-//	Searches (all LibFxEffectDefs contained in AllFxEffectDefLibs) for the FxTechniqueCommon
+//	Searches (all LibFxEffectDefs contained in reg.FxEffectDefLibs, or DefaultRegistry if reg is nil) for the FxTechniqueCommon
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxTechniqueCommon() *FxTechniqueCommon {
-	var (
-		def *FxEffectDef
-		fp  *FxProfile
-		id  = me.S()
-	)
-	for _, lib := range AllFxEffectDefLibs {
-		for _, def = range lib.M {
-			for _, fp = range def.Profiles {
-				if (fp.Common != nil) && (fp.Common.Technique.Id == id) {
-					return &fp.Common.Technique
-				}
+func (me RefId) FxTechniqueCommon(reg *Registry) (t *FxTechniqueCommon) {
+	for _, lib := range reg.orDefault().FxEffectDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if t, _ = entry.obj.(*FxTechniqueCommon); t != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibFxEffectDefs contained in AllFxEffectDefLibs) for the FxTechniqueGlsl
+//	Searches (all LibFxEffectDefs contained in reg.FxEffectDefLibs, or DefaultRegistry if reg is nil) for the FxTechniqueGlsl
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) FxTechniqueGlsl() (t *FxTechniqueGlsl) {
-	var (
-		def *FxEffectDef
-		fp  *FxProfile
-		id  = me.S()
-	)
-	for _, lib := range AllFxEffectDefLibs {
-		for _, def = range lib.M {
-			for _, fp = range def.Profiles {
-				if fp.Glsl != nil {
-					for _, t = range fp.Glsl.Techniques {
-						if t.Id == id {
-							return
-						}
-					}
-					t = nil
-				}
+func (me RefId) FxTechniqueGlsl(reg *Registry) (t *FxTechniqueGlsl) {
+	for _, lib := range reg.orDefault().FxEffectDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if t, _ = entry.obj.(*FxTechniqueGlsl); t != nil {
+				return
 			}
 		}
 	}
 	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepEdges
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepEdges
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepEdges() *GeometryBrepEdges {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Edges != nil) && (def.Brep.Edges.Id == id) {
-				return def.Brep.Edges
+func (me RefId) GeometryBrepEdges(reg *Registry) (obj *GeometryBrepEdges) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepEdges); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepFaces
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepFaces
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepFaces() *GeometryBrepFaces {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Faces != nil) && (def.Brep.Faces.Id == id) {
-				return def.Brep.Faces
+func (me RefId) GeometryBrepFaces(reg *Registry) (obj *GeometryBrepFaces) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepFaces); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepPcurves
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepPcurves
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepPcurves() *GeometryBrepPcurves {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Pcurves != nil) && (def.Brep.Pcurves.Id == id) {
-				return def.Brep.Pcurves
+func (me RefId) GeometryBrepPcurves(reg *Registry) (obj *GeometryBrepPcurves) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepPcurves); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepShells
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepShells
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepShells() *GeometryBrepShells {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Shells != nil) && (def.Brep.Shells.Id == id) {
-				return def.Brep.Shells
+func (me RefId) GeometryBrepShells(reg *Registry) (obj *GeometryBrepShells) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepShells); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepSolids
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepSolids
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepSolids() *GeometryBrepSolids {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Solids != nil) && (def.Brep.Solids.Id == id) {
-				return def.Brep.Solids
+func (me RefId) GeometryBrepSolids(reg *Registry) (obj *GeometryBrepSolids) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepSolids); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryDef
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryDef
 //	whose Id is referenced by me, returning the Mesh of the first match found.
-func (me RefId) GeometryMesh() (gm *GeometryMesh) {
-	if def := me.GeometryDef(); def != nil {
+func (me RefId) GeometryMesh(reg *Registry) (gm *GeometryMesh) {
+	if def := me.GeometryDef(reg); def != nil {
 		gm = def.Mesh
 	}
 	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryVertices
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryVertices
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryVertices() *GeometryVertices {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Mesh != nil) && (def.Mesh.Vertices != nil) && (def.Mesh.Vertices.Id == id) {
-				return def.Mesh.Vertices
-			} else if (def.Brep != nil) && (def.Brep.Vertices.Id == id) {
-				return &def.Brep.Vertices
+func (me RefId) GeometryVertices(reg *Registry) (obj *GeometryVertices) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryVertices); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the GeometryBrepWires
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the GeometryBrepWires
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) GeometryBrepWires() *GeometryBrepWires {
-	var (
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if (def.Brep != nil) && (def.Brep.Wires != nil) && (def.Brep.Wires.Id == id) {
-				return def.Brep.Wires
+func (me RefId) GeometryBrepWires(reg *Registry) (obj *GeometryBrepWires) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		for _, entry := range lib.index.lookup(me.S()) {
+			if obj, _ = entry.obj.(*GeometryBrepWires); obj != nil {
+				return
 			}
 		}
 	}
-	return nil
+	return
 }
 
 //	Returns the Id currently referenced by me.
@@ -346,115 +212,44 @@ func (me *RefId) SetIdRef(v string) {
 	*me = RefId(v)
 }
 
-//	Searches (all LibAnimationDefs contained in AllAnimationDefLibs) for the Source
+//	Searches (all LibAnimationDefs contained in reg.AnimationDefLibs, or DefaultRegistry if reg is nil) for the Source
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) SourceInAnimationDef() (s *Source) {
-	var (
-		def *AnimationDef
-		id  = me.S()
-	)
-	for _, lib := range AllAnimationDefLibs {
-		for _, def = range lib.M {
-			if s = def.Sources[id]; s != nil {
-				return
-			}
+func (me RefId) SourceInAnimationDef(reg *Registry) (s *Source) {
+	for _, lib := range reg.orDefault().AnimationDefLibs {
+		if s = lib.index.source(me.S()); s != nil {
+			return
 		}
 	}
 	return
 }
 
 //	Calls the SourceInAnimationDef(), SourceInControllerDef() and SourceInGeometryDef() methods in that order to find src.
-func (me RefId) SourceInAnyDef() (src *Source) {
-	if src = me.SourceInAnimationDef(); src == nil {
-		if src = me.SourceInControllerDef(); src == nil {
-			src = me.SourceInGeometryDef()
+func (me RefId) SourceInAnyDef(reg *Registry) (src *Source) {
+	if src = me.SourceInAnimationDef(reg); src == nil {
+		if src = me.SourceInControllerDef(reg); src == nil {
+			src = me.SourceInGeometryDef(reg)
 		}
 	}
 	return
 }
 
-//	Searches (all LibControllerDefs contained in AllControllerDefLibs) for the Source
+//	Searches (all LibControllerDefs contained in reg.ControllerDefLibs, or DefaultRegistry if reg is nil) for the Source
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) SourceInControllerDef() (s *Source) {
-	var (
-		def *ControllerDef
-		cs  Sources
-		id  = me.S()
-	)
-	for _, lib := range AllControllerDefLibs {
-		for _, def = range lib.M {
-			if cs = nil; def.Morph != nil {
-				cs = def.Morph.Sources
-			} else if def.Skin != nil {
-				cs = def.Skin.Sources
-			}
-			if cs != nil {
-				if s = cs[id]; s != nil {
-					return
-				}
-			}
+func (me RefId) SourceInControllerDef(reg *Registry) (s *Source) {
+	for _, lib := range reg.orDefault().ControllerDefLibs {
+		if s = lib.index.source(me.S()); s != nil {
+			return
 		}
 	}
 	return
 }
 
-//	Searches (all LibGeometryDefs contained in AllGeometryDefLibs) for the Source
+//	Searches (all LibGeometryDefs contained in reg.GeometryDefLibs, or DefaultRegistry if reg is nil) for the Source
 //	whose Id is referenced by me, returning the first match found.
-func (me RefId) SourceInGeometryDef() (s *Source) {
-	var (
-		gbc *GeometryBrepCurve
-		gbs *GeometryBrepSurface
-		def *GeometryDef
-		id  = me.S()
-	)
-	for _, lib := range AllGeometryDefLibs {
-		for _, def = range lib.M {
-			if def.Mesh != nil {
-				if s = def.Mesh.Sources[id]; s != nil {
-					return
-				}
-			}
-			if def.Spline != nil {
-				if s = def.Spline.Sources[id]; s != nil {
-					return
-				}
-			}
-			if def.Brep != nil {
-				if s = def.Brep.Sources[id]; s != nil {
-					return
-				}
-				if def.Brep.Surfaces != nil {
-					for _, gbs = range def.Brep.Surfaces.All {
-						if gbs.Element.NurbsSurface != nil {
-							if s = gbs.Element.NurbsSurface.Sources[id]; s != nil {
-								return
-							}
-						} else if (gbs.Element.SweptSurface != nil) && (gbs.Element.SweptSurface.Curve != nil) && (gbs.Element.SweptSurface.Curve.Element.Nurbs != nil) {
-							if s = gbs.Element.SweptSurface.Curve.Element.Nurbs.Sources[id]; s != nil {
-								return
-							}
-						}
-					}
-				}
-				if def.Brep.Curves != nil {
-					for _, gbc = range def.Brep.Curves.All {
-						if gbc.Element.Nurbs != nil {
-							if s = gbc.Element.Nurbs.Sources[id]; s != nil {
-								return
-							}
-						}
-					}
-				}
-				if def.Brep.SurfaceCurves != nil {
-					for _, gbc = range def.Brep.SurfaceCurves.All {
-						if gbc.Element.Nurbs != nil {
-							if s = gbc.Element.Nurbs.Sources[id]; s != nil {
-								return
-							}
-						}
-					}
-				}
-			}
+func (me RefId) SourceInGeometryDef(reg *Registry) (s *Source) {
+	for _, lib := range reg.orDefault().GeometryDefLibs {
+		if s = lib.index.source(me.S()); s != nil {
+			return
 		}
 	}
 	return
@@ -0,0 +1,246 @@
+package cdom
+
+//	Implemented by resource definitions that contain other objects with their own Id (such as
+//	the Sources of a GeometryDef), so that these can be indexed by the library containing them.
+type refIndexer interface {
+	refIds(add func(id string, obj interface{}))
+}
+
+type refIndexEntry struct {
+	defId string
+	obj   interface{}
+}
+
+//	Maps the Ids of all resource definitions in a library, and of all objects contained
+//	in them that have their own Id, to those objects. Used by all RefId lookup methods.
+type refIndex struct {
+	defs    map[string]interface{}
+	entries map[string][]refIndexEntry
+	keys    map[string][]string
+}
+
+func (me *refIndex) add(defId string, def interface{}) {
+	me.defs[defId] = def
+	refIdsOf(def, defId, func(id string, obj interface{}) {
+		me.entries[id] = append(me.entries[id], refIndexEntry{defId: defId, obj: obj})
+		me.keys[defId] = append(me.keys[defId], id)
+	})
+}
+
+func (me *refIndex) init() {
+	me.defs, me.entries, me.keys = map[string]interface{}{}, map[string][]refIndexEntry{}, map[string][]string{}
+}
+
+//	Returns whether obj is indexed under id, or else found under id by me.scan(id).
+func (me *refIndex) contains(id string, obj interface{}) bool {
+	for _, entry := range me.entries[id] {
+		if entry.obj == obj {
+			return true
+		}
+	}
+	for _, entry := range me.scan(id) {
+		if entry.obj == obj {
+			return true
+		}
+	}
+	return false
+}
+
+//	Returns the entries for id. If there are none, such as for a Source added to a resource definition
+//	after it was indexed, falls back to me.scan(id).
+func (me *refIndex) lookup(id string) (entries []refIndexEntry) {
+	if entries = me.entries[id]; len(entries) == 0 {
+		entries = me.scan(id)
+	}
+	return
+}
+
+func (me *refIndex) remove(defId string) {
+	var entries []refIndexEntry
+	for _, id := range me.keys[defId] {
+		entries = me.entries[id][:0]
+		for _, entry := range me.entries[id] {
+			if entry.defId != defId {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			delete(me.entries, id)
+		} else {
+			me.entries[id] = entries
+		}
+	}
+	delete(me.keys, defId)
+	delete(me.defs, defId)
+}
+
+//	Returns the entries for id found by walking all indexed resource definitions in their current state,
+//	without consulting or updating the index. This costs as much as a Reindex() of the library.
+func (me *refIndex) scan(id string) (entries []refIndexEntry) {
+	if len(id) > 0 {
+		for defId, def := range me.defs {
+			refIdsOf(def, defId, func(objId string, obj interface{}) {
+				if objId == id {
+					entries = append(entries, refIndexEntry{defId: defId, obj: obj})
+				}
+			})
+		}
+	}
+	return
+}
+
+func (me *refIndex) source(id string) (s *Source) {
+	for _, entry := range me.lookup(id) {
+		if s, _ = entry.obj.(*Source); s != nil {
+			return
+		}
+	}
+	return
+}
+
+func (me *refIndex) sourceArray(id string) (sa *SourceArray) {
+	for _, entry := range me.lookup(id) {
+		if sa, _ = entry.obj.(*SourceArray); sa != nil {
+			return
+		}
+	}
+	return
+}
+
+//	Calls add for def (with the specified defId) and for all objects with a non-empty Id contained in def.
+func refIdsOf(def interface{}, defId string, add func(string, interface{})) {
+	nonEmpty := func(id string, obj interface{}) {
+		if len(id) > 0 {
+			add(id, obj)
+		}
+	}
+	nonEmpty(defId, def)
+	if indexer, _ := def.(refIndexer); indexer != nil {
+		indexer.refIds(nonEmpty)
+	}
+}
+
+func (me Sources) refIds(add func(string, interface{})) {
+	for id, src := range me {
+		add(id, src)
+		add(src.Array.Id, &src.Array)
+	}
+}
+
+func (me *AnimationDef) refIds(add func(string, interface{})) {
+	me.Sources.refIds(add)
+	for _, as := range me.Samplers {
+		add(as.Id, as)
+	}
+	for _, def := range me.AnimationDefs {
+		add(def.Id, def)
+		def.refIds(add)
+	}
+}
+
+func (me *ControllerDef) refIds(add func(string, interface{})) {
+	if me.Morph != nil {
+		me.Morph.Sources.refIds(add)
+	} else if me.Skin != nil {
+		me.Skin.Sources.refIds(add)
+	}
+}
+
+func (me *FxEffectDef) refIds(add func(string, interface{})) {
+	for _, fp := range me.Profiles {
+		add(fp.Id, fp)
+		if fp.Common != nil {
+			add(fp.Common.Technique.Id, &fp.Common.Technique)
+		}
+		if fp.Glsl != nil {
+			for _, t := range fp.Glsl.Techniques {
+				add(t.Id, t)
+			}
+		}
+	}
+}
+
+func (me *GeometryDef) refIds(add func(string, interface{})) {
+	if me.Mesh != nil {
+		me.Mesh.Sources.refIds(add)
+		if me.Mesh.Vertices != nil {
+			add(me.Mesh.Vertices.Id, me.Mesh.Vertices)
+		}
+	}
+	if me.Spline != nil {
+		me.Spline.Sources.refIds(add)
+	}
+	if brep := me.Brep; brep != nil {
+		brep.Sources.refIds(add)
+		add(brep.Vertices.Id, &brep.Vertices)
+		if brep.Surfaces != nil {
+			for _, gbs := range brep.Surfaces.All {
+				if gbs.Element.NurbsSurface != nil {
+					gbs.Element.NurbsSurface.Sources.refIds(add)
+				} else if (gbs.Element.SweptSurface != nil) && (gbs.Element.SweptSurface.Curve != nil) && (gbs.Element.SweptSurface.Curve.Element.Nurbs != nil) {
+					gbs.Element.SweptSurface.Curve.Element.Nurbs.Sources.refIds(add)
+				}
+			}
+		}
+		if brep.Curves != nil {
+			for _, gbc := range brep.Curves.All {
+				if gbc.Element.Nurbs != nil {
+					gbc.Element.Nurbs.Sources.refIds(add)
+				}
+			}
+		}
+		if brep.SurfaceCurves != nil {
+			for _, gbc := range brep.SurfaceCurves.All {
+				if gbc.Element.Nurbs != nil {
+					gbc.Element.Nurbs.Sources.refIds(add)
+				}
+			}
+		}
+		if brep.Edges != nil {
+			add(brep.Edges.Id, brep.Edges)
+		}
+		if brep.Faces != nil {
+			add(brep.Faces.Id, brep.Faces)
+		}
+		if brep.Pcurves != nil {
+			add(brep.Pcurves.Id, brep.Pcurves)
+		}
+		if brep.Shells != nil {
+			add(brep.Shells.Id, brep.Shells)
+		}
+		if brep.Solids != nil {
+			add(brep.Solids.Id, brep.Solids)
+		}
+		if brep.Wires != nil {
+			add(brep.Wires.Id, brep.Wires)
+		}
+	}
+}
+
+func (me *NodeDef) refIds(add func(string, interface{})) {
+	for _, cn := range me.Nodes {
+		if cn.Def != nil {
+			add(cn.Def.Id, cn.Def)
+			cn.Def.refIds(add)
+		}
+	}
+}
+
+func (me *PxModelDef) refIds(add func(string, interface{})) {
+	for _, rb := range me.RigidBodies {
+		add(rb.Id, rb)
+	}
+	for _, rc := range me.RigidConstraints {
+		add(rc.Id, rc)
+	}
+}
+
+func (me *VisualSceneDef) refIds(add func(string, interface{})) {
+	for _, node := range me.Nodes {
+		add(node.Id, node)
+		node.refIds(add)
+	}
+	for _, eval := range me.Evaluations {
+		add(eval.Id, eval)
+	}
+}
@@ -0,0 +1,32 @@
+package cdom
+
+import (
+	"testing"
+)
+
+func TestRefIndexInPlaceEdits(t *testing.T) {
+	reg := NewRegistry()
+	def := reg.GeometryDefs.New("g")
+	def.Mesh = &GeometryMesh{}
+	def.Mesh.Sources = Sources{}
+	reg.GeometryDefs.Add(def)
+	src := &Source{}
+	src.Id, src.Array.Id = "s", "s-array"
+	def.Mesh.Sources[src.Id] = src
+	if got := RefId("s").SourceInGeometryDef(reg); got != src {
+		t.Errorf("got Source %p for an in-place added Source, want %p", got, src)
+	}
+	if got := RefId("s-array").ArrayInGeometryDef(reg); got != &src.Array {
+		t.Errorf("got SourceArray %p for an in-place added Source, want %p", got, &src.Array)
+	}
+	if url := reg.UrlOf(src); url != "#s" {
+		t.Errorf("got url %q for an in-place added Source, want \"#s\"", url)
+	}
+	if (RefId("none").SourceInAnyDef(reg) != nil) || (reg.UrlOf(&Source{}) != "") {
+		t.Errorf("found an object that is not in the registry")
+	}
+	reg.GeometryDefs.Reindex()
+	if got := reg.GeometryDefs.index.entries["s"]; (len(got) != 1) || (got[0].obj != src) {
+		t.Errorf("got index entries %v after Reindex(), want the Source", got)
+	}
+}
@@ -1,6 +1,7 @@
 package cdom
 
 import (
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -33,6 +34,14 @@ type RefSid struct {
 	//	This is always a pointer: so V may be a *SidFloat but it will never be a SidFloat.
 	//	To be set ONLY through the Resolve() method! Reset to nil by the SetSidRef() method.
 	V interface{}
+
+	parsed *refSidParsed
+}
+
+type refSidParsed struct {
+	s    string
+	path *SidPath
+	err  error
 }
 
 //	Creates and returns a new RefSid, its S initialized with the specified sidRef.
@@ -44,12 +53,23 @@ func NewRefSid(sidRef string) (rs *RefSid) {
 
 //	Sets S to sidRef and resets V to nil.
 func (me *RefSid) SetSidRef(sidRef string) {
-	me.S, me.V = sidRef, nil
+	me.S, me.V, me.parsed = sidRef, nil, nil
+}
+
+//	Returns the parsed form of me.S, as per ParseSidPath(). The result is cached until me.S changes.
+func (me *RefSid) Path() (path *SidPath, err error) {
+	if (me.parsed == nil) || (me.parsed.s != me.S) {
+		me.parsed = &refSidParsed{s: me.S}
+		me.parsed.path, me.parsed.err = ParseSidPath(me.S)
+	}
+	path, err = me.parsed.path, me.parsed.err
+	return
 }
 
 //	If me.V is nil or force is true: resolves the Sid path in me.S and sets V to the result.
 //	For possible root arguments, see RefSidRoot. If no match is found for the full path, V
-//	will become nil (rather than, say, a partial-path-match result-value).
+//	will become nil (rather than, say, a partial-path-match result-value). V also becomes nil
+//	if me.S is not a valid Sid path, in which case Path() returns the reason.
 //	
 //	Sid path examples:
 //		-	foo/bar/doodad
@@ -65,58 +85,242 @@ func (me *RefSid) SetSidRef(sidRef string) {
 //		-	foo/bar/doodad(2)
 //			root resolves foo/bar/doodad, then returns pointer to a "slot" at index 2
 //			(if doodad supports indexed-slot access by implementing RefSidIndexer)
+//		-	foo/bar/doodad(3)(1)
+//			root resolves foo/bar/doodad, then returns pointer to a "slot" at row 3, column 1
+//			(if doodad supports two-dimensional indexed-slot access by implementing RefSidIndexer)
 func (me *RefSid) Resolve(root RefSidRoot, force bool) {
 	if force || (me.V == nil) {
 		me.V = nil
-		parts := strings.Split(me.S, "/")
-		if len(parts) == 1 {
-			parts = append([]string{"."}, parts...)
+		if path, err := me.Path(); err == nil {
+			me.V = path.Resolve(root)
+		}
+	}
+}
+
+//	A parsed Sid path, as returned by ParseSidPath().
+type SidPath struct {
+	//	The Sid path that was parsed.
+	S string
+
+	//	The Id of the object to start resolving from, or "." for the RefSidRoot itself.
+	Id string
+
+	//	The Sids to be resolved, in order, starting from the object with Id. Never empty.
+	Sids []string
+
+	//	Applied, in order, to the value that Sids resolve to. May be empty.
+	Selectors []SidSelector
+}
+
+//	A member or array selector at the end of a Sid path, such as ".ANGLE" or "(2)".
+type SidSelector struct {
+	//	If not empty, this selector selects the member with this name via RefSidFielder.
+	Member string
+
+	//	If Member is empty, the array indices selected via RefSidIndexer: either one, as in "(2)",
+	//	or two, as in "(3)(1)". In the latter case, Index[0] selects the row and Index[1] the column.
+	Index []int
+}
+
+//	The error returned by ParseSidPath() for a malformed Sid path.
+type SidPathError struct {
+	//	The malformed Sid path.
+	S string
+
+	//	The byte offset into S at which the problem was detected.
+	Pos int
+
+	//	Describes the problem.
+	Msg string
+}
+
+//	Returns a human-readable single-line representation of me.
+func (me *SidPathError) Error() string {
+	return sfmt("Sid path '%s', position %d: %s", me.S, me.Pos, me.Msg)
+}
+
+//	Parses the Sid path s, in the Collada 1.5 addressing syntax: an Id (or "."), then one or more
+//	slash-separated Sids, then optionally any number of member selectors (".name") and array selectors
+//	("(index)"), where one array selector may directly follow another to form a two-dimensional index.
+//	If s contains no unescaped slash, it is taken to be a single Sid, as in "./"+s. Within an Id, Sid or member name,
+//	a backslash escapes the next character, so that Ids or Sids containing "/", ".", "(", ")" or "\\" can be referred
+//	to. (An Id may also contain unescaped "." characters, since Ids are never followed by selectors.)
+func ParseSidPath(s string) (me *SidPath, err error) {
+	p := &sidPathParser{s: s}
+	me = &SidPath{S: s}
+	if me.Id = p.name("/", "Id"); (p.err == nil) && (p.pos < len(s)) {
+		p.pos++
+	} else if p.err == nil {
+		//	no unescaped slash: a lone Sid
+		me.Id, p.pos = ".", 0
+	}
+	for p.err == nil {
+		me.Sids = append(me.Sids, p.name("/.()", "Sid"))
+		if (p.err != nil) || (p.pos >= len(s)) || (s[p.pos] != '/') {
+			break
 		}
-		if resolver := root.sidResolver(parts[0]); (resolver != nil) && (len(parts) > 1) {
-			bag := &refSidBag{indexers: [...]int{-1, -1}}
-			last := parts[len(parts)-1]
-			if pos := strings.Index(last, "."); pos > 0 {
-				parts[len(parts)-1] = last[:pos]
-				bag.fielder = last[pos+1:]
-			} else if pos = strings.Index(last, "("); pos > 0 {
-				parts[len(parts)-1] = last[:pos]
-				i := 0
-				for _, s := range strings.Split(last[pos+1:], ")(") {
-					if iv, err := strconv.Atoi(strings.Trim(s, " )(")); err != nil {
-						bag.indexers[i] = iv
-						if i++; i > 1 {
-							break
-						}
-					}
+		p.pos++
+	}
+	for (p.err == nil) && (p.pos < len(s)) {
+		switch s[p.pos] {
+		case '.':
+			p.pos++
+			me.Selectors = append(me.Selectors, SidSelector{Member: p.name(".(", "member name")})
+		case '(':
+			sel := SidSelector{}
+			for (p.err == nil) && (p.pos < len(s)) && (s[p.pos] == '(') {
+				if len(sel.Index) == 2 {
+					p.fail(p.pos, "at most two array indices may follow one another")
+				} else {
+					sel.Index = append(sel.Index, p.index())
 				}
 			}
-			if me.V = resolver.sidResolve(parts[1:], bag); me.V != nil {
-				if len(bag.fielder) > 0 {
-					if fielder, _ := me.V.(RefSidFielder); fielder != nil {
-						me.V = fielder.AccessField(bag.fielder)
-					} else {
-						me.V = nil
-					}
-				} else if bag.indexers[0] > -1 {
-					if indexer, _ := me.V.(RefSidIndexer); indexer != nil {
-						me.V = indexer.AccessIndex(bag.indexers[0], bag.indexers[1])
-					} else {
-						me.V = nil
-					}
+			me.Selectors = append(me.Selectors, sel)
+		default:
+			p.fail(p.pos, sfmt("unexpected '%c'", s[p.pos]))
+		}
+	}
+	if err = p.err; err != nil {
+		me = nil
+	}
+	return
+}
+
+//	Resolves me, starting from root (see RefSidRoot), and returns the result (always a pointer),
+//	or nil if no match is found for the full path.
+func (me *SidPath) Resolve(root RefSidRoot) (val interface{}) {
+	if root == nil {
+		return
+	}
+	if resolver := sidResolverOf(root, me.Id); resolver != nil {
+		if val = resolver.sidResolve(me.Sids, &refSidBag{}); val != nil {
+			for _, sel := range me.Selectors {
+				if val = sel.apply(val); val == nil {
+					break
 				}
 			}
 		}
 	}
+	return
+}
+
+//	Returns root.sidResolver(id), or nil if that is a nil pointer (as returned by a lib that does not contain id).
+func sidResolverOf(root RefSidRoot, id string) (rsr refSidResolver) {
+	if rsr = root.sidResolver(id); rsr != nil {
+		if rv := reflect.ValueOf(rsr); (rv.Kind() == reflect.Ptr) && rv.IsNil() {
+			rsr = nil
+		}
+	}
+	return
+}
+
+//	Returns the result of applying me to val, or nil if val does not support me (or me is out of range).
+func (me *SidSelector) apply(val interface{}) (res interface{}) {
+	if len(me.Member) > 0 {
+		if fielder, _ := val.(RefSidFielder); fielder != nil {
+			res = fielder.AccessField(me.Member)
+		}
+	} else if indexer, _ := val.(RefSidIndexer); (indexer != nil) && (len(me.Index) > 0) {
+		j := -1
+		if len(me.Index) > 1 {
+			j = me.Index[1]
+		}
+		res = indexer.AccessIndex(me.Index[0], j)
+	}
+	if rv := reflect.ValueOf(res); (res != nil) && (rv.Kind() == reflect.Ptr) && rv.IsNil() {
+		res = nil
+	}
+	return
+}
+
+type sidPathParser struct {
+	s   string
+	pos int
+	err error
+}
+
+func (me *sidPathParser) fail(pos int, msg string) {
+	if me.err == nil {
+		me.err = &SidPathError{S: me.s, Pos: pos, Msg: msg}
+	}
+}
+
+//	Parses an array selector such as "(2)", starting at the "(".
+func (me *sidPathParser) index() (i int) {
+	start := me.pos + 1
+	end := strings.IndexRune(me.s[start:], ')')
+	if end < 0 {
+		me.fail(me.pos, "unterminated array index")
+		return
+	}
+	digits := me.s[start : start+end]
+	if len(digits) == 0 {
+		me.fail(start, "empty array index")
+	} else if strings.Trim(digits, "0123456789") != "" {
+		me.fail(start, sfmt("array index '%s' is not a non-negative integer", digits))
+	} else if n, err := strconv.Atoi(digits); err != nil {
+		me.fail(start, sfmt("array index '%s' is out of range", digits))
+	} else {
+		i = n
+	}
+	me.pos = start + end + 1
+	return
+}
+
+//	Parses an Id, Sid or member name up to the next unescaped character in stops, resolving backslash escapes.
+//	An Id ends only at a "/", but Sids and member names must not contain unescaped "/", ".", "(" or ")" other than stops.
+func (me *sidPathParser) name(stops, what string) (name string) {
+	var buf []byte
+	start := me.pos
+	for ; me.pos < len(me.s); me.pos++ {
+		c := me.s[me.pos]
+		if c == '\\' {
+			if me.pos++; me.pos >= len(me.s) {
+				me.fail(me.pos-1, "backslash at end of path escapes nothing")
+				return
+			}
+			buf = append(buf, me.s[me.pos])
+			continue
+		}
+		if strings.IndexByte(stops, c) >= 0 {
+			break
+		}
+		if (what != "Id") && strings.ContainsRune("/.()", rune(c)) {
+			me.fail(me.pos, sfmt("unexpected '%c' in %s", c, what))
+			return
+		}
+		buf = append(buf, c)
+	}
+	if me.pos == start {
+		me.fail(start, sfmt("empty %s", what))
+	}
+	name = string(buf)
+	return
 }
 
 type refSidBag struct {
-	fielder, sid string
-	indexers     [2]int
-	valRaw       interface{}
-	valAsRes     refSidResolver
+	sid      string
+	valRaw   interface{}
+	valAsRes refSidResolver
+
+	//	if set, sidResolve() calls visit with every Sid path (below sids) and its value, instead of matching path
+	visit func(sids []string, val interface{})
+	sids  []string
 }
 
 func (me *refSidBag) sidResolve(path []string) interface{} {
+	if me.visit != nil {
+		if len(me.sid) > 0 {
+			sids, res := append(me.sids[:len(me.sids):len(me.sids)], me.sid), me.valAsRes
+			if me.visit(sids, me.valRaw); res != nil {
+				outer := me.sids
+				me.sids = sids
+				res.sidResolve(nil, me)
+				me.sids = outer
+			}
+		}
+		return nil
+	}
 	if me.sid == path[0] {
 		if len(path) == 1 {
 			return me.valRaw
@@ -129,12 +333,14 @@ func (me *refSidBag) sidResolve(path []string) interface{} {
 
 //	Implemented by select types that embed HasSid to aid resolving Sid paths
 //	with a tailing named-field accessor, as in "some/sid/path.fieldName".
+//	AccessField() returns nil for unsupported field names.
 type RefSidFielder interface {
 	AccessField(fieldName string) interface{}
 }
 
 //	Implemented by select types that embed HasSid to aid resolving Sid paths
 //	with a tailing indexed-slot accessor, as in "some/sid/path(2)".
+//	AccessIndex() takes a negative j for one-dimensional indices, and returns nil for indices out of range.
 type RefSidIndexer interface {
 	AccessIndex(i, j int) interface{}
 }
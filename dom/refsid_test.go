@@ -0,0 +1,249 @@
+package cdom
+
+import (
+	"testing"
+)
+
+//	A minimal stand-in for a "FooDef" with Id "foo", containing a "bar" that contains a "doodad".
+type testSidRoot struct {
+	bar testSidBar
+}
+
+func (me *testSidRoot) sidResolver(id string) (rsr refSidResolver) {
+	if (id == "foo") || (id == ".") {
+		rsr = me
+	}
+	return
+}
+
+func (me *testSidRoot) sidResolve(path []string, bag *refSidBag) (val interface{}) {
+	bag.valRaw, bag.valAsRes, bag.sid = &me.bar, &me.bar, "bar"
+	return bag.sidResolve(path)
+}
+
+//	A minimal stand-in for a lib, finding testSidRoot by its Id.
+type testSidLib struct {
+	foo testSidRoot
+}
+
+func (me *testSidLib) sidResolver(id string) refSidResolver {
+	if id == "foo" {
+		return &me.foo
+	}
+	return nil
+}
+
+type testSidBar struct {
+	doodad testSidDoodad
+}
+
+func (me *testSidBar) sidResolve(path []string, bag *refSidBag) (val interface{}) {
+	bag.valRaw, bag.valAsRes, bag.sid = &me.doodad, nil, "doodad"
+	return bag.sidResolve(path)
+}
+
+type testSidDoodad struct {
+	Hollow bool
+	Slots  Float4x4
+}
+
+func (me *testSidDoodad) AccessField(fn string) interface{} {
+	if fn == "Hollow" {
+		return &me.Hollow
+	}
+	return nil
+}
+
+func (me *testSidDoodad) AccessIndex(i, j int) interface{} {
+	return me.Slots.AccessIndex(i, j)
+}
+
+func TestRefSidResolveExamples(t *testing.T) {
+	lib := &testSidLib{}
+	root, doodad := &lib.foo, &lib.foo.bar.doodad
+	for _, test := range []struct {
+		s    string
+		root RefSidRoot
+		want interface{}
+	}{
+		{"foo/bar/doodad", lib, doodad},
+		{"foo/bar/doodad", root, doodad},
+		{"./bar/doodad", root, doodad},
+		{"bar", root, &lib.foo.bar},
+		{"foo/bar/doodad.Hollow", lib, &doodad.Hollow},
+		{"foo/bar/doodad(2)", lib, &doodad.Slots[2]},
+		{"foo/bar/doodad(3)(1)", lib, doodad.Slots.AccessIndex(3, 1)},
+		{"foo/bar/nope", lib, nil},
+		{"foo/bar/doodad.Nope", lib, nil},
+		{"foo/bar/doodad(16)", lib, nil},
+		{"nope/bar/doodad", lib, nil},
+		{"./bar/doodad", lib, nil},
+		{"foo/bar/doodad.Hollow(1)", lib, nil},
+		{"foo/bar(1)", lib, nil},
+		{"foo/bar.Hollow", lib, nil},
+	} {
+		rs := NewRefSid(test.s)
+		if rs.Resolve(test.root, false); rs.V != test.want {
+			t.Errorf("%q: got %v, want %v", test.s, rs.V, test.want)
+		}
+	}
+}
+
+func TestRefSidResolveTransform(t *testing.T) {
+	node := newNodeDef("foo")
+	matrix, translate := &Transform{Kind: TransformKindMatrix, F: make([]float64, 16)}, &Transform{Kind: TransformKindTranslate, F: []float64{1, 2, 3}}
+	matrix.Sid, translate.Sid = "matrix", "translate"
+	node.Transforms = append(node.Transforms, matrix, translate)
+	for _, test := range []struct {
+		s    string
+		want interface{}
+	}{
+		{"foo/matrix(3)(1)", &matrix.F[13]},
+		{"foo/matrix(7)", &matrix.F[7]},
+		{"foo/matrix(1)(4)", nil},
+		{"translate.Y", &translate.F[1]},
+		{"translate.ANGLE", nil},
+		{"translate(3)", nil},
+	} {
+		rs := NewRefSid(test.s)
+		if rs.Resolve(node, true); rs.V != test.want {
+			t.Errorf("%q: got %v, want %v", test.s, rs.V, test.want)
+		}
+	}
+}
+
+func TestAccessIndexBounds(t *testing.T) {
+	matrix, translate := &Transform{Kind: TransformKindMatrix, F: make([]float64, 16)}, &Transform{Kind: TransformKindTranslate, F: []float64{1, 2, 3}}
+	var slots Float4x4
+	var vec Float3
+	for _, test := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"matrix(3)(3)", matrix.AccessIndex(3, 3), &matrix.F[15]},
+		{"matrix(4)(0)", matrix.AccessIndex(4, 0), nil},
+		{"matrix(1)(4)", matrix.AccessIndex(1, 4), nil},
+		{"matrix(-1)", matrix.AccessIndex(-1, -1), nil},
+		{"translate(3)", translate.AccessIndex(3, -1), nil},
+		{"translate(0)(1)", translate.AccessIndex(0, 1), &translate.F[1]},
+		{"translate(1)(0)", translate.AccessIndex(1, 0), nil},
+		{"empty.X", (&Transform{}).AccessField("X"), nil},
+		{"slots(4)(0)", slots.AccessIndex(4, 0), nil},
+		{"slots(16)", slots.AccessIndex(16, -1), nil},
+		{"vec(3)", vec.AccessIndex(3, -1), nil},
+		{"vec(2)", vec.AccessIndex(2, -1), &vec[2]},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestParseSidPath(t *testing.T) {
+	for _, test := range []struct {
+		s         string
+		id        string
+		sids      []string
+		selectors []SidSelector
+	}{
+		{"foo/bar/doodad", "foo", []string{"bar", "doodad"}, nil},
+		{"./bar/doodad", ".", []string{"bar", "doodad"}, nil},
+		{"bar", ".", []string{"bar"}, nil},
+		{"foo/bar/doodad.Hollow", "foo", []string{"bar", "doodad"}, []SidSelector{{Member: "Hollow"}}},
+		{"foo/bar/doodad(2)", "foo", []string{"bar", "doodad"}, []SidSelector{{Index: []int{2}}}},
+		{"matrix(3)(1)", ".", []string{"matrix"}, []SidSelector{{Index: []int{3, 1}}}},
+		{"foo/bar(0).X(1)", "foo", []string{"bar"}, []SidSelector{{Index: []int{0}}, {Member: "X"}, {Index: []int{1}}}},
+		{"my.node/t\\.x\\/y\\\\z.W", "my.node", []string{"t.x/y\\z"}, []SidSelector{{Member: "W"}}},
+		{"a\\/b", ".", []string{"a/b"}, nil},
+		{"a\\(1\\)", ".", []string{"a(1)"}, nil},
+	} {
+		path, err := ParseSidPath(test.s)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.s, err)
+			continue
+		}
+		if (path.S != test.s) || (path.Id != test.id) || !testEqualStrings(path.Sids, test.sids) || (len(path.Selectors) != len(test.selectors)) {
+			t.Errorf("%q: got %#v", test.s, path)
+			continue
+		}
+		for i, sel := range path.Selectors {
+			if (sel.Member != test.selectors[i].Member) || !testEqualInts(sel.Index, test.selectors[i].Index) {
+				t.Errorf("%q: selector %d: got %#v, want %#v", test.s, i, sel, test.selectors[i])
+			}
+		}
+	}
+}
+
+func TestParseSidPathErrors(t *testing.T) {
+	for _, test := range []struct {
+		s   string
+		pos int
+	}{
+		{"", 0},
+		{"/bar", 0},
+		{"foo/", 4},
+		{"foo//bar", 4},
+		{"foo/bar.", 8},
+		{"foo/bar()", 8},
+		{"foo/bar(x)", 8},
+		{"foo/bar(-1)", 8},
+		{"foo/bar(1", 7},
+		{"foo/bar(1)(2)(3)", 13},
+		{"foo/bar(1)x", 10},
+		{"foo/bar)", 7},
+		{"foo/bar.X/baz", 9},
+		{"foo/bar.X)", 9},
+		{"foo/bar\\", 7},
+		{"foo/ba.r/baz", 8},
+	} {
+		if path, err := ParseSidPath(test.s); err == nil {
+			t.Errorf("%q: got %#v, want an error", test.s, path)
+		} else if perr, _ := err.(*SidPathError); perr == nil {
+			t.Errorf("%q: got %T, want a *SidPathError", test.s, err)
+		} else if (perr.S != test.s) || (perr.Pos != test.pos) {
+			t.Errorf("%q: got error at %d (%v), want at %d", test.s, perr.Pos, err, test.pos)
+		}
+	}
+}
+
+func TestRefSidPathCache(t *testing.T) {
+	rs := NewRefSid("foo/bar")
+	path, _ := rs.Path()
+	if again, _ := rs.Path(); again != path {
+		t.Errorf("Path() not cached")
+	}
+	rs.S = "foo/baz"
+	if path, _ = rs.Path(); (path == nil) || (path.Sids[0] != "baz") {
+		t.Errorf("Path() not refreshed after S changed: %#v", path)
+	}
+	if rs.SetSidRef("foo("); rs.parsed != nil {
+		t.Errorf("SetSidRef() did not reset the cached path")
+	}
+	if _, err := rs.Path(); err == nil {
+		t.Errorf("%q: want an error", rs.S)
+	}
+}
+
+func testEqualInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func testEqualStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,202 @@
+package cdom
+
+var (
+	//	The Registry containing the global AllFooDefLibs and FooDefs libraries:
+	//	AllGeometryDefLibs and GeometryDefs, AllFxImageDefLibs and FxImageDefs etc.
+	//	Used by all lookup methods that take a *Registry argument when it is nil.
+	DefaultRegistry = NewRegistry()
+)
+
+//	A self-contained scope of resource definition libraries.
+//	Every Registry has its own FooDefLibs hash-table and "default" FooDefs library for each
+//	resource type, so that multiple Documents with colliding Ids can coexist in one process.
+//	To create a new Registry, ONLY use the NewRegistry() constructor.
+type Registry struct {
+	//	A hash-table that contains LibAnimationDefs libraries associated by their Id.
+	AnimationDefLibs LibsAnimationDef
+
+	//	The "default" LibAnimationDefs library for AnimationDefs.
+	AnimationDefs *LibAnimationDefs
+
+	//	A hash-table that contains LibAnimationClipDefs libraries associated by their Id.
+	AnimationClipDefLibs LibsAnimationClipDef
+
+	//	The "default" LibAnimationClipDefs library for AnimationClipDefs.
+	AnimationClipDefs *LibAnimationClipDefs
+
+	//	A hash-table that contains LibCameraDefs libraries associated by their Id.
+	CameraDefLibs LibsCameraDef
+
+	//	The "default" LibCameraDefs library for CameraDefs.
+	CameraDefs *LibCameraDefs
+
+	//	A hash-table that contains LibControllerDefs libraries associated by their Id.
+	ControllerDefLibs LibsControllerDef
+
+	//	The "default" LibControllerDefs library for ControllerDefs.
+	ControllerDefs *LibControllerDefs
+
+	//	A hash-table that contains LibFormulaDefs libraries associated by their Id.
+	FormulaDefLibs LibsFormulaDef
+
+	//	The "default" LibFormulaDefs library for FormulaDefs.
+	FormulaDefs *LibFormulaDefs
+
+	//	A hash-table that contains LibFxEffectDefs libraries associated by their Id.
+	FxEffectDefLibs LibsFxEffectDef
+
+	//	The "default" LibFxEffectDefs library for FxEffectDefs.
+	FxEffectDefs *LibFxEffectDefs
+
+	//	A hash-table that contains LibFxImageDefs libraries associated by their Id.
+	FxImageDefLibs LibsFxImageDef
+
+	//	The "default" LibFxImageDefs library for FxImageDefs.
+	FxImageDefs *LibFxImageDefs
+
+	//	A hash-table that contains LibFxMaterialDefs libraries associated by their Id.
+	FxMaterialDefLibs LibsFxMaterialDef
+
+	//	The "default" LibFxMaterialDefs library for FxMaterialDefs.
+	FxMaterialDefs *LibFxMaterialDefs
+
+	//	A hash-table that contains LibGeometryDefs libraries associated by their Id.
+	GeometryDefLibs LibsGeometryDef
+
+	//	The "default" LibGeometryDefs library for GeometryDefs.
+	GeometryDefs *LibGeometryDefs
+
+	//	A hash-table that contains LibKxArticulatedSystemDefs libraries associated by their Id.
+	KxArticulatedSystemDefLibs LibsKxArticulatedSystemDef
+
+	//	The "default" LibKxArticulatedSystemDefs library for KxArticulatedSystemDefs.
+	KxArticulatedSystemDefs *LibKxArticulatedSystemDefs
+
+	//	A hash-table that contains LibKxJointDefs libraries associated by their Id.
+	KxJointDefLibs LibsKxJointDef
+
+	//	The "default" LibKxJointDefs library for KxJointDefs.
+	KxJointDefs *LibKxJointDefs
+
+	//	A hash-table that contains LibKxModelDefs libraries associated by their Id.
+	KxModelDefLibs LibsKxModelDef
+
+	//	The "default" LibKxModelDefs library for KxModelDefs.
+	KxModelDefs *LibKxModelDefs
+
+	//	A hash-table that contains LibKxSceneDefs libraries associated by their Id.
+	KxSceneDefLibs LibsKxSceneDef
+
+	//	The "default" LibKxSceneDefs library for KxSceneDefs.
+	KxSceneDefs *LibKxSceneDefs
+
+	//	A hash-table that contains LibLightDefs libraries associated by their Id.
+	LightDefLibs LibsLightDef
+
+	//	The "default" LibLightDefs library for LightDefs.
+	LightDefs *LibLightDefs
+
+	//	A hash-table that contains LibNodeDefs libraries associated by their Id.
+	NodeDefLibs LibsNodeDef
+
+	//	The "default" LibNodeDefs library for NodeDefs.
+	NodeDefs *LibNodeDefs
+
+	//	A hash-table that contains LibPxForceFieldDefs libraries associated by their Id.
+	PxForceFieldDefLibs LibsPxForceFieldDef
+
+	//	The "default" LibPxForceFieldDefs library for PxForceFieldDefs.
+	PxForceFieldDefs *LibPxForceFieldDefs
+
+	//	A hash-table that contains LibPxMaterialDefs libraries associated by their Id.
+	PxMaterialDefLibs LibsPxMaterialDef
+
+	//	The "default" LibPxMaterialDefs library for PxMaterialDefs.
+	PxMaterialDefs *LibPxMaterialDefs
+
+	//	A hash-table that contains LibPxModelDefs libraries associated by their Id.
+	PxModelDefLibs LibsPxModelDef
+
+	//	The "default" LibPxModelDefs library for PxModelDefs.
+	PxModelDefs *LibPxModelDefs
+
+	//	A hash-table that contains LibPxSceneDefs libraries associated by their Id.
+	PxSceneDefLibs LibsPxSceneDef
+
+	//	The "default" LibPxSceneDefs library for PxSceneDefs.
+	PxSceneDefs *LibPxSceneDefs
+
+	//	A hash-table that contains LibVisualSceneDefs libraries associated by their Id.
+	VisualSceneDefLibs LibsVisualSceneDef
+
+	//	The "default" LibVisualSceneDefs library for VisualSceneDefs.
+	VisualSceneDefs *LibVisualSceneDefs
+}
+
+//	Creates and returns a new Registry, with all its FooDefLibs hash-tables
+//	initialized and each containing its "default" FooDefs library.
+func NewRegistry() (me *Registry) {
+	me = &Registry{}
+	me.initAnimationDefs()
+	me.initAnimationClipDefs()
+	me.initCameraDefs()
+	me.initControllerDefs()
+	me.initFormulaDefs()
+	me.initFxEffectDefs()
+	me.initFxImageDefs()
+	me.initFxMaterialDefs()
+	me.initGeometryDefs()
+	me.initKxArticulatedSystemDefs()
+	me.initKxJointDefs()
+	me.initKxModelDefs()
+	me.initKxSceneDefs()
+	me.initLightDefs()
+	me.initNodeDefs()
+	me.initPxForceFieldDefs()
+	me.initPxMaterialDefs()
+	me.initPxModelDefs()
+	me.initPxSceneDefs()
+	me.initVisualSceneDefs()
+	return
+}
+
+func (me *Registry) orDefault() *Registry {
+	if me == nil {
+		return DefaultRegistry
+	}
+	return me
+}
+
+//	Signals to the core package (or your custom package) that changes have been made to the
+//	libraries of this Registry that need to be picked up. Unlike the global SyncChanges() function,
+//	does not call OnBeforeSyncAll and OnAfterSyncAll.
+func (me *Registry) SyncChanges() {
+	for _, syncer := range syncHandlers {
+		syncer(me)
+	}
+}
+
+//	Returns the first RefSidRoot with the specified Id found in the libraries of me: either a resource
+//	definition, or an object contained in one (such as a NodeDef inside a VisualSceneDef, or a Source).
+//	This makes me itself a RefSidRoot: RefSid.Resolve(reg, false) resolves absolute Sid paths
+//	such as "some-node-id/translate.X" regardless of where the object with that Id resides.
+func (me *Registry) FindSidRoot(id string) (root RefSidRoot) {
+	me = me.orDefault()
+	for _, libs := range libsOfRegistry {
+		for _, lib := range libs(me) {
+			for _, entry := range lib.index.lookup(id) {
+				if root, _ = entry.obj.(RefSidRoot); root != nil {
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+func (me *Registry) sidResolver(id string) (rsr refSidResolver) {
+	if root := me.FindSidRoot(id); root != nil {
+		rsr = root.sidResolver(id)
+	}
+	return
+}
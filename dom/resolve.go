@@ -0,0 +1,154 @@
+package cdom
+
+import (
+	"reflect"
+)
+
+var (
+	typeBaseDef  = reflect.TypeOf(BaseDef{})
+	typeHasId    = reflect.TypeOf(HasId{})
+	typeRefId    = reflect.TypeOf(RefId(""))
+	typeRefSid   = reflect.TypeOf(RefSid{})
+	typeRegistry = reflect.TypeOf((*Registry)(nil))
+)
+
+type resolveAll struct {
+	reg        *Registry
+	force      bool
+	unresolved []string
+}
+
+//	Pre-binds all references in me and in all resource definitions of me.Libs(), in a single pass:
+//	calls the EnsureDef() method of every FooInst, and the Resolve() method of every RefSid (including
+//	those of all RefParams). Sid paths that start with "./" or consist of a single Sid are resolved against
+//	the nearest RefSidRoot containing the RefSid, all others against me.Libs() (see Registry.FindSidRoot()).
+//	If force is true, all FooInst.Def and RefSid.V fields are re-resolved, otherwise only those that are nil
+//	(or, in the case of FooInst.Def, "dirty"). Returns the DefRef of every FooInst and the S of every RefSid
+//	that could not be resolved.
+func (me *Document) ResolveAll(force bool) (unresolved []string) {
+	ra := &resolveAll{reg: me.Libs(), force: force}
+	walkDocument(me, func(obj interface{}, root RefSidRoot, defId string) bool {
+		if rs, _ := obj.(*RefSid); rs != nil {
+			ra.resolveSid(rs, root)
+		} else if rv := reflect.ValueOf(obj); rv.MethodByName("EnsureDef").IsValid() {
+			ra.ensureDef(rv)
+		}
+		return true
+	})
+	unresolved = ra.unresolved
+	return
+}
+
+func (me *resolveAll) ensureDef(rv reflect.Value) {
+	if defRef := rv.Elem().FieldByName("DefRef"); (defRef.Kind() == reflect.String) && (defRef.Len() > 0) {
+		if me.force {
+			rv.Elem().FieldByName("Def").Set(reflect.Zero(rv.Elem().FieldByName("Def").Type()))
+		}
+		if def := rv.MethodByName("EnsureDef").Call([]reflect.Value{reflect.ValueOf(me.reg)})[0]; def.IsNil() {
+			me.unresolved = append(me.unresolved, defRef.String())
+		}
+	}
+}
+
+func (me *resolveAll) resolveSid(rs *RefSid, root RefSidRoot) {
+	if len(rs.S) > 0 {
+		if root = sidRoot(me.reg, rs, root); root != nil {
+			rs.Resolve(root, me.force)
+		}
+		if rs.V == nil {
+			me.unresolved = append(me.unresolved, rs.S)
+		}
+	}
+}
+
+//	Returns the RefSidRoot that rs is to be resolved against: reg if the Sid path of rs starts with
+//	an Id, otherwise the nearest RefSidRoot containing rs, that is, root.
+func sidRoot(reg *Registry, rs *RefSid, root RefSidRoot) RefSidRoot {
+	if path, _ := rs.Path(); (path != nil) && (path.Id != ".") {
+		return reg
+	}
+	return root
+}
+
+//	Walks me and all resource definitions of me.Libs() depth-first via reflection, visiting every object once.
+//	Calls visit with a pointer to every struct (such as a *NodeDef, but also the *BaseDef embedded in it) and to every
+//	RefSid and RefId, along with the nearest RefSidRoot and the Id of the nearest resource definition containing it.
+//	If visit returns false, the fields of that struct are skipped. The Def of a FooInst is never walked into, since
+//	it is walked where it is defined.
+func walkDocument(me *Document, visit func(obj interface{}, root RefSidRoot, defId string) bool) {
+	w := &domWalker{seen: map[interface{}]bool{}, visit: visit}
+	w.walk(reflect.ValueOf(me), nil, "")
+	w.walk(reflect.ValueOf(me.Libs()), nil, "")
+}
+
+type domWalker struct {
+	seen  map[interface{}]bool
+	visit func(obj interface{}, root RefSidRoot, defId string) bool
+}
+
+func (me *domWalker) walk(rv reflect.Value, root RefSidRoot, defId string) {
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if !rv.IsNil() {
+			if ptr := rv.Interface(); !me.seen[ptr] {
+				me.seen[ptr] = true
+				if r, _ := ptr.(RefSidRoot); (r != nil) && (rv.Type() != typeRegistry) {
+					root = r
+				}
+				me.walk(rv.Elem(), root, defId)
+			}
+		}
+	case reflect.Interface:
+		if !rv.IsNil() {
+			me.walk(rv.Elem(), root, defId)
+		}
+	case reflect.Array, reflect.Slice:
+		if walkable(rv.Type().Elem()) {
+			for i := 0; i < rv.Len(); i++ {
+				me.walk(rv.Index(i), root, defId)
+			}
+		}
+	case reflect.Map:
+		if walkable(rv.Type().Elem()) {
+			for _, key := range rv.MapKeys() {
+				me.walk(rv.MapIndex(key), root, defId)
+			}
+		}
+	case reflect.String:
+		if (rv.Type() == typeRefId) && rv.CanAddr() {
+			me.visit(rv.Addr().Interface(), root, defId)
+		}
+	case reflect.Struct:
+		isInst := false
+		if rv.CanAddr() {
+			if rv.Type() == typeRefSid {
+				me.visit(rv.Addr().Interface(), root, defId)
+				return
+			}
+			if r, _ := rv.Addr().Interface().(RefSidRoot); r != nil {
+				root = r
+			}
+			if bd := rv.FieldByName("BaseDef"); bd.IsValid() && (bd.Type() == typeBaseDef) {
+				defId = bd.FieldByName("Id").String()
+			}
+			isInst = rv.Addr().MethodByName("EnsureDef").IsValid()
+			if !me.visit(rv.Addr().Interface(), root, defId) {
+				return
+			}
+		}
+		for i := 0; i < rv.NumField(); i++ {
+			//	an instance's Def is walked where it is defined, not where it is instantiated
+			if sf := rv.Type().Field(i); (len(sf.PkgPath) == 0) && !(isInst && (sf.Name == "Def")) {
+				me.walk(rv.Field(i), root, defId)
+			}
+		}
+	}
+}
+
+func walkable(rt reflect.Type) bool {
+	switch rt.Kind() {
+	case reflect.Array, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Struct:
+		return true
+	}
+	return rt == typeRefId
+}
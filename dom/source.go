@@ -1,5 +1,9 @@
 package cdom
 
+import (
+	"fmt"
+)
+
 //	Declares a data repository that provides values
 //	according to the semantics of an Input that refers to it.
 type Source struct {
@@ -25,6 +29,218 @@ type Source struct {
 	}
 }
 
+//	Returns the number of elements that me.TC.Accessor reads from me.Array: its Count,
+//	or (if me has no SourceAccessor) the number of values in me.Array.
+func (me *Source) Count() uint64 {
+	if me.TC.Accessor != nil {
+		return me.TC.Accessor.Count
+	}
+	return uint64(me.Array.Len())
+}
+
+//	Returns all Floats of me.Array selected by the Params with the specified names (or all bound Params,
+//	if no names are specified), element by element, as per ParamIndices().
+func (me *Source) Floats(names ...string) (vals []float64, err error) {
+	var indices []uint64
+	if indices, err = me.ParamIndices(names...); err == nil {
+		if err = me.Validate(); err == nil {
+			count := me.Count()
+			vals = make([]float64, count*uint64(len(indices)))
+			for i := uint64(0); i < count; i++ {
+				me.FloatsAt(i, indices, vals[i*uint64(len(indices)):])
+			}
+		}
+	}
+	return
+}
+
+//	Like Floats(), but returns one Float2 per element. Selecting more than 2 Params is an error, missing values are 0.
+func (me *Source) Float2s(names ...string) (vals []Float2, err error) {
+	err = me.floatsN(2, names, func(count uint64) { vals = make([]Float2, count) }, func(i uint64) []float64 { return vals[i][:] })
+	return
+}
+
+//	Like Floats(), but returns one Float3 per element. Selecting more than 3 Params is an error, missing values are 0.
+func (me *Source) Float3s(names ...string) (vals []Float3, err error) {
+	err = me.floatsN(3, names, func(count uint64) { vals = make([]Float3, count) }, func(i uint64) []float64 { return vals[i][:] })
+	return
+}
+
+//	Like Floats(), but returns one Float4 per element. Selecting more than 4 Params is an error, missing values are 0.
+func (me *Source) Float4s(names ...string) (vals []Float4, err error) {
+	err = me.floatsN(4, names, func(count uint64) { vals = make([]Float4, count) }, func(i uint64) []float64 { return vals[i][:] })
+	return
+}
+
+//	Like Floats(), but returns one Float4x4 per element. Selecting more than 16 Params is an error, missing values are 0.
+//	Note that Collada stores matrices in row-major order, just like Float4x4.
+func (me *Source) Float4x4s(names ...string) (vals []Float4x4, err error) {
+	err = me.floatsN(16, names, func(count uint64) { vals = make([]Float4x4, count) }, func(i uint64) []float64 { return vals[i][:] })
+	return
+}
+
+//	Reads the Floats of me.Array at the specified indices (as returned by ParamIndices()) of the element at
+//	index i into vals, returning the number of values read: at most len(vals), or 0 if i is out of range.
+//	This is the random-access counterpart of Floats(), for consumers that look up elements by index.
+func (me *Source) FloatsAt(i uint64, indices []uint64, vals []float64) (n int) {
+	offset, stride := me.Layout()
+	if i < me.Count() {
+		start := offset + i*stride
+		for _, index := range indices {
+			if n >= len(vals) {
+				break
+			} else if (start + index) >= uint64(len(me.Array.Floats)) {
+				return 0
+			}
+			vals[n] = me.Array.Floats[start+index]
+			n++
+		}
+	}
+	return
+}
+
+//	Returns all Ints of me.Array selected by the Params with the specified names (or all bound Params,
+//	if no names are specified), element by element, as per ParamIndices().
+func (me *Source) Ints(names ...string) (vals []int64, err error) {
+	var indices []uint64
+	if indices, err = me.ParamIndices(names...); err == nil {
+		if err = me.Validate(); err == nil {
+			offset, stride := me.Layout()
+			for i, count := uint64(0), me.Count(); (i < count) && (len(me.Array.Ints) > 0); i++ {
+				for _, index := range indices {
+					vals = append(vals, me.Array.Ints[offset+i*stride+index])
+				}
+			}
+		}
+	}
+	return
+}
+
+//	Returns the Offset and Stride of me.TC.Accessor (with a Stride of 0 counting as 1), or 0 and 1 if me has no SourceAccessor.
+//	The value of element i at position index (as returned by ParamIndices()) is that at offset + i * stride + index in me.Array.
+func (me *Source) Layout() (offset, stride uint64) {
+	if stride = 1; me.TC.Accessor != nil {
+		if offset = me.TC.Accessor.Offset; me.TC.Accessor.Stride > 0 {
+			stride = me.TC.Accessor.Stride
+		}
+	}
+	return
+}
+
+//	Returns the positions, within each element of me.TC.Accessor.Stride values, of the values of the Params with the
+//	specified names (such as "S", "T" or "X", "Y", "Z"), in that order. If no names are specified, returns the positions of
+//	the values of all bound Params, skipping unbound Params (those without a Name). A Param whose Type denotes a vector or
+//	matrix (such as "float3" or "float4x4") spans as many values. If me has no SourceAccessor, or its SourceAccessor has
+//	no Params, every position counts as bound. Returns an error if any of the specified names is not bound.
+func (me *Source) ParamIndices(names ...string) (indices []uint64, err error) {
+	_, stride := me.Layout()
+	if (me.TC.Accessor == nil) || (len(me.TC.Accessor.Params) == 0) {
+		if len(names) == 0 {
+			for i := uint64(0); i < stride; i++ {
+				indices = append(indices, i)
+			}
+		} else {
+			err = fmt.Errorf("source '%s': no param '%s'", me.Id, names[0])
+		}
+		return
+	}
+	var pos, width uint64
+	params := me.TC.Accessor.Params
+	starts := make([]uint64, len(params))
+	for i, p := range params {
+		starts[i] = pos
+		pos += p.Width()
+	}
+	add := func(i int) {
+		for width = params[i].Width(); (width > 0) && ((starts[i] + width) > stride); width-- {
+		}
+		for w := uint64(0); w < width; w++ {
+			indices = append(indices, starts[i]+w)
+		}
+	}
+	if len(names) == 0 {
+		for i, p := range params {
+			if len(p.Name) > 0 {
+				add(i)
+			}
+		}
+		return
+	}
+	for _, name := range names {
+		found := false
+		for i, p := range params {
+			if found = p.Name == name; found {
+				add(i)
+				break
+			}
+		}
+		if !found {
+			indices, err = nil, fmt.Errorf("source '%s': no param '%s'", me.Id, name)
+			return
+		}
+	}
+	return
+}
+
+//	Returns all Names, IdRefs, SidRefs or Tokens (whichever me.Array contains) selected by the Params with
+//	the specified names (or all bound Params, if no names are specified), element by element, as per ParamIndices().
+func (me *Source) Strings(names ...string) (vals []string, err error) {
+	var indices []uint64
+	if indices, err = me.ParamIndices(names...); err == nil {
+		if err = me.Validate(); err == nil {
+			strs := me.Array.Names
+			if len(me.Array.IdRefs) > 0 {
+				strs = me.Array.IdRefs
+			} else if len(me.Array.SidRefs) > 0 {
+				strs = me.Array.SidRefs
+			} else if len(me.Array.Tokens) > 0 {
+				strs = me.Array.Tokens
+			}
+			offset, stride := me.Layout()
+			for i, count := uint64(0), me.Count(); (i < count) && (len(strs) > 0); i++ {
+				for _, index := range indices {
+					vals = append(vals, strs[offset+i*stride+index])
+				}
+			}
+		}
+	}
+	return
+}
+
+//	Returns an error if me.TC.Accessor reads beyond the end of me.Array, that is,
+//	if its Offset + Count * Stride exceeds the number of values in me.Array,
+//	or if its Params span more values than its Stride.
+func (me *Source) Validate() (err error) {
+	if acc := me.TC.Accessor; acc != nil {
+		var width uint64
+		for _, p := range acc.Params {
+			width += p.Width()
+		}
+		if _, stride := me.Layout(); width > stride {
+			err = fmt.Errorf("source '%s': accessor params span %d values but its stride is %d", me.Id, width, stride)
+		} else if (acc.Offset + acc.Count*stride) > uint64(me.Array.Len()) {
+			err = fmt.Errorf("source '%s': accessor reads %d values (offset %d, count %d, stride %d) but the array has only %d", me.Id, acc.Offset+acc.Count*stride, acc.Offset, acc.Count, stride, me.Array.Len())
+		}
+	}
+	return
+}
+
+func (me *Source) floatsN(n int, names []string, alloc func(uint64), val func(uint64) []float64) (err error) {
+	var indices []uint64
+	if indices, err = me.ParamIndices(names...); err == nil {
+		if len(indices) > n {
+			err = fmt.Errorf("source '%s': %d params selected, at most %d expected", me.Id, len(indices), n)
+		} else if err = me.Validate(); err == nil {
+			count := me.Count()
+			alloc(count)
+			for i := uint64(0); i < count; i++ {
+				me.FloatsAt(i, indices, val(i))
+			}
+		}
+	}
+	return
+}
+
 //	Describes a stream of values from an array data source.
 type SourceAccessor struct {
 	//	The number of times the array is accessed. Required.
@@ -83,5 +299,24 @@ type SourceArray struct {
 	Tokens []string
 }
 
+//	Returns the number of values in whichever of me's []slice fields is non-empty.
+func (me *SourceArray) Len() int {
+	switch {
+	case len(me.Bools) > 0:
+		return len(me.Bools)
+	case len(me.Floats) > 0:
+		return len(me.Floats)
+	case len(me.IdRefs) > 0:
+		return len(me.IdRefs)
+	case len(me.Ints) > 0:
+		return len(me.Ints)
+	case len(me.Names) > 0:
+		return len(me.Names)
+	case len(me.SidRefs) > 0:
+		return len(me.SidRefs)
+	}
+	return len(me.Tokens)
+}
+
 //	A hash-table of Sources, each keyed with its Id.
 type Sources map[string]*Source
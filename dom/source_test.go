@@ -0,0 +1,103 @@
+package cdom
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//	Returns a Source of floats with a SourceAccessor of the specified layout and one Param per "name:type" in params
+//	(an empty name denoting an unbound Param), or without a SourceAccessor if count is 0.
+func testSource(floats []float64, offset, count, stride uint64, params ...string) (me *Source) {
+	me = &Source{}
+	me.Id, me.Array.Floats = "src", floats
+	if count > 0 {
+		me.TC.Accessor = &SourceAccessor{Offset: offset, Count: count, Stride: stride}
+		for _, p := range params {
+			nt := strings.SplitN(p, ":", 2)
+			param := &Param{Type: nt[1]}
+			param.Name = nt[0]
+			me.TC.Accessor.Params = append(me.TC.Accessor.Params, param)
+		}
+	}
+	return
+}
+
+func TestSourceFloats(t *testing.T) {
+	seq := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, test := range []struct {
+		name    string
+		src     *Source
+		names   []string
+		indices []uint64
+		floats  []float64
+	}{
+		{"no accessor", testSource(seq[:4], 0, 0, 0), nil, []uint64{0}, seq[:4]},
+		{"all params", testSource(seq[:6], 0, 2, 3, "X:float", "Y:float", "Z:float"), nil, []uint64{0, 1, 2}, seq[:6]},
+		{"named params, reordered", testSource(seq[:6], 0, 2, 3, "X:float", "Y:float", "Z:float"), []string{"Z", "X"}, []uint64{2, 0}, []float64{2, 0, 5, 3}},
+		{"offset", testSource(seq[:7], 1, 2, 3, "X:float", "Y:float", "Z:float"), nil, []uint64{0, 1, 2}, seq[1:7]},
+		{"stride beyond params", testSource(seq[:8], 0, 2, 4, "S:float", "T:float"), nil, []uint64{0, 1}, []float64{0, 1, 4, 5}},
+		{"unbound param", testSource(seq[:6], 0, 2, 3, "S:float", ":float", "T:float"), nil, []uint64{0, 2}, []float64{0, 2, 3, 5}},
+		{"zero stride", testSource(seq[:3], 0, 3, 0, "W:float"), nil, []uint64{0}, seq[:3]},
+		{"vector param", testSource(seq[:8], 0, 2, 4, "M:float3", "W:float"), []string{"W", "M"}, []uint64{3, 0, 1, 2}, []float64{3, 0, 1, 2, 7, 4, 5, 6}},
+		{"no params", testSource(seq[:4], 0, 2, 2), nil, []uint64{0, 1}, seq[:4]},
+	} {
+		indices, err := test.src.ParamIndices(test.names...)
+		if (err != nil) || !reflect.DeepEqual(indices, test.indices) {
+			t.Errorf("%s: got ParamIndices() %v (%v), want %v", test.name, indices, err, test.indices)
+		}
+		floats, err := test.src.Floats(test.names...)
+		if (err != nil) || !reflect.DeepEqual(floats, test.floats) {
+			t.Errorf("%s: got Floats() %v (%v), want %v", test.name, floats, err, test.floats)
+		}
+	}
+}
+
+func TestSourceFloat3s(t *testing.T) {
+	src := testSource([]float64{9, 0, 1, 2, 3, 4, 5}, 1, 2, 3, "X:float", "Y:float", "Z:float")
+	if vals, err := src.Float3s(); (err != nil) || !reflect.DeepEqual(vals, []Float3{{0, 1, 2}, {3, 4, 5}}) {
+		t.Errorf("got %v (%v), want [[0 1 2] [3 4 5]]", vals, err)
+	}
+	if vals, err := src.Float3s("Y"); (err != nil) || !reflect.DeepEqual(vals, []Float3{{1, 0, 0}, {4, 0, 0}}) {
+		t.Errorf("got %v (%v), want missing values to be 0", vals, err)
+	}
+	src = testSource([]float64{0, 1, 2, 3}, 0, 1, 4, "X:float", "Y:float", "Z:float", "W:float")
+	if _, err := src.Float3s(); err == nil {
+		t.Errorf("got no error for 4 params selected into a Float3")
+	}
+	if offset, stride := src.Layout(); (offset != 0) || (stride != 4) {
+		t.Errorf("got Layout() %d, %d, want 0, 4", offset, stride)
+	}
+}
+
+func TestSourceValidate(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  *Source
+		err  string
+	}{
+		{"no accessor", testSource(make([]float64, 3), 0, 0, 0), ""},
+		{"exact", testSource(make([]float64, 7), 1, 2, 3, "X:float", "Y:float", "Z:float"), ""},
+		{"offset beyond array", testSource(make([]float64, 6), 1, 2, 3, "X:float", "Y:float", "Z:float"), "reads 7 values"},
+		{"count beyond array", testSource(make([]float64, 6), 0, 3, 3, "X:float", "Y:float", "Z:float"), "reads 9 values"},
+		{"params wider than stride", testSource(make([]float64, 4), 0, 2, 2, "M:float3"), "span 3 values"},
+		{"unbound params count", testSource(make([]float64, 4), 0, 2, 2, ":float", ":float", "X:float"), "span 3 values"},
+	} {
+		err := test.src.Validate()
+		if len(test.err) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+		} else if (err == nil) || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got error %v, want one containing %q", test.name, err, test.err)
+		} else if _, ferr := test.src.Floats(); ferr == nil {
+			t.Errorf("%s: Floats() returned no error", test.name)
+		}
+	}
+	if _, err := testSource(make([]float64, 3), 0, 3, 1, "X:float").ParamIndices("Y"); err == nil {
+		t.Errorf("got no error for an unknown param name")
+	}
+	if _, err := testSource(make([]float64, 3), 0, 0, 0).ParamIndices("X"); err == nil {
+		t.Errorf("got no error for a param name without accessor")
+	}
+}
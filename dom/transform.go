@@ -37,7 +37,7 @@ type Transform struct {
 	//	For TransformKindLookat:
 	//		9 values representing three 3D vectors (eye position, interest point, up-axis).
 	//	For TransformKindMatrix:
-	//		16 values representing one column-major 4x4 matrix.
+	//		16 values representing one 4x4 matrix (transforming column vectors), in row-major order.
 	//	For TransformKindSkew:
 	//		7 values -- one angle in degrees, then two 3D vectors for the axes of rotation and translation.
 	//	For TransformKindRotate:
@@ -48,24 +48,35 @@ type Transform struct {
 }
 
 //	RefSidFielder implementation.
-//	Supported field names: "X", "Y", "Z" (mapped to the first three values in me.F).
+//	Supported field names: "X", "Y", "Z" (mapped to the first three values in me.F),
+//	and "ANGLE" (mapped to the fourth value in me.F, that is, the angle of a TransformKindRotate).
 func (me *Transform) AccessField(fn string) interface{} {
 	switch fn {
+	case "ANGLE":
+		if len(me.F) > 3 {
+			return &me.F[3]
+		}
 	case "X":
-		return &me.F[0]
+		if len(me.F) > 0 {
+			return &me.F[0]
+		}
 	case "Y":
-		return &me.F[1]
+		if len(me.F) > 1 {
+			return &me.F[1]
+		}
 	case "Z":
-		return &me.F[2]
+		if len(me.F) > 2 {
+			return &me.F[2]
+		}
 	}
 	return nil
 }
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional and two-dimensional indices.
+//	Supports one-dimensional indices, and two-dimensional (row, column) indices into a TransformKindMatrix.
 func (me *Transform) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me.F))
+	if i = accessIndex(i, j, 4, len(me.F)); i >= 0 {
+		return &me.F[i]
 	}
-	return &me.F[i]
+	return nil
 }
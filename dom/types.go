@@ -10,7 +10,10 @@ type Bool2 [2]bool
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Bool2) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains three bool values.
@@ -19,7 +22,10 @@ type Bool3 [3]bool
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Bool3) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains four bool values.
@@ -28,7 +34,10 @@ type Bool4 [4]bool
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Bool4) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains two float64 values.
@@ -37,43 +46,46 @@ type Float2 [2]float64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Float2) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains four float64 values.
 type Float2x2 [4]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional or two-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float2x2) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 2, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains six float64 values.
 type Float2x3 [6]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float2x3) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 3, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains eight float64 values.
 type Float2x4 [8]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float2x4) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 4, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains three float64 values.
@@ -82,43 +94,46 @@ type Float3 [3]float64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Float3) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains six float64 values.
 type Float3x2 [6]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float3x2) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 2, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains nine float64 values.
 type Float3x3 [9]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float3x3) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 3, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains twelve float64 values.
 type Float3x4 [12]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float3x4) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 4, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains four float64 values.
@@ -127,43 +142,46 @@ type Float4 [4]float64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Float4) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains eight float64 values.
 type Float4x2 [8]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float4x2) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 2, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains twelve float64 values.
 type Float4x3 [12]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float4x3) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 3, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains sixteen float64 values.
 type Float4x4 [16]float64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Float4x4) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 4, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains seven float64 values.
@@ -172,7 +190,10 @@ type Float7 [7]float64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Float7) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains two int64 values.
@@ -181,19 +202,22 @@ type Int2 [2]int64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Int2) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains four int64 values.
 type Int2x2 [4]int64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Int2x2) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 2, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains three int64 values.
@@ -202,19 +226,22 @@ type Int3 [3]int64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Int3) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains nine int64 values.
 type Int3x3 [9]int64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Int3x3) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 3, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Contains four int64 values.
@@ -223,19 +250,22 @@ type Int4 [4]int64
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *Int4) AccessIndex(i, _ int) interface{} {
-	return &me[i]
+	if (i >= 0) && (i < len(me)) {
+		return &me[i]
+	}
+	return nil
 }
 
 //	Contains sixteen int64 values.
 type Int4x4 [16]int64
 
 //	RefSidIndexer implementation.
-//	Supports one-dimensional indices.
+//	Supports one-dimensional or two-dimensional (row, column) indices.
 func (me *Int4x4) AccessIndex(i, j int) interface{} {
-	if j >= 0 {
-		i = index2D(i, j, len(me))
+	if i = accessIndex(i, j, 4, len(me)); i >= 0 {
+		return &me[i]
 	}
-	return &me[i]
+	return nil
 }
 
 //	Provides a bool value.
@@ -268,7 +298,10 @@ type ParamOrFloat2 struct {
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *ParamOrFloat2) AccessIndex(i, _ int) interface{} {
-	return &me.F[i]
+	if (i >= 0) && (i < len(me.F)) {
+		return &me.F[i]
+	}
+	return nil
 }
 
 //	Provides a int64 value.
@@ -337,7 +370,10 @@ type SidFloat3 struct {
 //	RefSidIndexer implementation.
 //	Supports one-dimensional indices.
 func (me *SidFloat3) AccessIndex(i, _ int) interface{} {
-	return &me.F[i]
+	if (i >= 0) && (i < len(me.F)) {
+		return &me.F[i]
+	}
+	return nil
 }
 
 //	A string value that has a scoped identifier.
@@ -0,0 +1,519 @@
+package cdomutil
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	The keys of an AnimationSampler, as read from the Sources of its Inputs by NewAnimationCurve().
+type AnimationCurve struct {
+	//	The sampler whose Inputs provided the keys.
+	Sampler *cdom.AnimationSampler
+
+	//	The time of each key, from the Source of the "INPUT" Input. Must be in ascending order.
+	Times []float64
+
+	//	The values of each key, Stride per key, from the Source of the "OUTPUT" Input.
+	Values []float64
+
+	//	The number of values per key.
+	Stride int
+
+	//	The interpolation of the segment starting at each key ("LINEAR", "STEP", "BEZIER", "HERMITE", "BSPLINE"
+	//	or "CARDINAL"), from the Source of the "INTERPOLATION" Input. Keys without one are interpolated linearly.
+	//	"BSPLINE" segments are uniform cubic B-splines with the key values as control points, "CARDINAL"
+	//	segments are Catmull-Rom splines through the key values, both ignoring the tangents.
+	Interpolations []string
+
+	//	The tangents of each key, from the Sources of the "IN_TANGENT" and "OUT_TANGENT" Inputs: either one 2D
+	//	control point (time and value) per value, as in Collada 1.5, or one value per value, as in Collada 1.4.
+	InTangents, OutTangents []float64
+}
+
+//	Reads the keys of sampler from the Sources of anim. Returns an error if sampler has no keys, if any of
+//	its Inputs refers to a Source not in anim.Sources, or if the Sources of its Inputs disagree on the number of keys
+//	or the number of values per key.
+func NewAnimationCurve(anim *cdom.AnimationDef, sampler *cdom.AnimationSampler) (me *AnimationCurve, err error) {
+	me = &AnimationCurve{Sampler: sampler}
+	var count uint64
+	for _, in := range sampler.Inputs {
+		src := anim.Sources[in.Source.S()]
+		if src == nil {
+			err = fmt.Errorf("sampler '%s': no source '%s' for input '%s'", sampler.Id, in.Source, in.Semantic)
+		} else {
+			switch in.Semantic {
+			case "INPUT":
+				me.Times, err = src.Floats()
+				count = src.Count()
+			case "OUTPUT":
+				me.Values, err = src.Floats()
+			case "INTERPOLATION":
+				me.Interpolations, err = src.Strings()
+			case "IN_TANGENT":
+				me.InTangents, err = src.Floats()
+			case "OUT_TANGENT":
+				me.OutTangents, err = src.Floats()
+			}
+		}
+		if err != nil {
+			me = nil
+			return
+		}
+	}
+	if count == 0 {
+		err = fmt.Errorf("sampler '%s': no keys", sampler.Id)
+	} else if me.Stride = len(me.Values) / int(count); (me.Stride == 0) || ((me.Stride * int(count)) != len(me.Values)) || (len(me.Times) != int(count)) {
+		err = fmt.Errorf("sampler '%s': %d times but %d values", sampler.Id, len(me.Times), len(me.Values))
+	} else {
+		for _, tan := range []*[]float64{&me.InTangents, &me.OutTangents} {
+			if n := len(*tan) / int(count); (len(*tan) > 0) && (((n * int(count)) != len(*tan)) || ((n != me.Stride) && (n != 2*me.Stride))) {
+				err = fmt.Errorf("sampler '%s': %d keys of %d values but %d tangent values", sampler.Id, count, me.Stride, len(*tan))
+			}
+		}
+		if (len(me.InTangents) > 0) && (len(me.OutTangents) > 0) && (len(me.InTangents) != len(me.OutTangents)) {
+			err = fmt.Errorf("sampler '%s': %d in-tangent values but %d out-tangent values", sampler.Id, len(me.InTangents), len(me.OutTangents))
+		}
+	}
+	if err != nil {
+		me = nil
+	}
+	return
+}
+
+//	Returns the time of the first key of me.
+func (me *AnimationCurve) Start() float64 {
+	return me.Times[0]
+}
+
+//	Returns the time of the last key of me.
+func (me *AnimationCurve) End() float64 {
+	return me.Times[len(me.Times)-1]
+}
+
+//	Writes the values of me at time t into vals, which must have a length of at least me.Stride.
+//	Before the first key and after the last key, the PreBehavior and PostBehavior of me.Sampler apply:
+//	AnimSamplerBehaviorCycle repeats the keys, AnimSamplerBehaviorCycleRelative also offsets each repetition
+//	by the difference between the values of the last and first key, AnimSamplerBehaviorOscillate repeats
+//	the keys alternately backwards and forwards, and AnimSamplerBehaviorGradient continues along the line
+//	through the first two (or last two) keys. All other behaviors hold the value of the first (or last) key.
+func (me *AnimationCurve) Sample(t float64, vals []float64) {
+	var cycles float64
+	n, first, last, behavior := len(me.Times), me.Start(), me.End(), cdom.AnimSamplerBehaviorConstant
+	if t < first {
+		behavior = me.Sampler.PreBehavior
+	} else if t > last {
+		behavior = me.Sampler.PostBehavior
+	}
+	if dur := last - first; (behavior != cdom.AnimSamplerBehaviorConstant) && (dur > 0) {
+		switch behavior {
+		case cdom.AnimSamplerBehaviorCycle, cdom.AnimSamplerBehaviorCycleRelative, cdom.AnimSamplerBehaviorOscillate:
+			cycles = math.Floor((t - first) / dur)
+			if t = first + (t - first - cycles*dur); (behavior == cdom.AnimSamplerBehaviorOscillate) && (math.Mod(cycles, 2) != 0) {
+				t = last - (t - first)
+			}
+			if behavior != cdom.AnimSamplerBehaviorCycleRelative {
+				cycles = 0
+			}
+		case cdom.AnimSamplerBehaviorGradient:
+			k := 0
+			if t > last {
+				k = n - 2
+			}
+			if t0, t1 := me.Times[k], me.Times[k+1]; t1 > t0 {
+				for i := 0; i < me.Stride; i++ {
+					v0 := me.value(k, i)
+					vals[i] = v0 + (t-t0)*(me.value(k+1, i)-v0)/(t1-t0)
+				}
+				return
+			}
+		}
+	}
+	me.interpolate(math.Max(first, math.Min(last, t)), vals)
+	if cycles != 0 {
+		for i := 0; i < me.Stride; i++ {
+			vals[i] += cycles * (me.value(n-1, i) - me.value(0, i))
+		}
+	}
+}
+
+func (me *AnimationCurve) interpolate(t float64, vals []float64) {
+	n := len(me.Times)
+	k := sort.SearchFloat64s(me.Times, t) - 1
+	if k > (n - 2) {
+		k = n - 2
+	}
+	if k < 0 {
+		k = 0
+	}
+	if n == 1 {
+		copy(vals[:me.Stride], me.Values)
+		return
+	}
+	var s float64
+	if t0, t1 := me.Times[k], me.Times[k+1]; t1 > t0 {
+		s = (t - t0) / (t1 - t0)
+	}
+	interp := "LINEAR"
+	if k < len(me.Interpolations) {
+		interp = me.Interpolations[k]
+	}
+	for i := 0; i < me.Stride; i++ {
+		p0, p1 := me.value(k, i), me.value(k+1, i)
+		switch interp {
+		case "STEP":
+			if vals[i] = p0; s >= 1 {
+				vals[i] = p1
+			}
+		case "BEZIER":
+			vals[i] = me.bezier(k, i, t, s)
+		case "HERMITE":
+			vals[i] = hermite(p0, p1, me.tangent(me.OutTangents, k, i, p1-p0), me.tangent(me.InTangents, k+1, i, p1-p0), s)
+		case "BSPLINE":
+			pp, pn, is, s2 := me.value(k-1, i), me.value(k+2, i), 1-s, s*s
+			vals[i] = (is*is*is*pp + (3*s2*s-6*s2+4)*p0 + (-3*s2*s+3*s2+3*s+1)*p1 + s2*s*pn) / 6
+		case "CARDINAL":
+			vals[i] = hermite(p0, p1, (p1-me.value(k-1, i))/2, (me.value(k+2, i)-p0)/2, s)
+		default:
+			vals[i] = p0 + s*(p1-p0)
+		}
+	}
+}
+
+//	Evaluates the cubic Bezier segment between keys k and k+1 for value i at time t (or at s if the tangents are 1D).
+func (me *AnimationCurve) bezier(k, i int, t, s float64) float64 {
+	n := len(me.Times)
+	p0, p1 := me.value(k, i), me.value(k+1, i)
+	if (len(me.OutTangents) == 0) || (len(me.InTangents) == 0) {
+		return p0 + s*(p1-p0)
+	}
+	c0, c1 := me.OutTangents[k*len(me.OutTangents)/n:], me.InTangents[(k+1)*len(me.InTangents)/n:]
+	if (len(me.OutTangents) / n) < (2 * me.Stride) {
+		return bezier(p0, c0[i], c1[i], p1, s)
+	}
+	//	find the curve parameter u at which the curve reaches time t, keeping its
+	//	control points within the segment so that the time is monotonic in u
+	t0, t1 := me.Times[k], me.Times[k+1]
+	x0, x1 := math.Max(t0, math.Min(t1, c0[2*i])), math.Max(t0, math.Min(t1, c1[2*i]))
+	lo, hi, u := 0.0, 1.0, s
+	for iter := 0; iter < 64; iter++ {
+		if x := bezier(t0, x0, x1, t1, u); math.Abs(x-t) < 1e-12 {
+			break
+		} else if x < t {
+			lo = u
+		} else {
+			hi = u
+		}
+		u = (lo + hi) / 2
+	}
+	return bezier(p0, c0[2*i+1], c1[2*i+1], p1, u)
+}
+
+//	Returns the tangent of value i of key k in tans, for use in the Hermite formula as-is.
+//	Of 2D tangents, only the value is used. If there are no tangents, returns def.
+func (me *AnimationCurve) tangent(tans []float64, k, i int, def float64) float64 {
+	if len(tans) == 0 {
+		return def
+	}
+	n := len(tans) / len(me.Times)
+	if n >= (2 * me.Stride) {
+		return tans[k*n+2*i+1]
+	}
+	return tans[k*n+i]
+}
+
+//	Returns value i of key k, clamping k to the range of keys.
+func (me *AnimationCurve) value(k, i int) float64 {
+	if k < 0 {
+		k = 0
+	} else if k >= len(me.Times) {
+		k = len(me.Times) - 1
+	}
+	return me.Values[k*me.Stride+i]
+}
+
+//	An AnimationChannel, bound to the AnimationCurve of its sampler, as created by NewAnimator().
+type AnimationTrack struct {
+	//	The AnimationDef declaring Channel.
+	Anim *cdom.AnimationDef
+
+	//	The channel.
+	Channel *cdom.AnimationChannel
+
+	//	The value animated by Channel: its resolved Target.V, or else the values of a Source that its Target
+	//	refers to by Id, as per NewAnimator().
+	Target interface{}
+
+	//	The keys of the sampler referred to by Channel.Source.
+	Curve *AnimationCurve
+}
+
+//	Evaluates the Channels of a set of AnimationDefs, as created by NewAnimator().
+type Animator struct {
+	//	All Channels of the AnimationDefs that have a resolved Target.
+	Tracks []*AnimationTrack
+
+	//	All Channels of the AnimationDefs whose Target could not be resolved,
+	//	or resolved to a value that cannot be animated (such as a string).
+	Unresolved []*cdom.AnimationChannel
+
+	reg  *cdom.Registry
+	vals []float64
+}
+
+//	Creates an Animator for all Channels of anims and their nested AnimationDefs. The Target of each Channel
+//	is resolved (unless already resolved) against reg, or DefaultRegistry if reg is nil. If that fails, a Target of
+//	the form "id" or "id(i)" (as many exporters write for the weights of morph controllers) is bound to all values,
+//	or to the first value of the i-th element, of the Source with that Id in any AnimationDef, ControllerDef or
+//	GeometryDef of reg. Returns an error if a Channel refers to a sampler not declared in the same AnimationDef,
+//	or if NewAnimationCurve() fails for a sampler.
+func NewAnimator(reg *cdom.Registry, anims ...*cdom.AnimationDef) (me *Animator, err error) {
+	if reg == nil {
+		reg = cdom.DefaultRegistry
+	}
+	me = &Animator{reg: reg}
+	curves := map[*cdom.AnimationSampler]*AnimationCurve{}
+	var walk func(*cdom.AnimationDef)
+	walk = func(anim *cdom.AnimationDef) {
+		for _, ch := range anim.Channels {
+			var (
+				sampler *cdom.AnimationSampler
+				target  interface{}
+			)
+			for _, s := range anim.Samplers {
+				if s.Id == ch.Source.S() {
+					sampler = s
+					break
+				}
+			}
+			if sampler == nil {
+				err = fmt.Errorf("animation '%s': no sampler '%s'", anim.Id, ch.Source)
+				return
+			}
+			curve := curves[sampler]
+			if curve == nil {
+				if curve, err = NewAnimationCurve(anim, sampler); err != nil {
+					return
+				}
+				curves[sampler] = curve
+			}
+			ch.Target.Resolve(reg, false)
+			if target = ch.Target.V; target == nil {
+				_, target = sourceTarget(reg, ch.Target.S)
+			}
+			if (target == nil) || !setAnimated(target, nil) {
+				me.Unresolved = append(me.Unresolved, ch)
+			} else {
+				me.Tracks = append(me.Tracks, &AnimationTrack{Anim: anim, Channel: ch, Curve: curve, Target: target})
+				if curve.Stride > len(me.vals) {
+					me.vals = make([]float64, curve.Stride)
+				}
+			}
+		}
+		for _, sub := range anim.AnimationDefs {
+			if err == nil {
+				walk(sub)
+			}
+		}
+	}
+	for _, anim := range anims {
+		if err == nil {
+			walk(anim)
+		}
+	}
+	if err != nil {
+		me = nil
+	}
+	return
+}
+
+//	Samples all me.Tracks at time t, as per AnimationCurve.Sample(), and writes the results through
+//	their Target. A Target addressing a whole Transform (or other multi-value type) receives
+//	as many values as it holds, and as many as the curve provides. Note that world matrices derived from
+//	animated Transforms (such as those computed by VisualSceneNodes()) need to be recomputed afterwards.
+func (me *Animator) Apply(t float64) {
+	for _, track := range me.Tracks {
+		vals := me.vals[:track.Curve.Stride]
+		track.Curve.Sample(t, vals)
+		setAnimated(track.Target, vals)
+	}
+}
+
+//	Resamples all me.Tracks at fps samples per second from start to end (both included), and returns a new
+//	AnimationDef with the specified Id holding the results, with all times relative to start: one AnimationSampler
+//	with "LINEAR" interpolation per AnimationCurve, and one AnimationChannel per track, targeting the same Sid path.
+//	The Ids of the new samplers and their sources are prefixed with id. Returns an error if fps is not positive.
+//	The new AnimationDef is created by the AnimationDefs library of the Registry passed to NewAnimator(), but not added to it.
+func (me *Animator) Bake(id string, start, end, fps float64) (anim *cdom.AnimationDef, err error) {
+	if fps <= 0 {
+		err = fmt.Errorf("animation '%s': invalid fps %v", id, fps)
+		return
+	}
+	var times []float64
+	for i, n := 0, int(math.Ceil((end-start)*fps-1e-6)); i < n; i++ {
+		times = append(times, float64(i)/fps)
+	}
+	times = append(times, math.Max(0, end-start))
+	anim = me.reg.AnimationDefs.New(id)
+	samplers := map[*AnimationCurve]*cdom.AnimationSampler{}
+	for _, track := range me.Tracks {
+		curve := track.Curve
+		sampler := samplers[curve]
+		if sampler == nil {
+			sampler = &cdom.AnimationSampler{}
+			if sampler.Id = fmt.Sprintf("%s-%s", id, curve.Sampler.Id); len(curve.Sampler.Id) == 0 {
+				sampler.Id = fmt.Sprintf("%s-sampler%d", id, len(anim.Samplers))
+			}
+			vals, interps := make([]float64, len(times)*curve.Stride), make([]string, len(times))
+			for i, t := range times {
+				curve.Sample(start+t, vals[i*curve.Stride:(i+1)*curve.Stride])
+				interps[i] = "LINEAR"
+			}
+			output := NewSourceFloats(sampler.Id+"-output", vals)
+			output.TC.Accessor.Stride, output.TC.Accessor.Count, output.TC.Accessor.Params = uint64(curve.Stride), uint64(len(times)), bakeParams(track)
+			for i, src := range []*cdom.Source{NewSourceFloats(sampler.Id+"-input", append([]float64{}, times...), "TIME"), output, NewSourceNames(sampler.Id+"-interpolation", interps, "INTERPOLATION")} {
+				anim.Sources[src.Id] = src
+				sampler.Inputs = append(sampler.Inputs, &cdom.Input{Semantic: [...]string{"INPUT", "OUTPUT", "INTERPOLATION"}[i], Source: cdom.RefId(src.Id)})
+			}
+			samplers[curve] = sampler
+			anim.Samplers = append(anim.Samplers, sampler)
+		}
+		ch := &cdom.AnimationChannel{Source: cdom.RefId(sampler.Id)}
+		ch.Target.SetSidRef(track.Channel.Target.S)
+		anim.Channels = append(anim.Channels, ch)
+	}
+	return
+}
+
+//	Returns the earliest Start() and the latest End() of the Curves of all me.Tracks, or 0 and 0 if there are none.
+func (me *Animator) TimeRange() (start, end float64) {
+	for i, track := range me.Tracks {
+		if s, e := track.Curve.Start(), track.Curve.End(); i == 0 {
+			start, end = s, e
+		} else {
+			start, end = math.Min(start, s), math.Max(end, e)
+		}
+	}
+	return
+}
+
+//	Returns copies of the bound Params of the "OUTPUT" Source of track.Curve.Sampler, or (if those do
+//	not span exactly as many values as track.Curve.Stride) one "float" Param per value, named "V0", "V1" etc.
+func bakeParams(track *AnimationTrack) (params []*cdom.Param) {
+	var width uint64
+	for _, in := range track.Curve.Sampler.Inputs {
+		if src := track.Anim.Sources[in.Source.S()]; (in.Semantic == "OUTPUT") && (src != nil) && (src.TC.Accessor != nil) {
+			for _, p := range src.TC.Accessor.Params {
+				if len(p.Name) > 0 {
+					param := *p
+					params, width = append(params, &param), width+p.Width()
+				}
+			}
+		}
+	}
+	if width != uint64(track.Curve.Stride) {
+		params = nil
+		for i := 0; i < track.Curve.Stride; i++ {
+			param := &cdom.Param{Type: "float"}
+			param.Name = fmt.Sprintf("V%d", i)
+			params = append(params, param)
+		}
+	}
+	return
+}
+
+func bezier(p0, c0, c1, p1, s float64) float64 {
+	is := 1 - s
+	return is*is*is*p0 + 3*s*is*is*c0 + 3*s*s*is*c1 + s*s*s*p1
+}
+
+func hermite(p0, p1, t0, t1, s float64) float64 {
+	s2, s3 := s*s, s*s*s
+	return (2*s3-3*s2+1)*p0 + (s3-2*s2+s)*t0 + (-2*s3+3*s2)*p1 + (s3-s2)*t1
+}
+
+//	Returns the Source referred to by path ("id" or "id(i)") and a pointer to all its Floats, or to the first value
+//	of its i-th element, or nil and nil if there is no such Source or element.
+func sourceTarget(reg *cdom.Registry, path string) (src *cdom.Source, target interface{}) {
+	id, elem := path, -1
+	if pos := strings.Index(path, "("); (pos > 0) && strings.HasSuffix(path, ")") {
+		var err error
+		if id = path[:pos]; len(id) > 0 {
+			if elem, err = strconv.Atoi(path[pos+1 : len(path)-1]); (err != nil) || (elem < 0) {
+				return
+			}
+		}
+	}
+	if src = cdom.RefId(id).SourceInAnyDef(reg); (src == nil) || (len(src.Array.Floats) == 0) {
+		src = nil
+		return
+	} else if elem < 0 {
+		target = &src.Array.Floats
+		return
+	}
+	indices, err := src.ParamIndices()
+	if (err == nil) && (len(indices) > 0) && (uint64(elem) < src.Count()) {
+		offset, stride := src.Layout()
+		if i := offset + uint64(elem)*stride + indices[0]; i < uint64(len(src.Array.Floats)) {
+			target = &src.Array.Floats[i]
+		}
+	}
+	if target == nil {
+		src = nil
+	}
+	return
+}
+
+//	Writes vals through v (a resolved RefSid.V), returning false if v is of a type that cannot be animated.
+//	Surplus values are ignored, missing values leave the corresponding parts of v untouched.
+func setAnimated(v interface{}, vals []float64) bool {
+	switch tv := v.(type) {
+	case *float64:
+		if len(vals) > 0 {
+			*tv = vals[0]
+		}
+	case *float32:
+		if len(vals) > 0 {
+			*tv = float32(vals[0])
+		}
+	case *cdom.FxColor:
+		for i, f := range []*float32{&tv.R, &tv.G, &tv.B, &tv.A} {
+			if i < len(vals) {
+				*f = float32(vals[i])
+			}
+		}
+	case *cdom.SidFloat:
+		return setAnimated(&tv.F, vals)
+	case *cdom.SidFloat3:
+		copy(tv.F[:], vals)
+	case *cdom.SidVec3:
+		for i, f := range []*float64{&tv.X, &tv.Y, &tv.Z} {
+			if i < len(vals) {
+				*f = vals[i]
+			}
+		}
+	case *cdom.Transform:
+		copy(tv.F, vals)
+	default:
+		//	pointers to arrays or slices of floats, such as *cdom.Float4x4
+		rv := reflect.ValueOf(v)
+		if (rv.Kind() != reflect.Ptr) || rv.IsNil() {
+			return false
+		}
+		if rv = rv.Elem(); (rv.Kind() != reflect.Array) && (rv.Kind() != reflect.Slice) {
+			return false
+		}
+		if k := rv.Type().Elem().Kind(); (k != reflect.Float64) && (k != reflect.Float32) {
+			return false
+		}
+		for i := 0; (i < rv.Len()) && (i < len(vals)); i++ {
+			rv.Index(i).SetFloat(vals[i])
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package cdomutil_test
+
+import (
+	"math"
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+	collimp "github.com/metaleap/go-collada/imp-1.5"
+)
+
+const (
+	testDocHead = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+`
+
+	//	Rotates node "root" about Z from 0 to 40 degrees over 4 seconds, in steps of 10 degrees per second.
+	testAnimDoc = testDocHead + `<library_animations><animation id="turn">
+<source id="in"><float_array id="in-a" count="5">0 1 2 3 4</float_array><technique_common><accessor source="#in-a" count="5"><param name="TIME" type="float"/></accessor></technique_common></source>
+<source id="out"><float_array id="out-a" count="5">0 10 20 30 40</float_array><technique_common><accessor source="#out-a" count="5"><param name="ANGLE" type="float"/></accessor></technique_common></source>
+<sampler id="s"><input semantic="INPUT" source="#in"/><input semantic="OUTPUT" source="#out"/></sampler>
+<channel source="#s" target="root/rz.ANGLE"/>
+</animation></library_animations>
+<library_visual_scenes><visual_scene id="vs"><node id="root"><rotate sid="rz">0 0 1 0</rotate></node></visual_scene></library_visual_scenes>
+</COLLADA>`
+)
+
+//	Imports src into a new Registry, failing t on any error.
+func testImport(t *testing.T, src string) (doc *cdom.Document) {
+	bag := collimp.NewImportBag()
+	bag.Log, bag.Registry = nil, cdom.NewRegistry()
+	var err error
+	if doc, err = collimp.ImportCollada([]byte(src), bag); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	return
+}
+
+func testNearly(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestAnimatorBake(t *testing.T) {
+	reg := testImport(t, testAnimDoc).Registry
+	animator, err := cdomutil.NewAnimator(reg, reg.AnimationDefs.M["turn"])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	baked, err := animator.Bake("baked", 1, 3, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (reg.AnimationDefs.M["baked"] != nil) || (cdom.AnimationDefs.M["baked"] != nil) {
+		t.Errorf("Bake() added its AnimationDef to a library")
+	}
+	if (len(baked.Samplers) != 1) || (len(baked.Channels) != 1) || (baked.Channels[0].Target.S != "root/rz.ANGLE") {
+		t.Fatalf("got %d samplers and %d channels, want one of each targeting root/rz.ANGLE", len(baked.Samplers), len(baked.Channels))
+	}
+	var times, vals []float64
+	for _, in := range baked.Samplers[0].Inputs {
+		if src := baked.Sources[in.Source.S()]; in.Semantic == "INPUT" {
+			times, _ = src.Floats()
+		} else if in.Semantic == "OUTPUT" {
+			vals, _ = src.Floats()
+		}
+	}
+	want := []float64{10, 15, 20, 25, 30}
+	if (len(times) != len(want)) || (len(vals) != len(want)) {
+		t.Fatalf("got times %v and values %v, want 5 of each", times, vals)
+	}
+	for i := range want {
+		if !testNearly(times[i], float64(i)/2) || !testNearly(vals[i], want[i]) {
+			t.Errorf("key %d: got %v at %v, want %v at %v", i, vals[i], times[i], want[i], float64(i)/2)
+		}
+	}
+	if _, err = animator.Bake("bad", 0, 1, 0); err == nil {
+		t.Errorf("got no error for 0 fps")
+	}
+}
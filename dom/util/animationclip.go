@@ -0,0 +1,135 @@
+package cdomutil
+
+import (
+	"fmt"
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	A named section of an animation, as passed to AnimationSplit().
+type AnimationClipRange struct {
+	//	The Id and Name of the AnimationClipDef to be created.
+	Name string
+
+	//	The time in seconds of the beginning and end of the clip.
+	Start, End float64
+}
+
+//	Plays an AnimationClipDef, as created by NewAnimationClipPlayer().
+type AnimationClipPlayer struct {
+	//	The clip being played.
+	Clip *cdom.AnimationClipDef
+
+	//	Evaluates all AnimationDefs instantiated by Clip.Animations.
+	Animator *Animator
+
+	//	Whether Advance() wraps around to the beginning of Clip once it reaches its end.
+	Loop bool
+
+	//	The current playback position, in seconds since the beginning of Clip.
+	//	Set by Seek() and Advance().
+	Time float64
+}
+
+//	Creates an AnimationClipPlayer for clip, resolving clip.Animations via their EnsureDef(reg) method and the
+//	targets of their Channels as per NewAnimator(). Returns an error if any of clip.Animations cannot be resolved,
+//	or if NewAnimator() fails. The Formulas of clip are not evaluated. Does not apply any values yet, see Seek().
+func NewAnimationClipPlayer(clip *cdom.AnimationClipDef, reg *cdom.Registry) (me *AnimationClipPlayer, err error) {
+	anims := make([]*cdom.AnimationDef, 0, len(clip.Animations))
+	for _, inst := range clip.Animations {
+		if def := inst.EnsureDef(reg); def != nil {
+			anims = append(anims, def)
+		} else {
+			err = fmt.Errorf("animation clip '%s': no animation '%s'", clip.Id, inst.DefRef)
+			return
+		}
+	}
+	me = &AnimationClipPlayer{Clip: clip}
+	if me.Animator, err = NewAnimator(reg, anims...); err != nil {
+		me = nil
+	}
+	return
+}
+
+//	Advances me.Time by dt seconds and applies the values at the new position. If this reaches or passes
+//	the end of me.Clip, me.Time wraps around if me.Loop is true, otherwise it stops at the end and done is true.
+func (me *AnimationClipPlayer) Advance(dt float64) (done bool) {
+	dur := me.Duration()
+	if t := me.Time + dt; me.Loop && (dur > 0) {
+		me.Seek(t - math.Floor(t/dur)*dur)
+	} else {
+		done = t >= dur
+		me.Seek(t)
+	}
+	return
+}
+
+//	Returns the length in seconds of me.Clip, from Start() to End().
+func (me *AnimationClipPlayer) Duration() float64 {
+	return me.End() - me.Start()
+}
+
+//	Returns the time in seconds, within the animations of me.Clip, of the end of me.Clip. This is me.Clip.End,
+//	unless that is not after me.Clip.Start (such as when it was left unspecified): then, it is the time of the
+//	last key of the animations, as per Animator.TimeRange(), or Start() if that is earlier.
+func (me *AnimationClipPlayer) End() float64 {
+	if me.Clip.End > me.Clip.Start {
+		return me.Clip.End
+	}
+	_, end := me.Animator.TimeRange()
+	return math.Max(me.Start(), end)
+}
+
+//	Sets me.Time to t (clamped to the range from 0 to Duration()) and applies
+//	the values at that position, as per Animator.Apply(Start() + me.Time).
+func (me *AnimationClipPlayer) Seek(t float64) {
+	me.Time = math.Max(0, math.Min(me.Duration(), t))
+	me.Animator.Apply(me.Start() + me.Time)
+}
+
+//	Returns the time in seconds, within the animations of me.Clip, of the beginning of me.Clip.
+func (me *AnimationClipPlayer) Start() float64 {
+	return me.Clip.Start
+}
+
+//	Resamples the animations of clip, from its Start() to its End() as per AnimationClipPlayer, at fps samples
+//	per second into a new standalone AnimationDef with the specified Id, as per Animator.Bake(). The times of the new
+//	keys are relative to the beginning of clip, so the new AnimationDef starts at 0. Channels whose target cannot be
+//	resolved are dropped. The new AnimationDef is not added to any library.
+func AnimationClipBake(clip *cdom.AnimationClipDef, reg *cdom.Registry, id string, fps float64) (anim *cdom.AnimationDef, err error) {
+	var player *AnimationClipPlayer
+	if player, err = NewAnimationClipPlayer(clip, reg); err == nil {
+		anim, err = player.Animator.Bake(id, player.Start(), player.End(), fps)
+	}
+	return
+}
+
+//	Splits anims into sections: creates one AnimationClipDef per range, with its Id, Name, Start and End taken from
+//	the range, and one AnimationInst per AnimationDef in anims. To ship each clip as a standalone animation, pass it
+//	to AnimationClipBake(). Returns an error if any range is unnamed or does not end after its beginning.
+//	The new AnimationClipDefs are created by reg.AnimationClipDefs (or those of DefaultRegistry if reg is nil),
+//	but not added to it.
+func AnimationSplit(reg *cdom.Registry, ranges []AnimationClipRange, anims ...*cdom.AnimationDef) (clips []*cdom.AnimationClipDef, err error) {
+	if reg == nil {
+		reg = cdom.DefaultRegistry
+	}
+	for _, r := range ranges {
+		if len(r.Name) == 0 {
+			err = fmt.Errorf("animation clip range %v-%v: no name", r.Start, r.End)
+		} else if r.End <= r.Start {
+			err = fmt.Errorf("animation clip '%s': range %v-%v is empty", r.Name, r.Start, r.End)
+		}
+		if err != nil {
+			clips = nil
+			return
+		}
+		clip := reg.AnimationClipDefs.New(r.Name)
+		clip.Name, clip.Start, clip.End = r.Name, r.Start, r.End
+		for _, anim := range anims {
+			clip.Animations = append(clip.Animations, anim.NewInst())
+		}
+		clips = append(clips, clip)
+	}
+	return
+}
@@ -0,0 +1,73 @@
+package cdomutil_test
+
+import (
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+func TestAnimationSplit(t *testing.T) {
+	reg := testImport(t, testAnimDoc).Registry
+	turn := reg.AnimationDefs.M["turn"]
+	clips, err := cdomutil.AnimationSplit(reg, []cdomutil.AnimationClipRange{{"run", 1, 3}, {"jump", 3, 4}}, turn)
+	if (err != nil) || (len(clips) != 2) {
+		t.Fatalf("got %d clips (%v), want 2", len(clips), err)
+	}
+	for i, want := range []cdomutil.AnimationClipRange{{"run", 1, 3}, {"jump", 3, 4}} {
+		clip := clips[i]
+		if (clip.Id != want.Name) || (clip.Name != want.Name) || (clip.Start != want.Start) || (clip.End != want.End) || (len(clip.Animations) != 1) || (clip.Animations[0].EnsureDef(reg) != turn) {
+			t.Errorf("clip %d: got %q from %v to %v, want %v", i, clip.Id, clip.Start, clip.End, want)
+		}
+		if (reg.AnimationClipDefs.M[clip.Id] != nil) || (cdom.AnimationClipDefs.M[clip.Id] != nil) {
+			t.Errorf("clip %d: added to a library", i)
+		}
+	}
+	for _, r := range []cdomutil.AnimationClipRange{{"", 1, 2}, {"empty", 2, 2}, {"backwards", 2, 1}} {
+		if clips, err = cdomutil.AnimationSplit(reg, []cdomutil.AnimationClipRange{r}, turn); (err == nil) || (clips != nil) {
+			t.Errorf("range %v: got %d clips and no error", r, len(clips))
+		}
+	}
+}
+
+func TestAnimationClipPlayer(t *testing.T) {
+	reg := testImport(t, testAnimDoc).Registry
+	rz := reg.VisualSceneDefs.M["vs"].Nodes[0].Transforms[0]
+	clips, _ := cdomutil.AnimationSplit(reg, []cdomutil.AnimationClipRange{{"run", 1, 3}}, reg.AnimationDefs.M["turn"])
+	player, err := cdomutil.NewAnimationClipPlayer(clips[0], reg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if (player.Start() != 1) || (player.End() != 3) || (player.Duration() != 2) {
+		t.Fatalf("got range %v to %v, want 1 to 3", player.Start(), player.End())
+	}
+	check := func(step string, done, wantDone bool, time, angle float64) {
+		if (done != wantDone) || !testNearly(player.Time, time) || !testNearly(rz.F[3], angle) {
+			t.Errorf("%s: got time %v, angle %v, done %v, want %v, %v, %v", step, player.Time, rz.F[3], done, time, angle, wantDone)
+		}
+	}
+	for _, test := range []struct {
+		seek, time, angle float64
+	}{{-1, 0, 10}, {0.5, 0.5, 15}, {5, 2, 30}} {
+		player.Seek(test.seek)
+		check("seek", false, false, test.time, test.angle)
+	}
+	player.Seek(0)
+	for _, test := range []struct {
+		loop            bool
+		dt, time, angle float64
+		done            bool
+	}{
+		{false, 1.5, 1.5, 25, false},
+		{false, 1, 2, 30, true},
+		{false, 1, 2, 30, true},
+		{true, 0.5, 0.5, 15, false},
+		{true, 1.5, 0, 10, false},
+		{true, 2.5, 0.5, 15, false},
+		{true, 4, 0.5, 15, false},
+		{true, -1, 1.5, 25, false},
+	} {
+		player.Loop = test.loop
+		check("advance", player.Advance(test.dt), test.done, test.time, test.angle)
+	}
+}
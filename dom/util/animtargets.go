@@ -0,0 +1,218 @@
+package cdomutil
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	One AnimationChannel and what it animates, as listed by NewAnimationTargetIndex().
+type AnimationTarget struct {
+	//	The AnimationDef declaring Channel.
+	Anim *cdom.AnimationDef
+
+	//	The channel.
+	Channel *cdom.AnimationChannel
+
+	//	The sampler of Anim referred to by Channel.Source, or nil if Anim declares none with that Id.
+	Sampler *cdom.AnimationSampler
+
+	//	The value animated by Channel: its resolved Target.V, or else the values of a Source that its Target
+	//	refers to by Id, as per NewAnimator(). Nil if Err is set.
+	V interface{}
+
+	//	The Sid-addressed object that V belongs to: the value of the Target path without its member or array
+	//	selectors, such as the *cdom.Transform of the Target "some-node/translate.X", the *cdom.FxParamDef of
+	//	an effect parameter, the *cdom.SidFloat of a camera's field of view or of a light's falloff angle, or the
+	//	*cdom.Source of a Source target. Equals V if the Target path has no selectors.
+	Owner interface{}
+
+	//	The object with the Id that the Target path starts with, such as the *cdom.NodeDef of
+	//	"some-node/translate.X". Nil for Source targets.
+	Root cdom.RefSidRoot
+
+	//	Why the Target could not be resolved, or resolved to a value that cannot be animated (such as a string).
+	Err error
+}
+
+//	A table of all AnimationChannels of a Registry and the values they animate, as created by NewAnimationTargetIndex().
+type AnimationTargetIndex struct {
+	//	All channels whose Target resolved to a value that can be animated,
+	//	ordered by the Ids of their libraries, then by the Ids of their (top-level) AnimationDefs.
+	Targets []*AnimationTarget
+
+	//	All channels whose Target did not resolve, or resolved to a value that cannot be animated, in the same order.
+	Unresolved []*AnimationTarget
+
+	//	Groups of two or more Targets that write at least one same value, such as one targeting "node/translate"
+	//	and another targeting "node/translate.X". Within each group, every Target shares a value with another one.
+	Conflicts [][]*AnimationTarget
+
+	byV map[interface{}][]*AnimationTarget
+}
+
+//	Resolves the Targets of all AnimationChannels of all AnimationDefs (and their nested AnimationDefs) in all
+//	libraries of reg.AnimationDefLibs (or those of DefaultRegistry if reg is nil), as NewAnimator() does, and
+//	indexes what they animate.
+func NewAnimationTargetIndex(reg *cdom.Registry) (me *AnimationTargetIndex) {
+	if reg == nil {
+		reg = cdom.DefaultRegistry
+	}
+	me = &AnimationTargetIndex{byV: map[interface{}][]*AnimationTarget{}}
+	var walk func(*cdom.AnimationDef)
+	walk = func(anim *cdom.AnimationDef) {
+		for _, ch := range anim.Channels {
+			target := newAnimationTarget(reg, anim, ch)
+			if target.Err != nil {
+				me.Unresolved = append(me.Unresolved, target)
+			} else {
+				me.Targets = append(me.Targets, target)
+				me.byV[target.V] = append(me.byV[target.V], target)
+			}
+		}
+		for _, sub := range anim.AnimationDefs {
+			walk(sub)
+		}
+	}
+	libIds := make([]string, 0, len(reg.AnimationDefLibs))
+	for id := range reg.AnimationDefLibs {
+		libIds = append(libIds, id)
+	}
+	sort.Strings(libIds)
+	for _, libId := range libIds {
+		lib := reg.AnimationDefLibs[libId]
+		defIds := make([]string, 0, len(lib.M))
+		for id := range lib.M {
+			defIds = append(defIds, id)
+		}
+		sort.Strings(defIds)
+		for _, id := range defIds {
+			walk(lib.M[id])
+		}
+	}
+	me.initConflicts()
+	return
+}
+
+func newAnimationTarget(reg *cdom.Registry, anim *cdom.AnimationDef, ch *cdom.AnimationChannel) (me *AnimationTarget) {
+	me = &AnimationTarget{Anim: anim, Channel: ch}
+	for _, s := range anim.Samplers {
+		if s.Id == ch.Source.S() {
+			me.Sampler = s
+			break
+		}
+	}
+	path, err := ch.Target.Path()
+	if err == nil {
+		if ch.Target.Resolve(reg, false); ch.Target.V != nil {
+			me.V, me.Owner = ch.Target.V, ch.Target.V
+			if len(path.Selectors) > 0 {
+				me.Owner = (&cdom.SidPath{Id: path.Id, Sids: path.Sids}).Resolve(reg)
+			}
+			me.Root = reg.FindSidRoot(path.Id)
+		}
+	}
+	if me.V == nil {
+		if src, target := sourceTarget(reg, ch.Target.S); target != nil {
+			me.V, me.Owner = target, src
+		}
+	}
+	if me.V == nil {
+		if err == nil {
+			err = fmt.Errorf("no value addressed by '%s'", ch.Target.S)
+		}
+		me.Err = err
+	} else if _, ok := animatedAddrs(me.V); !ok {
+		me.Err = fmt.Errorf("value addressed by '%s' is a %T, which cannot be animated", ch.Target.S, me.V)
+	}
+	if me.Err != nil {
+		me.V, me.Owner, me.Root = nil, nil, nil
+	}
+	return
+}
+
+func (me *AnimationTargetIndex) initConflicts() {
+	//	union-find over the Targets, joining any two that write a same value
+	parents, owners := make([]int, len(me.Targets)), map[uintptr]int{}
+	var find func(int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	for i, target := range me.Targets {
+		parents[i] = i
+		addrs, _ := animatedAddrs(target.V)
+		for _, addr := range addrs {
+			if j, ok := owners[addr]; !ok {
+				owners[addr] = i
+			} else if ri, rj := find(i), find(j); ri != rj {
+				parents[ri] = rj
+			}
+		}
+	}
+	groups := map[int]int{}
+	for i, target := range me.Targets {
+		root := find(i)
+		if g, ok := groups[root]; ok {
+			me.Conflicts[g] = append(me.Conflicts[g], target)
+		} else {
+			groups[root] = len(me.Conflicts)
+			me.Conflicts = append(me.Conflicts, []*AnimationTarget{target})
+		}
+	}
+	conflicts := me.Conflicts[:0]
+	for _, group := range me.Conflicts {
+		if len(group) > 1 {
+			conflicts = append(conflicts, group)
+		}
+	}
+	me.Conflicts = conflicts
+}
+
+//	Returns all Targets whose V is v, such as all channels animating one *cdom.Transform as a whole.
+func (me *AnimationTargetIndex) Lookup(v interface{}) []*AnimationTarget {
+	return me.byV[v]
+}
+
+//	Returns the addresses of all scalars that setAnimated() writes to for v, and whether v can be animated at all.
+func animatedAddrs(v interface{}) (addrs []uintptr, ok bool) {
+	ptr := func(p interface{}) uintptr {
+		return reflect.ValueOf(p).Pointer()
+	}
+	ok = true
+	switch tv := v.(type) {
+	case *float64, *float32:
+		addrs = append(addrs, ptr(tv))
+	case *cdom.FxColor:
+		addrs = append(addrs, ptr(&tv.R), ptr(&tv.G), ptr(&tv.B), ptr(&tv.A))
+	case *cdom.SidFloat:
+		addrs = append(addrs, ptr(&tv.F))
+	case *cdom.SidFloat3:
+		addrs = append(addrs, ptr(&tv.F[0]), ptr(&tv.F[1]), ptr(&tv.F[2]))
+	case *cdom.SidVec3:
+		addrs = append(addrs, ptr(&tv.X), ptr(&tv.Y), ptr(&tv.Z))
+	case *cdom.Transform:
+		for i := range tv.F {
+			addrs = append(addrs, ptr(&tv.F[i]))
+		}
+	default:
+		//	pointers to arrays or slices of floats, as per setAnimated()
+		rv := reflect.ValueOf(v)
+		if ok = (rv.Kind() == reflect.Ptr) && !rv.IsNil(); ok {
+			if rv = rv.Elem(); (rv.Kind() != reflect.Array) && (rv.Kind() != reflect.Slice) {
+				ok = false
+			} else if k := rv.Type().Elem().Kind(); (k != reflect.Float64) && (k != reflect.Float32) {
+				ok = false
+			} else {
+				for i := 0; i < rv.Len(); i++ {
+					addrs = append(addrs, rv.Index(i).Addr().Pointer())
+				}
+			}
+		}
+	}
+	return
+}
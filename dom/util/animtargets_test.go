@@ -0,0 +1,58 @@
+package cdomutil_test
+
+import (
+	"testing"
+
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+func TestAnimationTargetIndexConflicts(t *testing.T) {
+	targets := []string{"a/t", "a/t.X", "a/r.ANGLE", "b/t.Y", "b/t.Z", "b/t(1)", "nosuch/t.X"}
+	src := testDocHead + `<library_animations><animation id="anim">
+<source id="in"><float_array id="in-a" count="1">0</float_array><technique_common><accessor source="#in-a" count="1"><param name="TIME" type="float"/></accessor></technique_common></source>
+<sampler id="s"><input semantic="INPUT" source="#in"/><input semantic="OUTPUT" source="#in"/></sampler>
+`
+	for _, target := range targets {
+		src += `<channel source="#s" target="` + target + `"/>
+`
+	}
+	src += `</animation></library_animations>
+<library_nodes>
+<node id="a"><translate sid="t">0 0 0</translate><rotate sid="r">0 0 1 0</rotate></node>
+<node id="b"><translate sid="t">0 0 0</translate></node>
+</library_nodes>
+</COLLADA>`
+	reg := testImport(t, src).Registry
+	idx := cdomutil.NewAnimationTargetIndex(reg)
+	if (len(idx.Targets) != 6) || (len(idx.Unresolved) != 1) || (idx.Unresolved[0].Channel.Target.S != "nosuch/t.X") {
+		t.Fatalf("got %d targets and %d unresolved, want 6 and only 'nosuch/t.X'", len(idx.Targets), len(idx.Unresolved))
+	}
+	for i, target := range idx.Targets {
+		if (target.Channel.Target.S != targets[i]) || (target.Sampler == nil) || (target.Sampler.Id != "s") {
+			t.Errorf("target %d: got %q with sampler %v, want %q with sampler 's'", i, target.Channel.Target.S, target.Sampler, targets[i])
+		}
+	}
+	xf, node := reg.NodeDefs.M["a"].Transforms[0], reg.NodeDefs.M["a"]
+	if whole, x := idx.Targets[0], idx.Targets[1]; (whole.V != xf) || (whole.Owner != xf) || (x.V != &xf.F[0]) || (x.Owner != xf) || (x.Root != node) {
+		t.Errorf("got V %v and owner %v for 'a/t.X', want the X of the translate of node 'a' and the translate itself", x.V, x.Owner)
+	}
+	if got := idx.Lookup(xf); (len(got) != 1) || (got[0] != idx.Targets[0]) {
+		t.Errorf("got %v for the translate of 'a', want only the channel targeting it as a whole", got)
+	}
+	//	"b/t.Y" and "b/t.Z" write different values, but "b/t(1)" writes the same one as "b/t.Y"
+	want := [][]string{{"a/t", "a/t.X"}, {"b/t.Y", "b/t(1)"}}
+	if len(idx.Conflicts) != len(want) {
+		t.Fatalf("got %d conflicts, want %d", len(idx.Conflicts), len(want))
+	}
+	for g, group := range idx.Conflicts {
+		if len(group) != len(want[g]) {
+			t.Errorf("conflict %d: got %d targets, want %v", g, len(group), want[g])
+			continue
+		}
+		for i, target := range group {
+			if target.Channel.Target.S != want[g][i] {
+				t.Errorf("conflict %d: got %q at %d, want %q", g, target.Channel.Target.S, i, want[g][i])
+			}
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package cdomutil
+
+import (
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	An axis-aligned bounding box. Use NewBoundingBox() to create an empty one.
+type BoundingBox struct {
+	//	The minimum and maximum coordinates of all points contained.
+	Min, Max cdom.Float3
+}
+
+//	Returns an empty BoundingBox, with Min at positive and Max at negative infinity.
+func NewBoundingBox() (me BoundingBox) {
+	for i := 0; i < 3; i++ {
+		me.Min[i], me.Max[i] = math.Inf(1), math.Inf(-1)
+	}
+	return
+}
+
+//	Extends me to contain p.
+func (me *BoundingBox) Add(p cdom.Float3) {
+	for i := 0; i < 3; i++ {
+		me.Min[i], me.Max[i] = math.Min(me.Min[i], p[i]), math.Max(me.Max[i], p[i])
+	}
+}
+
+//	Extends me to contain box.
+func (me *BoundingBox) AddBox(box *BoundingBox) {
+	if !box.Empty() {
+		me.Add(box.Min)
+		me.Add(box.Max)
+	}
+}
+
+//	Returns the center of me.
+func (me *BoundingBox) Center() cdom.Float3 {
+	return vscale(vadd(me.Min, me.Max), 0.5)
+}
+
+//	Returns the 8 corners of me.
+func (me *BoundingBox) Corners() (corners [8]cdom.Float3) {
+	for i := range corners {
+		for c := 0; c < 3; c++ {
+			if corners[i][c] = me.Min[c]; (i & (1 << uint(c))) != 0 {
+				corners[i][c] = me.Max[c]
+			}
+		}
+	}
+	return
+}
+
+//	Returns whether me contains nothing at all.
+func (me *BoundingBox) Empty() bool {
+	return (me.Min[0] > me.Max[0]) || (me.Min[1] > me.Max[1]) || (me.Min[2] > me.Max[2])
+}
+
+//	Returns the BoundingBox of the 8 corners of me transformed by m.
+func (me *BoundingBox) Transform(m *cdom.Float4x4) (box BoundingBox) {
+	if box = NewBoundingBox(); !me.Empty() {
+		for _, c := range me.Corners() {
+			box.Add(mulPoint(m, c, 1))
+		}
+	}
+	return
+}
+
+//	A bounding sphere. A negative Radius denotes an empty BoundingSphere, as created by NewBoundingSphere().
+type BoundingSphere struct {
+	//	The center of the sphere.
+	Center cdom.Float3
+
+	//	The radius of the sphere.
+	Radius float64
+}
+
+//	Returns an empty BoundingSphere.
+func NewBoundingSphere() BoundingSphere {
+	return BoundingSphere{Radius: -1}
+}
+
+//	Grows me (as little as possible, keeping it a sphere) to contain sphere. If me is empty, it becomes sphere.
+func (me *BoundingSphere) Add(sphere BoundingSphere) {
+	if me.Empty() {
+		*me = sphere
+	} else if !sphere.Empty() {
+		dir := vsub(sphere.Center, me.Center)
+		if d := vlen(dir); (d + sphere.Radius) > me.Radius {
+			if (d + me.Radius) <= sphere.Radius {
+				*me = sphere
+			} else {
+				r := (d + me.Radius + sphere.Radius) / 2
+				me.Center, me.Radius = vadd(me.Center, vscale(dir, (r-me.Radius)/d)), r
+			}
+		}
+	}
+}
+
+//	Returns whether me contains nothing at all.
+func (me *BoundingSphere) Empty() bool {
+	return me.Radius < 0
+}
+
+//	A BoundingBox and a BoundingSphere of the same contents, as returned by GeometryBounds() and friends.
+//	The BoundingSphere is not necessarily the smallest possible, but usually within a few percent of it.
+type Bounds struct {
+	//	The axis-aligned bounding box.
+	Box BoundingBox
+
+	//	The bounding sphere.
+	Sphere BoundingSphere
+}
+
+//	Extends me to contain bounds.
+func (me *Bounds) Add(bounds *Bounds) {
+	me.Box.AddBox(&bounds.Box)
+	me.Sphere.Add(bounds.Sphere)
+}
+
+//	Returns the Bounds of all values of the "POSITION" Sources of mesh (those referred to by the Inputs of its
+//	Vertices, or else of its Primitives), in its local coordinate space. Empty if mesh has no readable positions.
+func GeometryMeshBounds(mesh *cdom.GeometryMesh) Bounds {
+	return meshShape(mesh).bounds()
+}
+
+//	Returns the Bounds of geo, resolving GeometryMesh.ConvexHullOf against reg, in its local coordinate space:
+//	for a Mesh as per GeometryMeshBounds(), for a Spline those of the "POSITION" Source of its ControlVertices,
+//	and for a Brep those of the "POSITION" Source of its Vertices, plus all of its sphere and torus Surfaces
+//	(other kinds of surfaces are unbounded or bounded by the Vertices).
+func GeometryBounds(geo *cdom.GeometryDef, reg *cdom.Registry) Bounds {
+	return geometryShape(geo, reg, nil).bounds()
+}
+
+//	Returns the Bounds of the output of def, resolving its Sources against reg. For a Skin, these are the bounds of its
+//	bind pose, that is, of its Source (a GeometryDef or a morph controller) transformed by its BindShapeMatrix, in the
+//	coordinate space of the scene containing its joints (see Skinner). For a Morph, these contain its base mesh and
+//	all of its target meshes, in their local coordinate space (for a Relative morph, the base mesh extended by the
+//	Bounds of all targets, assuming weights between 0 and 1).
+func ControllerBounds(def *cdom.ControllerDef, reg *cdom.Registry) Bounds {
+	return controllerShape(def, reg, nil).bounds()
+}
+
+//	Returns the Bounds of shape in the coordinate space of its rigid body, applying shape.Transforms and resolving
+//	a Geometry.Inst against reg. Capsules with differing Radii and all cylinders are bounded by their boxes, and
+//	planes (being infinite) are not bounded at all.
+func PxShapeBounds(shape *cdom.PxShape, reg *cdom.Registry) Bounds {
+	return pxShapeShape(shape, reg).bounds()
+}
+
+//	Returns the Bounds, in world space (as per their current World matrices), of the contents of root and all of its
+//	descendants among nodes (as returned by VisualSceneNodes()), or of all nodes if root is nil: of all the geometries
+//	and controllers instantiated by their Node.Insts, as per GeometryBounds() and ControllerBounds(). Skins are
+//	bounded by their bind pose, which is already in world space, so it is not transformed by the World of their node.
+//	Definitions that cannot be resolved via reg are skipped.
+func SceneNodeBounds(root *SceneNode, nodes []*SceneNode, reg *cdom.Registry) Bounds {
+	var all boundsShape
+	shapes := map[interface{}]boundsShape{}
+	for _, sn := range nodes {
+		if root != nil {
+			in := false
+			for p := sn; (p != nil) && !in; p = p.Parent {
+				in = p == root
+			}
+			if !in {
+				continue
+			}
+		}
+		for _, inst := range sn.Node.Insts.Geometry {
+			if def := inst.EnsureDef(reg); def != nil {
+				if _, ok := shapes[def]; !ok {
+					shapes[def] = geometryShape(def, reg, nil)
+				}
+				all = append(all, shapes[def].transform(&sn.World)...)
+			}
+		}
+		for _, inst := range sn.Node.Insts.Controller {
+			if def := inst.EnsureDef(reg); def != nil {
+				if _, ok := shapes[def]; !ok {
+					shapes[def] = controllerShape(def, reg, nil)
+				}
+				if def.Skin != nil {
+					all = append(all, shapes[def]...)
+				} else {
+					all = append(all, shapes[def].transform(&sn.World)...)
+				}
+			}
+		}
+	}
+	return all.bounds()
+}
+
+//	Returns the Bounds of all nodes of vs in world space, as per SceneNodeBounds() and VisualSceneNodes().
+func VisualSceneBounds(vs *cdom.VisualSceneDef, reg *cdom.Registry) Bounds {
+	nodes, _ := VisualSceneNodes(vs, reg)
+	return SceneNodeBounds(nil, nodes, reg)
+}
+
+//	The contents to be bounded, as spheres (points being spheres with a Radius of 0).
+type boundsShape []BoundingSphere
+
+func (me *boundsShape) addBox(box BoundingBox, m *cdom.Float4x4) {
+	for _, c := range box.Corners() {
+		*me = append(*me, BoundingSphere{Center: mulPoint(m, c, 1)})
+	}
+}
+
+func (me *boundsShape) addPoints(src *cdom.Source) {
+	if src != nil {
+		if points, err := src.Float3s(); err == nil {
+			for _, p := range points {
+				*me = append(*me, BoundingSphere{Center: p})
+			}
+		}
+	}
+}
+
+func (me boundsShape) bounds() (b Bounds) {
+	b.Box, b.Sphere = NewBoundingBox(), NewBoundingSphere()
+	if len(me) == 0 {
+		return
+	}
+	for _, s := range me {
+		b.Box.Add(vsub(s.Center, [3]float64{s.Radius, s.Radius, s.Radius}))
+		b.Box.Add(vadd(s.Center, [3]float64{s.Radius, s.Radius, s.Radius}))
+	}
+	//	as per Jack Ritter's "An Efficient Bounding Sphere": start from two far-apart elements, then grow to fit
+	farthest := func(from cdom.Float3) (far BoundingSphere) {
+		max := math.Inf(-1)
+		for _, s := range me {
+			if d := vlen(vsub(s.Center, from)) + s.Radius; d > max {
+				far, max = s, d
+			}
+		}
+		return
+	}
+	a := farthest(me[0].Center)
+	b.Sphere = a
+	b.Sphere.Add(farthest(a.Center))
+	alt := BoundingSphere{Center: b.Box.Center()}
+	for _, s := range me {
+		b.Sphere.Add(s)
+		alt.Radius = math.Max(alt.Radius, vlen(vsub(s.Center, alt.Center))+s.Radius)
+	}
+	if alt.Radius < b.Sphere.Radius {
+		b.Sphere = alt
+	}
+	return
+}
+
+func (me boundsShape) transform(m *cdom.Float4x4) (shape boundsShape) {
+	var scale float64
+	for c := 0; c < 3; c++ {
+		scale = math.Max(scale, vlen([3]float64{m[c], m[4+c], m[8+c]}))
+	}
+	shape = make(boundsShape, len(me))
+	for i, s := range me {
+		shape[i] = BoundingSphere{Center: mulPoint(m, s.Center, 1), Radius: s.Radius * scale}
+	}
+	return
+}
+
+//	Returns the shape of the Source of def: a GeometryDef or (for a skin) a morph controller.
+//	The visited map guards against controllers or convex hulls referring to each other.
+func controllerShape(def *cdom.ControllerDef, reg *cdom.Registry, visited map[interface{}]bool) (shape boundsShape) {
+	if visited == nil {
+		visited = map[interface{}]bool{}
+	}
+	if visited[def] {
+		return
+	}
+	visited[def] = true
+	source := func(ref cdom.RefId) boundsShape {
+		if geo := ref.GeometryDef(reg); geo != nil {
+			return geometryShape(geo, reg, visited)
+		} else if ctl := ref.ControllerDef(reg); ctl != nil {
+			return controllerShape(ctl, reg, visited)
+		}
+		return nil
+	}
+	if skin := def.Skin; skin != nil {
+		bindShape := cdom.Float4x4(skin.BindShapeMatrix)
+		shape = source(skin.Source).transform(&bindShape)
+	} else if morph := def.Morph; morph != nil {
+		shape = source(morph.Source)
+		var targets []boundsShape
+		for _, in := range morph.Targets.Inputs {
+			if src := morph.Sources[in.Source.S()]; (src != nil) && (in.Semantic == "MORPH_TARGET") {
+				if ids, err := src.Strings(); err == nil {
+					for _, id := range ids {
+						targets = append(targets, source(cdom.RefId(id)))
+					}
+				}
+			}
+		}
+		if !morph.Relative {
+			for _, target := range targets {
+				shape = append(shape, target...)
+			}
+		} else if len(shape) > 0 {
+			box := shape.bounds().Box
+			for _, target := range targets {
+				if tb := target.bounds().Box; !tb.Empty() {
+					for i := 0; i < 3; i++ {
+						box.Min[i], box.Max[i] = box.Min[i]+math.Min(0, tb.Min[i]), box.Max[i]+math.Max(0, tb.Max[i])
+					}
+				}
+			}
+			identity := Float4x4Identity()
+			shape.addBox(box, &identity)
+		}
+	}
+	return
+}
+
+func geometryShape(geo *cdom.GeometryDef, reg *cdom.Registry, visited map[interface{}]bool) (shape boundsShape) {
+	if visited == nil {
+		visited = map[interface{}]bool{}
+	}
+	if visited[geo] {
+		return
+	}
+	visited[geo] = true
+	if mesh := geo.Mesh; mesh != nil {
+		if (len(mesh.ConvexHullOf) > 0) && (mesh.Vertices == nil) {
+			if hull := mesh.ConvexHullOf.GeometryDef(reg); hull != nil {
+				shape = geometryShape(hull, reg, visited)
+			}
+		} else {
+			shape = meshShape(mesh)
+		}
+	} else if spline := geo.Spline; spline != nil {
+		for _, in := range spline.ControlVertices.Inputs {
+			if in.Semantic == "POSITION" {
+				shape.addPoints(spline.Sources[in.Source.S()])
+			}
+		}
+	} else if brep := geo.Brep; brep != nil {
+		for _, in := range brep.Vertices.Inputs {
+			if in.Semantic == "POSITION" {
+				shape.addPoints(brep.Sources[in.Source.S()])
+			}
+		}
+		if brep.Surfaces != nil {
+			for _, surface := range brep.Surfaces.All {
+				m := positioningMatrix(&surface.Location)
+				if sphere := surface.Element.Sphere; sphere != nil {
+					shape = append(shape, boundsShape{{Radius: sphere.Radius}}.transform(&m)...)
+				} else if torus := surface.Element.Torus; torus != nil {
+					r := torus.Radii[0] + torus.Radii[1]
+					shape.addBox(BoundingBox{Min: cdom.Float3{-r, -r, -torus.Radii[1]}, Max: cdom.Float3{r, r, torus.Radii[1]}}, &m)
+				}
+			}
+		}
+	}
+	return
+}
+
+func meshShape(mesh *cdom.GeometryMesh) (shape boundsShape) {
+	var fromVertices bool
+	done := map[*cdom.Source]bool{}
+	if mesh.Vertices != nil {
+		for _, in := range mesh.Vertices.Inputs {
+			if src := mesh.Sources[in.Source.S()]; (in.Semantic == "POSITION") && (src != nil) && !done[src] {
+				done[src], fromVertices = true, true
+				shape.addPoints(src)
+			}
+		}
+	}
+	if !fromVertices {
+		for _, in := range meshInputs(mesh) {
+			if (in.semantic == "POSITION") && !done[in.src] {
+				done[in.src] = true
+				shape.addPoints(in.src)
+			}
+		}
+	}
+	return
+}
+
+//	Returns the matrix of loc: its Orientations (in order) followed by the translation to its Origin.
+func positioningMatrix(loc *cdom.GeometryPositioning) (m cdom.Float4x4) {
+	m = Float4x4Identity()
+	if loc.Origin != nil {
+		m[3], m[7], m[11] = loc.Origin.X, loc.Origin.Y, loc.Origin.Z
+	}
+	for _, o := range loc.Orientations {
+		rm := TransformMatrix(&cdom.Transform{Kind: cdom.TransformKindRotate, F: []float64{o.Axis.X, o.Axis.Y, o.Axis.Z, o.Angle}})
+		m = Float4x4Mul(&m, &rm)
+	}
+	return
+}
+
+func pxShapeShape(shape *cdom.PxShape, reg *cdom.Registry) (res boundsShape) {
+	m, g := TransformsMatrix(shape.Transforms), &shape.Geometry
+	if box := g.Box; box != nil {
+		h := cdom.Float3{box.HalfExtents.X, box.HalfExtents.Y, box.HalfExtents.Z}
+		res.addBox(BoundingBox{Min: vscale(h, -1), Max: h}, &m)
+	} else if sphere := g.Sphere; sphere != nil {
+		res = boundsShape{{Radius: sphere.Radius}}.transform(&m)
+	} else if cyl := g.Cylinder; cyl != nil {
+		h := cdom.Float3{cyl.Radii[0], cyl.Height / 2, cyl.Radii[1]}
+		res.addBox(BoundingBox{Min: vscale(h, -1), Max: h}, &m)
+	} else if capsule := g.Capsule; capsule != nil {
+		if r := capsule.Radii; (r.X == r.Y) && (r.Y == r.Z) {
+			res = boundsShape{{Center: cdom.Float3{0, -capsule.Height / 2, 0}, Radius: r.X}, {Center: cdom.Float3{0, capsule.Height / 2, 0}, Radius: r.X}}.transform(&m)
+		} else {
+			h := cdom.Float3{r.X, capsule.Height/2 + r.Y, r.Z}
+			res.addBox(BoundingBox{Min: vscale(h, -1), Max: h}, &m)
+		}
+	} else if g.Inst != nil {
+		if def := g.Inst.EnsureDef(reg); def != nil {
+			res = geometryShape(def, reg, nil).transform(&m)
+		}
+	}
+	return
+}
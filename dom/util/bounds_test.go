@@ -0,0 +1,63 @@
+package cdomutil_test
+
+import (
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	Instantiates the unit octahedron "g" twice: scaled by 2 around (10,0,0), and unscaled around (-10,0,0).
+const testBoundsDoc = testDocHead + `<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="18">1 0 0 -1 0 0 0 1 0 0 -1 0 0 0 1 0 0 -1</float_array><technique_common><accessor source="#pos-a" count="6" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+<library_visual_scenes><visual_scene id="vs">
+<node id="a"><translate>10 0 0</translate><scale>2 2 2</scale><instance_geometry url="#g"/></node>
+<node id="b"><translate>-10 0 0</translate><instance_geometry url="#g"/></node>
+</visual_scene></library_visual_scenes>
+</COLLADA>`
+
+func TestBoundingSphereAdd(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		to, added cdomutil.BoundingSphere
+		want      cdomutil.BoundingSphere
+	}{
+		{"empty", cdomutil.NewBoundingSphere(), cdomutil.BoundingSphere{Center: cdom.Float3{1, 2, 3}, Radius: 1}, cdomutil.BoundingSphere{Center: cdom.Float3{1, 2, 3}, Radius: 1}},
+		{"contained", cdomutil.BoundingSphere{Radius: 5}, cdomutil.BoundingSphere{Center: cdom.Float3{1, 0, 0}, Radius: 1}, cdomutil.BoundingSphere{Radius: 5}},
+		{"containing", cdomutil.BoundingSphere{Center: cdom.Float3{1, 0, 0}, Radius: 1}, cdomutil.BoundingSphere{Radius: 5}, cdomutil.BoundingSphere{Radius: 5}},
+		{"apart", cdomutil.BoundingSphere{Radius: 1}, cdomutil.BoundingSphere{Center: cdom.Float3{3, 0, 0}, Radius: 1}, cdomutil.BoundingSphere{Center: cdom.Float3{1.5, 0, 0}, Radius: 2.5}},
+	} {
+		got := test.to
+		got.Add(test.added)
+		if !testNearly(got.Radius, test.want.Radius) || !testNearly(got.Center[0], test.want.Center[0]) || !testNearly(got.Center[1], test.want.Center[1]) || !testNearly(got.Center[2], test.want.Center[2]) {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestVisualSceneBounds(t *testing.T) {
+	reg := testImport(t, testBoundsDoc).Registry
+	if b := cdomutil.GeometryMeshBounds(reg.GeometryDefs.M["g"].Mesh); (b.Box != cdomutil.BoundingBox{Min: cdom.Float3{-1, -1, -1}, Max: cdom.Float3{1, 1, 1}}) || (b.Sphere != cdomutil.BoundingSphere{Radius: 1}) {
+		t.Errorf("got mesh bounds %+v, want the unit cube and sphere", b)
+	}
+	nodes, _ := cdomutil.VisualSceneNodes(reg.VisualSceneDefs.M["vs"], reg)
+	if b := cdomutil.SceneNodeBounds(nodes[0], nodes, reg); (b.Box != cdomutil.BoundingBox{Min: cdom.Float3{8, -2, -2}, Max: cdom.Float3{12, 2, 2}}) || !testNearly(b.Sphere.Radius, 2) || !testNearly(b.Sphere.Center[0], 10) {
+		t.Errorf("got node 'a' bounds %+v, want a box and sphere of radius 2 around (10,0,0)", b)
+	}
+	b := cdomutil.VisualSceneBounds(reg.VisualSceneDefs.M["vs"], reg)
+	if (b.Box != cdomutil.BoundingBox{Min: cdom.Float3{-11, -2, -2}, Max: cdom.Float3{12, 2, 2}}) {
+		t.Errorf("got scene box %+v, want (-11,-2,-2)..(12,2,2)", b.Box)
+	}
+	//	the smallest sphere has a radius of 11.5; the approximation must contain both extremes and be close to it
+	for _, p := range []cdom.Float3{{-11, 0, 0}, {12, 0, 0}, {10, 2, 0}, {-10, 0, -1}} {
+		d := cdom.Float3{p[0] - b.Sphere.Center[0], p[1] - b.Sphere.Center[1], p[2] - b.Sphere.Center[2]}
+		if ((d[0] * d[0]) + (d[1] * d[1]) + (d[2] * d[2])) > ((b.Sphere.Radius * b.Sphere.Radius) + 1e-9) {
+			t.Errorf("got scene sphere %+v, which does not contain %v", b.Sphere, p)
+		}
+	}
+	if (b.Sphere.Radius < (11.5 - 1e-9)) || (b.Sphere.Radius > 11.5*1.05) {
+		t.Errorf("got scene sphere radius %v, want it within 5%% of 11.5", b.Sphere.Radius)
+	}
+}
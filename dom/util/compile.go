@@ -0,0 +1,281 @@
+package cdomutil
+
+import (
+	"fmt"
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Describes one attribute of the vertices compiled by GeometryCompile().
+type GeometryVertexAttrib struct {
+	//	The Semantic of the InputShared (or of the Input in GeometryMesh.Vertices, if the InputShared's Semantic
+	//	is "VERTEX") providing the values of this attribute, such as "POSITION", "NORMAL", "TEXCOORD" or "COLOR".
+	Semantic string
+
+	//	The Set of the InputShared providing the values of this attribute.
+	//	An InputShared without a Set, and every Input in GeometryMesh.Vertices, counts as Set 0.
+	Set uint64
+
+	//	The number of values per vertex of this attribute. Surplus values of the Source are ignored, and missing
+	//	values are 0. If 0, GeometryCompile() uses the number of bound Params of the first matching Source.
+	Size int
+}
+
+//	Describes one attribute of the vertices in GeometryBuffers.Vertices.
+type GeometryBufferAttrib struct {
+	//	Semantic, Set and Size. Size is never 0 unless Found is false.
+	GeometryVertexAttrib
+
+	//	The index of the first value of this attribute within each vertex.
+	Offset int
+
+	//	Whether any of the primitives provides this attribute. For primitives that do not provide
+	//	it, all values of this attribute are 0.
+	Found bool
+}
+
+//	A range of GeometryBuffers.Indices, covering all primitives of a GeometryMesh that share the same Material and Kind.
+type GeometryBufferGroup struct {
+	//	The Material symbol of the primitives in this group.
+	Material string
+
+	//	Either cdom.GeometryPrimitiveKindTriangles (3 indices per triangle) or cdom.GeometryPrimitiveKindLines (2 indices per line).
+	Kind cdom.GeometryPrimitiveKind
+
+	//	The range of this group in GeometryBuffers.Indices.
+	IndexOffset, IndexCount int
+
+	//	The number of distinct vertices referenced by this group.
+	VertexCount int
+}
+
+//	A single interleaved vertex buffer with a single index buffer, as created by GeometryCompile().
+type GeometryBuffers struct {
+	//	The layout of each vertex, in the order requested.
+	Attribs []*GeometryBufferAttrib
+
+	//	The number of values per vertex: the sum of the Size of all Attribs.
+	VertexSize int
+
+	//	All vertices, VertexSize values each. No two vertices are equal.
+	Vertices []float32
+
+	//	Indices into Vertices, in units of whole vertices.
+	Indices []uint32
+
+	//	The ranges of Indices, in the order in which their Material and Kind first occur in GeometryMesh.Primitives.
+	Groups []*GeometryBufferGroup
+}
+
+//	Returns the number of vertices in me.Vertices.
+func (me *GeometryBuffers) VertexCount() int {
+	if me.VertexSize == 0 {
+		return 0
+	}
+	return len(me.Vertices) / me.VertexSize
+}
+
+var (
+	layoutPositions = []GeometryVertexAttrib{{Semantic: "POSITION"}}
+)
+
+type compileInput struct {
+	src     *cdom.Source
+	offset  uint64
+	indices []uint64
+}
+
+//	Reads the values of me.src for corner into vals, returning false if me.src is nil or corner's index is out of range.
+func (me *compileInput) read(corner []uint64, vals []float64) bool {
+	return (me.src != nil) && (me.src.FloatsAt(corner[me.offset], me.indices, vals) > 0)
+}
+
+//	Compiles mesh into an interleaved vertex buffer and a single index buffer, as consumed by GPUs.
+//	Each vertex consists of the values of all attributes in layout, in that order, read from the Sources
+//	of the corresponding inputs via their SourceAccessor (skipping unbound Params). Corners that end up
+//	with identical values share the same vertex, regardless of which indices they were assembled from.
+//	Polygons, polylists, trifans and tristrips are triangulated (without modifying mesh) as per
+//	GeometryTriangulate(), linestrips are split into lines. The results are deterministic: vertices
+//	are numbered in the order they first occur in mesh.Primitives.
+func GeometryCompile(mesh *cdom.GeometryMesh, layout ...GeometryVertexAttrib) (bufs *GeometryBuffers, err error) {
+	var key []byte
+	bufs = &GeometryBuffers{}
+	tmp := &cdom.GeometryMesh{HasSources: mesh.HasSources, Vertices: mesh.Vertices}
+	for _, prim := range mesh.Primitives {
+		cp := *prim
+		tmp.Primitives = append(tmp.Primitives, &cp)
+	}
+	GeometryTriangulate(tmp)
+	all := make([][]compileInput, len(tmp.Primitives))
+	for i, prim := range tmp.Primitives {
+		all[i] = compileInputs(mesh, prim, layout)
+	}
+	for i, attr := range layout {
+		ba := &GeometryBufferAttrib{GeometryVertexAttrib: attr, Offset: bufs.VertexSize}
+		for _, inputs := range all {
+			if inputs[i].src != nil {
+				if ba.Found = true; ba.Size == 0 {
+					ba.Size = len(inputs[i].indices)
+				}
+				break
+			}
+		}
+		bufs.VertexSize += ba.Size
+		bufs.Attribs = append(bufs.Attribs, ba)
+	}
+	vertex, vals, verts := make([]float32, bufs.VertexSize), make([]float64, bufs.VertexSize), map[string]uint32{}
+	groups, groupIndices, groupVerts := map[string]int{}, [][]uint32{}, []map[uint32]bool{}
+	for p, prim := range tmp.Primitives {
+		kind, corners := cdom.GeometryPrimitiveKindTriangles, prim.Indices
+		switch prim.Kind {
+		case cdom.GeometryPrimitiveKindLines:
+			kind = prim.Kind
+		case cdom.GeometryPrimitiveKindLineStrips:
+			kind, corners = cdom.GeometryPrimitiveKindLines, lineStripsToLines(prim)
+		case cdom.GeometryPrimitiveKindTriangles:
+		default:
+			continue
+		}
+		stride, inputs, numPerPrim := prim.Stride(), all[p], 3
+		if stride == 0 {
+			continue
+		} else if kind == cdom.GeometryPrimitiveKindLines {
+			numPerPrim = 2
+		}
+		gkey := fmt.Sprintf("%d:%s", kind, prim.Material)
+		g, ok := groups[gkey]
+		if !ok {
+			g = len(bufs.Groups)
+			groups[gkey] = g
+			bufs.Groups = append(bufs.Groups, &GeometryBufferGroup{Material: prim.Material, Kind: kind})
+			groupIndices, groupVerts = append(groupIndices, nil), append(groupVerts, map[uint32]bool{})
+		}
+		//	drop trailing indices that do not form a whole triangle or line
+		n := (uint64(len(corners)) / (stride * uint64(numPerPrim))) * uint64(numPerPrim)
+		for c := uint64(0); c < n; c++ {
+			corner := corners[c*stride : (c+1)*stride]
+			for i, ba := range bufs.Attribs {
+				if err = readAttrib(vertex[ba.Offset:ba.Offset+ba.Size], vals[ba.Offset:ba.Offset+ba.Size], inputs[i], corner); err != nil {
+					bufs = nil
+					return
+				}
+			}
+			key = key[:0]
+			for _, f := range vertex {
+				bits := math.Float32bits(f)
+				key = append(key, byte(bits), byte(bits>>8), byte(bits>>16), byte(bits>>24))
+			}
+			index, ok := verts[string(key)]
+			if !ok {
+				index = uint32(len(verts))
+				verts[string(key)] = index
+				bufs.Vertices = append(bufs.Vertices, vertex...)
+			}
+			if !groupVerts[g][index] {
+				groupVerts[g][index] = true
+				bufs.Groups[g].VertexCount++
+			}
+			groupIndices[g] = append(groupIndices[g], index)
+		}
+	}
+	for g, group := range bufs.Groups {
+		group.IndexOffset, group.IndexCount = len(bufs.Indices), len(groupIndices[g])
+		bufs.Indices = append(bufs.Indices, groupIndices[g]...)
+	}
+	return
+}
+
+//	Returns, for every attribute in layout, the Source providing its values in prim, if any, and the offset of its indices.
+func compileInputs(mesh *cdom.GeometryMesh, prim *cdom.GeometryPrimitives, layout []GeometryVertexAttrib) (inputs []compileInput) {
+	inputs = make([]compileInput, len(layout))
+	for i, attr := range layout {
+		for _, in := range prim.Inputs {
+			set := uint64(0)
+			if in.Set != nil {
+				set = *in.Set
+			}
+			if (in.Semantic == "VERTEX") && (mesh.Vertices != nil) && (attr.Set == 0) {
+				for _, vin := range mesh.Vertices.Inputs {
+					if vin.Semantic == attr.Semantic {
+						inputs[i] = compileInput{src: mesh.Sources[vin.Source.S()], offset: in.Offset}
+						break
+					}
+				}
+			} else if (in.Semantic == attr.Semantic) && (set == attr.Set) {
+				inputs[i] = compileInput{src: mesh.Sources[in.Source.S()], offset: in.Offset}
+			}
+			if inputs[i].src != nil {
+				inputs[i].indices, _ = inputs[i].src.ParamIndices()
+				break
+			}
+		}
+	}
+	return
+}
+
+//	Splits ind into corners of stride indices each.
+func splitCorners(ind []uint64, stride uint64) (corners [][]uint64) {
+	corners = make([][]uint64, 0, uint64(len(ind))/stride)
+	for i := uint64(0); (i + stride) <= uint64(len(ind)); i += stride {
+		corners = append(corners, ind[i:i+stride])
+	}
+	return
+}
+
+//	Calls each for every group of corners delimited by vcount (or for all corners if vcount is empty) that has at least 3 corners.
+func eachVcount(corners [][]uint64, vcount []int64, each func([][]uint64)) {
+	if len(vcount) == 0 {
+		if len(corners) >= 3 {
+			each(corners)
+		}
+		return
+	}
+	for _, vc := range vcount {
+		n := int(vc)
+		if n < 0 {
+			n = 0
+		} else if n > len(corners) {
+			n = len(corners)
+		}
+		if n >= 3 {
+			each(corners[:n])
+		}
+		corners = corners[n:]
+	}
+}
+
+func lineStripsToLines(prim *cdom.GeometryPrimitives) (lines []uint64) {
+	stride := prim.Stride()
+	strip := func(ind []uint64) {
+		for i := stride; (i + stride) <= uint64(len(ind)); i += stride {
+			lines = append(lines, ind[i-stride:i+stride]...)
+		}
+	}
+	if ind := prim.Indices; len(prim.Vcount) == 0 {
+		strip(ind)
+	} else {
+		for _, vc := range prim.Vcount {
+			n := uint64(vc) * stride
+			if (vc < 0) || (n > uint64(len(ind))) {
+				n = uint64(len(ind))
+			}
+			strip(ind[:n])
+			ind = ind[n:]
+		}
+	}
+	return
+}
+
+func readAttrib(vertex []float32, vals []float64, in compileInput, corner []uint64) (err error) {
+	for i := range vals {
+		vals[i] = 0
+	}
+	if (len(in.indices) > 0) && (len(vals) > 0) && !in.read(corner, vals) {
+		return fmt.Errorf("index %d out of range in source '%s'", corner[in.offset], in.src.Id)
+	}
+	for i, f := range vals {
+		vertex[i] = float32(f)
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package cdomutil
+
+import (
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+func TestGeometryCompileGroupVertexCounts(t *testing.T) {
+	pos := NewSourceFloats("pos", []float64{0, 0, 0, 1, 0, 0, 0, 1, 0}, "X", "Y", "Z")
+	mesh := &cdom.GeometryMesh{}
+	mesh.Sources = cdom.Sources{pos.Id: pos}
+	//	three triangles sharing the same 3 vertices, with those of material "a" split by one of material "b"
+	for _, mat := range []string{"a", "b", "a"} {
+		prim := &cdom.GeometryPrimitives{Kind: cdom.GeometryPrimitiveKindTriangles, Material: mat}
+		prim.Count, prim.Indices = 1, []uint64{0, 1, 2}
+		prim.Inputs = []*cdom.InputShared{{Input: cdom.Input{Semantic: "POSITION", Source: cdom.RefId(pos.Id)}}}
+		mesh.Primitives = append(mesh.Primitives, prim)
+	}
+	bufs, err := GeometryCompile(mesh, GeometryVertexAttrib{Semantic: "POSITION"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := bufs.VertexCount(); n != 3 {
+		t.Errorf("got %d vertices, want 3", n)
+	}
+	if len(bufs.Groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(bufs.Groups))
+	}
+	for _, test := range []struct {
+		material                string
+		indexCount, vertexCount int
+	}{{"a", 6, 3}, {"b", 3, 3}} {
+		for _, group := range bufs.Groups {
+			if (group.Material == test.material) && ((group.IndexCount != test.indexCount) || (group.VertexCount != test.vertexCount)) {
+				t.Errorf("group %q: got %d indices and %d vertices, want %d and %d", test.material, group.IndexCount, group.VertexCount, test.indexCount, test.vertexCount)
+			}
+		}
+	}
+}
@@ -3,4 +3,8 @@
 //
 // Specifically, provides a wide range of useful constructor functions for
 // a variety of go-collada/dom package resource definitions and instances.
+//
+// Also provides functions for processing geometry data, such as triangulation,
+// de-indexing into vertex buffers, normal and tangent generation and bounding volumes,
+// and for evaluating transforms, animations, morphs and skins.
 package cdomutil
@@ -0,0 +1,230 @@
+package cdomutil
+
+import (
+	"fmt"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Evaluates a morph controller on the CPU, as created by NewMorpher().
+type Morpher struct {
+	//	The controller definition being evaluated.
+	Def *cdom.ControllerDef
+
+	//	The morph of Def.
+	Morph *cdom.ControllerMorph
+
+	//	The base mesh referred to by Morph.Source.
+	Base *cdom.GeometryMesh
+
+	//	The target meshes, in the order of the "MORPH_TARGET" Source of Morph.Targets.
+	Targets []*cdom.GeometryMesh
+
+	//	The "MORPH_WEIGHT" Source of Morph.Targets.
+	WeightSource *cdom.Source
+
+	//	The weight of each of Targets, as used by the last Update(), which reads them from WeightSource first (see
+	//	ReadWeights()). So, weights are to be set in WeightSource, such as by an Animator targeting it.
+	Weights []float64
+
+	//	The blended mesh, as computed by Update(): a shallow copy of Base that shares its Vertices and Primitives,
+	//	but has its own Sources, holding copies of all blended Sources of Base (with the same Ids). Hence, Mesh
+	//	can be used in place of Base, such as with GeometryCompile().
+	Mesh *cdom.GeometryMesh
+
+	blends []morphBlend
+}
+
+type morphBlend struct {
+	semantic     string
+	base, result *cdom.Source
+	//	one per Morpher.Targets, or nil where a target has no corresponding Source
+	targets []*cdom.Source
+}
+
+//	Prepares the evaluation of def, which must declare a Morph whose Source refers to a GeometryDef with a Mesh, as
+//	do all the Ids in the "MORPH_TARGET" Source of its Targets. Every Source of the base mesh that holds Floats and
+//	is referred to by an Input of its Vertices or Primitives is blended with the Source of each target mesh that is
+//	referred to by an Input with the same Semantic and set. Returns an error if any of the meshes or Sources are
+//	missing, if the numbers of targets and weights disagree, or if corresponding Sources of the base and a target
+//	mesh hold different numbers of values. Calls Update() once, so that me.Mesh reflects the initial me.Weights.
+func NewMorpher(def *cdom.ControllerDef, reg *cdom.Registry) (me *Morpher, err error) {
+	me = &Morpher{Def: def, Morph: def.Morph}
+	if me.Morph == nil {
+		err = fmt.Errorf("controller '%s' is not a morph", def.Id)
+	} else if geo := me.Morph.Source.GeometryDef(reg); (geo == nil) || (geo.Mesh == nil) {
+		err = fmt.Errorf("controller '%s': no geometry mesh '%s'", def.Id, me.Morph.Source)
+	} else {
+		me.Base = geo.Mesh
+		if err = me.initTargets(reg); err == nil {
+			err = me.initBlends()
+		}
+		if err != nil {
+			err = fmt.Errorf("controller '%s': %s", def.Id, err.Error())
+		}
+	}
+	if err != nil {
+		me = nil
+	} else {
+		me.Update()
+	}
+	return
+}
+
+func (me *Morpher) initBlends() (err error) {
+	me.Mesh = &cdom.GeometryMesh{}
+	*me.Mesh = *me.Base
+	me.Mesh.Sources = cdom.Sources{}
+	for id, src := range me.Base.Sources {
+		me.Mesh.Sources[id] = src
+	}
+	done := map[*cdom.Source]bool{}
+	for _, in := range meshInputs(me.Base) {
+		if done[in.src] || (len(in.src.Array.Floats) == 0) {
+			continue
+		}
+		done[in.src] = true
+		blend := morphBlend{semantic: in.semantic, base: in.src, targets: make([]*cdom.Source, len(me.Targets))}
+		for t, target := range me.Targets {
+			for _, tin := range meshInputs(target) {
+				if (tin.semantic == in.semantic) && (tin.set == in.set) {
+					if len(tin.src.Array.Floats) != len(in.src.Array.Floats) {
+						return fmt.Errorf("morph target %d: source '%s' has %d values, but base source '%s' has %d", t, tin.src.Id, len(tin.src.Array.Floats), in.src.Id, len(in.src.Array.Floats))
+					}
+					blend.targets[t] = tin.src
+					break
+				}
+			}
+		}
+		result := *in.src
+		result.Array.Floats = make([]float64, len(in.src.Array.Floats))
+		blend.result = &result
+		me.Mesh.Sources[in.id] = blend.result
+		me.blends = append(me.blends, blend)
+	}
+	return
+}
+
+func (me *Morpher) initTargets(reg *cdom.Registry) (err error) {
+	var ids []string
+	for _, in := range me.Morph.Targets.Inputs {
+		if src := me.Morph.Sources[in.Source.S()]; src == nil {
+			err = fmt.Errorf("no morph source '%s'", in.Source)
+		} else if in.Semantic == "MORPH_TARGET" {
+			ids, err = src.Strings()
+		} else if in.Semantic == "MORPH_WEIGHT" {
+			me.WeightSource = src
+		}
+		if err != nil {
+			return
+		}
+	}
+	if me.WeightSource == nil {
+		return fmt.Errorf("morph targets need a MORPH_WEIGHT input")
+	}
+	me.Targets = make([]*cdom.GeometryMesh, len(ids))
+	if err = me.ReadWeights(); err != nil {
+		return
+	}
+	for t, id := range ids {
+		geo := cdom.RefId(id).GeometryDef(reg)
+		if (geo == nil) || (geo.Mesh == nil) {
+			return fmt.Errorf("no morph target geometry mesh '%s'", id)
+		}
+		me.Targets[t] = geo.Mesh
+	}
+	return
+}
+
+//	Sets me.Weights to the current values of me.WeightSource, such as after an Animator has applied animated
+//	weights to it (see NewAnimator() about targeting Sources). Called by Update(), so only needed to inspect
+//	me.Weights in between. Returns an error, leaving me.Weights unchanged, if the values of me.WeightSource
+//	cannot be read or if their number differs from that of me.Targets.
+func (me *Morpher) ReadWeights() (err error) {
+	var weights []float64
+	if weights, err = me.WeightSource.Floats(); (err == nil) && (len(weights) != len(me.Targets)) {
+		err = fmt.Errorf("%d morph targets but %d weights", len(me.Targets), len(weights))
+	}
+	if err == nil {
+		me.Weights = weights
+	}
+	return
+}
+
+//	Re-reads me.Weights via ReadWeights() (keeping the previous ones if that fails), then
+//	blends all Sources of me.Base with those of me.Targets into me.Mesh, as per me.Weights. If me.Morph.Relative
+//	is false (normalized blending), the result is the base value multiplied with 1 minus the sum of all weights,
+//	plus the sum of all target values multiplied with their weights. Otherwise (relative blending), it is the base
+//	value plus the sum of all target values multiplied with their weights. A target without a corresponding Source
+//	counts as equal to the base mesh when normalizing, and as zero when relative. Blended "NORMAL", "TEXTANGENT" and
+//	"TEXBINORMAL" values are renormalized afterwards.
+func (me *Morpher) Update() {
+	me.ReadWeights()
+	for _, blend := range me.blends {
+		base, res := blend.base.Array.Floats, blend.result.Array.Floats
+		copy(res, base)
+		for t, target := range blend.targets {
+			if w := me.Weights[t]; (target != nil) && (w != 0) {
+				for i, f := range target.Array.Floats {
+					if me.Morph.Relative {
+						res[i] += w * f
+					} else {
+						res[i] += w * (f - base[i])
+					}
+				}
+			}
+		}
+		switch blend.semantic {
+		case "NORMAL", "TEXTANGENT", "TEXBINORMAL":
+			renormalize(blend.result)
+		}
+	}
+}
+
+type meshInput struct {
+	semantic, id string
+	set          uint64
+	src          *cdom.Source
+}
+
+//	Returns all Inputs of the Vertices and Primitives of mesh that refer to one of its Sources.
+func meshInputs(mesh *cdom.GeometryMesh) (inputs []meshInput) {
+	add := func(in *cdom.Input, set uint64) {
+		if src := mesh.Sources[in.Source.S()]; src != nil {
+			inputs = append(inputs, meshInput{semantic: in.Semantic, id: in.Source.S(), set: set, src: src})
+		}
+	}
+	if mesh.Vertices != nil {
+		for _, in := range mesh.Vertices.Inputs {
+			add(in, 0)
+		}
+	}
+	for _, prim := range mesh.Primitives {
+		for _, in := range prim.Inputs {
+			if in.Semantic != "VERTEX" {
+				add(&in.Input, inputSet(in))
+			}
+		}
+	}
+	return
+}
+
+//	Normalizes all 3-component elements of src in-place.
+func renormalize(src *cdom.Source) {
+	indices, err := src.ParamIndices()
+	if (err != nil) || (len(indices) != 3) {
+		return
+	}
+	offset, stride := src.Layout()
+	vals := src.Array.Floats
+	for i, count := uint64(0), src.Count(); i < count; i++ {
+		start := offset + i*stride
+		if (start + indices[2]) >= uint64(len(vals)) {
+			break
+		}
+		v := vnorm([3]float64{vals[start+indices[0]], vals[start+indices[1]], vals[start+indices[2]]})
+		for c, index := range indices {
+			vals[start+index] = v[c]
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package cdomutil_test
+
+import (
+	"strings"
+	"testing"
+
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	Morphs the position (1,0,0) of geometry "base" towards the (3,0,0) of geometry "target" at half weight.
+const testMorphDoc = testDocHead + `<library_controllers><controller id="c"><morph source="#base" method="NORMALIZED">
+<source id="tg"><IDREF_array id="tg-a" count="1">target</IDREF_array><technique_common><accessor source="#tg-a" count="1"><param name="MORPH_TARGET" type="IDREF"/></accessor></technique_common></source>
+<source id="mw"><float_array id="mw-a" count="1">0.5</float_array><technique_common><accessor source="#mw-a" count="1"><param name="MORPH_WEIGHT" type="float"/></accessor></technique_common></source>
+<targets><input semantic="MORPH_TARGET" source="#tg"/><input semantic="MORPH_WEIGHT" source="#mw"/></targets>
+</morph></controller></library_controllers>
+<library_geometries>
+<geometry id="base"><mesh>
+<source id="base-pos"><float_array id="base-pos-a" count="3">1 0 0</float_array><technique_common><accessor source="#base-pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="base-v"><input semantic="POSITION" source="#base-pos"/></vertices>
+</mesh></geometry>
+<geometry id="target"><mesh>
+<source id="target-pos"><float_array id="target-pos-a" count="3">3 0 0</float_array><technique_common><accessor source="#target-pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="target-v"><input semantic="POSITION" source="#target-pos"/></vertices>
+</mesh></geometry>
+</library_geometries>
+</COLLADA>`
+
+func TestMorpherNormalizedVsRelative(t *testing.T) {
+	for _, test := range []struct {
+		method            string
+		initial, animated float64
+	}{
+		//	the base value plus the weighted difference between the target and the base value
+		{"NORMALIZED", 2, 3},
+		//	the base value plus the weighted target value
+		{"RELATIVE", 2.5, 4},
+	} {
+		reg := testImport(t, strings.Replace(testMorphDoc, "NORMALIZED", test.method, 1)).Registry
+		mo, err := cdomutil.NewMorpher(reg.ControllerDefs.M["c"], reg)
+		if err != nil {
+			t.Fatalf("%s: %v", test.method, err)
+		}
+		if x := mo.Mesh.Sources["base-pos"].Array.Floats[0]; !testNearly(x, test.initial) {
+			t.Errorf("%s: got X %v, want %v", test.method, x, test.initial)
+		}
+		mo.WeightSource.Array.Floats[0] = 1
+		mo.Update()
+		if x := mo.Mesh.Sources["base-pos"].Array.Floats[0]; !testNearly(x, test.animated) {
+			t.Errorf("%s: got X %v with an animated weight of 1, want %v", test.method, x, test.animated)
+		}
+		if x := mo.Base.Sources["base-pos"].Array.Floats[0]; x != 1 {
+			t.Errorf("%s: got base X %v, want it unchanged at 1", test.method, x)
+		}
+	}
+}
@@ -0,0 +1,490 @@
+package cdomutil
+
+import (
+	"fmt"
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Generates normals for all polygons and triangles in mesh, and adds them to mesh as a new Source (with
+//	the Id of the POSITION Source plus "-normals") and a new "NORMAL" InputShared in each of mesh.Primitives,
+//	replacing any existing "NORMAL" inputs. Any "NORMAL" input in mesh.Vertices is removed, and moved into those
+//	other mesh.Primitives that use it (such as GeometryPrimitiveKindLines) so that they keep their normals.
+//	Each corner's normal is the average of the normals of all polygons sharing the corner's POSITION index
+//	(weighted by their angle at that corner) that deviate from the normal of the corner's own polygon by
+//	at most smoothAngle (in radians). Hence, a smoothAngle of 0 generates faceted normals, and a smoothAngle
+//	of math.Pi generates fully smooth normals. Any GeometryPrimitiveKindTrifans and GeometryPrimitiveKindTristrips
+//	in mesh are first converted into GeometryPrimitiveKindTriangles (as by GeometryTriangulate()), since their
+//	corners are shared among several triangles. GeometryPrimitiveKindLines and GeometryPrimitiveKindLineStrips
+//	are left untouched. Until the library containing mesh is Reindex()ed, lookups of the new Source fall back to a linear scan.
+func GeometryGenerateNormals(mesh *cdom.GeometryMesh, smoothAngle float64) (err error) {
+	type posKey struct {
+		src   *cdom.Source
+		index uint64
+	}
+	type incidence struct {
+		normal [3]float64
+		weight float64
+	}
+	var (
+		prims   []*cdom.GeometryPrimitives
+		corners []*meshCorner
+		keys    []posKey
+		srcId   string
+	)
+	triangulateStrips(mesh)
+	incident := map[posKey][]incidence{}
+	for _, prim := range mesh.Primitives {
+		pos := compileInputs(mesh, prim, layoutPositions)[0]
+		if faces := meshFaces(prim); (pos.src != nil) && (len(faces) > 0) {
+			if prims = append(prims, prim); len(srcId) == 0 {
+				srcId = pos.src.Id
+			}
+			for _, face := range faces {
+				face.init(pos)
+				for _, mc := range face.corners() {
+					key := posKey{src: pos.src, index: mc.corner[pos.offset]}
+					corners, keys = append(corners, mc), append(keys, key)
+					incident[key] = append(incident[key], incidence{normal: face.normal, weight: mc.weight})
+				}
+			}
+		}
+	}
+	if len(prims) == 0 {
+		err = fmt.Errorf("mesh has no polygons with a POSITION input")
+		return
+	}
+	minDot := math.Cos(smoothAngle) - 1e-6
+	vals, indices := newVecIndex(3), map[*uint64]uint64{}
+	for i, mc := range corners {
+		var n [3]float64
+		for _, inc := range incident[keys[i]] {
+			if vdot(mc.face.normal, inc.normal) >= minDot {
+				n = vadd(n, vscale(inc.normal, inc.weight))
+			}
+		}
+		if n = vnorm(n); n == ([3]float64{}) {
+			n = mc.face.normal
+		}
+		indices[&mc.corner[0]] = vals.index(n[:])
+	}
+	id := uniqueSourceId(mesh, srcId+"-normals")
+	mesh.Sources[id] = NewSourceFloats(id, vals.floats, "X", "Y", "Z")
+	for _, prim := range prims {
+		in := &cdom.InputShared{Input: cdom.Input{Semantic: "NORMAL", Source: cdom.RefId(id)}, Offset: widenPrimitives(prim, indices)}
+		prim.Inputs = append(prim.Inputs, in)
+		dropInputs(prim, func(old *cdom.InputShared) bool {
+			return (old != in) && (old.Semantic == "NORMAL")
+		})
+	}
+	if mesh.Vertices != nil {
+		moveVertexNormals(mesh, prims)
+	}
+	return
+}
+
+//	Removes all "NORMAL" inputs from mesh.Vertices, which would otherwise compete with the new NORMAL
+//	inputs of the (generated) primitives. Any other primitives that use the VERTEX input (such as lines)
+//	and have no NORMAL input of their own instead get the removed inputs, at the offset of their VERTEX input.
+func moveVertexNormals(mesh *cdom.GeometryMesh, generated []*cdom.GeometryPrimitives) {
+	var normals []*cdom.Input
+	inputs := mesh.Vertices.Inputs[:0]
+	for _, in := range mesh.Vertices.Inputs {
+		if in.Semantic == "NORMAL" {
+			normals = append(normals, in)
+		} else {
+			inputs = append(inputs, in)
+		}
+	}
+	if mesh.Vertices.Inputs = inputs; len(normals) == 0 {
+		return
+	}
+	done := map[*cdom.GeometryPrimitives]bool{}
+	for _, prim := range generated {
+		done[prim] = true
+	}
+	for _, prim := range mesh.Primitives {
+		var vertex *cdom.InputShared
+		for _, in := range prim.Inputs {
+			if in.Semantic == "NORMAL" {
+				vertex = nil
+				break
+			} else if in.Semantic == "VERTEX" {
+				vertex = in
+			}
+		}
+		if (vertex != nil) && !done[prim] {
+			for _, in := range normals {
+				prim.Inputs = append(prim.Inputs, &cdom.InputShared{Input: *in, Offset: vertex.Offset})
+			}
+		}
+	}
+}
+
+//	Generates tangents and bitangents for all polygons and triangles in mesh that have POSITION, NORMAL and "TEXCOORD"
+//	inputs, the latter with the specified Set, and adds them to mesh as two new Sources (with the Id of the TEXCOORD
+//	Source plus "-tangents" or "-binormals") and two new InputShareds of the specified Set (with the Semantics
+//	"TEXTANGENT" and "TEXBINORMAL", sharing the same offset) in each of those mesh.Primitives, replacing any existing
+//	"TEXTANGENT" and "TEXBINORMAL" inputs of that Set. In the spirit of MikkTSpace, all corners with the same position,
+//	normal and texture coordinates share the same tangent: the average of the tangents of their polygons (weighted by
+//	their angle at that corner), made orthogonal to the corner's normal. The bitangent is the cross product of normal
+//	and tangent, negated where the texture coordinates are mirrored. Any GeometryPrimitiveKindTrifans and
+//	GeometryPrimitiveKindTristrips in mesh are first converted as by GeometryGenerateNormals().
+//	Until the library containing mesh is Reindex()ed, lookups of the new Sources fall back to a linear scan.
+func GeometryGenerateTangents(mesh *cdom.GeometryMesh, texCoordSet uint64) (err error) {
+	type tangentSum struct {
+		t, b [3]float64
+	}
+	var (
+		prims   []*cdom.GeometryPrimitives
+		corners []*meshCorner
+		normals [][3]float64
+		sums    []*tangentSum
+		uvs     [][2]float64
+		srcId   string
+	)
+	layout := []GeometryVertexAttrib{{Semantic: "POSITION"}, {Semantic: "NORMAL"}, {Semantic: "TEXCOORD", Set: texCoordSet}}
+	triangulateStrips(mesh)
+	shared := map[[8]float64]*tangentSum{}
+	for _, prim := range mesh.Primitives {
+		ins := compileInputs(mesh, prim, layout)
+		if faces := meshFaces(prim); (ins[0].src != nil) && (ins[1].src != nil) && (ins[2].src != nil) && (len(faces) > 0) {
+			if prims = append(prims, prim); len(srcId) == 0 {
+				srcId = ins[2].src.Id
+			}
+			for _, face := range faces {
+				face.init(ins[0])
+				ok := face.ok
+				for uvs = uvs[:0]; ok && (len(uvs) < len(face.rings[0])); {
+					var uv [2]float64
+					ok = ins[2].read(face.rings[0][len(uvs)], uv[:])
+					uvs = append(uvs, uv)
+				}
+				var ft, fb [3]float64
+				if ok {
+					ft, fb = face.tangents(uvs)
+				}
+				for _, mc := range face.corners() {
+					var key [8]float64
+					ins[0].read(mc.corner, key[0:3])
+					ins[1].read(mc.corner, key[3:6])
+					ins[2].read(mc.corner, key[6:8])
+					sum := shared[key]
+					if sum == nil {
+						sum = &tangentSum{}
+						shared[key] = sum
+					}
+					sum.t, sum.b = vadd(sum.t, vscale(ft, mc.weight)), vadd(sum.b, vscale(fb, mc.weight))
+					corners, sums, normals = append(corners, mc), append(sums, sum), append(normals, vnorm([3]float64{key[3], key[4], key[5]}))
+				}
+			}
+		}
+	}
+	if len(prims) == 0 {
+		err = fmt.Errorf("mesh has no polygons with POSITION, NORMAL and TEXCOORD (set %d) inputs", texCoordSet)
+		return
+	}
+	vals, indices := newVecIndex(6), map[*uint64]uint64{}
+	for i, mc := range corners {
+		n, sum := normals[i], sums[i]
+		t := vnorm(vsub(sum.t, vscale(n, vdot(n, sum.t))))
+		if t == ([3]float64{}) {
+			t = vperp(n)
+		}
+		b := vcross(n, t)
+		if vdot(b, sum.b) < 0 {
+			b = vscale(b, -1)
+		}
+		indices[&mc.corner[0]] = vals.index(append(t[:], b[:]...))
+	}
+	tangents, binormals := make([]float64, 0, len(vals.floats)/2), make([]float64, 0, len(vals.floats)/2)
+	for i := 0; i < len(vals.floats); i += 6 {
+		tangents, binormals = append(tangents, vals.floats[i:i+3]...), append(binormals, vals.floats[i+3:i+6]...)
+	}
+	tid := uniqueSourceId(mesh, srcId+"-tangents")
+	mesh.Sources[tid] = NewSourceFloats(tid, tangents, "X", "Y", "Z")
+	bid := uniqueSourceId(mesh, srcId+"-binormals")
+	mesh.Sources[bid] = NewSourceFloats(bid, binormals, "X", "Y", "Z")
+	for _, prim := range prims {
+		offset, set := widenPrimitives(prim, indices), texCoordSet
+		tin := &cdom.InputShared{Input: cdom.Input{Semantic: "TEXTANGENT", Source: cdom.RefId(tid)}, Offset: offset, Set: &set}
+		bin := &cdom.InputShared{Input: cdom.Input{Semantic: "TEXBINORMAL", Source: cdom.RefId(bid)}, Offset: offset, Set: &set}
+		prim.Inputs = append(prim.Inputs, tin, bin)
+		dropInputs(prim, func(old *cdom.InputShared) bool {
+			return (old != tin) && (old != bin) && ((old.Semantic == "TEXTANGENT") || (old.Semantic == "TEXBINORMAL")) && (inputSet(old) == texCoordSet)
+		})
+	}
+	return
+}
+
+//	Removes all inputs of prim for which drop returns true, and then all indices no longer used by any
+//	remaining input, adjusting the offsets of the remaining inputs accordingly.
+func dropInputs(prim *cdom.GeometryPrimitives, drop func(*cdom.InputShared) bool) {
+	var kept []*cdom.InputShared
+	stride, unused := prim.Stride(), map[uint64]bool{}
+	for _, in := range prim.Inputs {
+		if drop(in) {
+			unused[in.Offset] = true
+		} else {
+			kept = append(kept, in)
+		}
+	}
+	for _, in := range kept {
+		delete(unused, in.Offset)
+	}
+	if prim.Inputs = kept; len(unused) > 0 {
+		compact := func(ind []uint64) (compacted []uint64) {
+			compacted = make([]uint64, 0, uint64(len(ind))/stride*(stride-uint64(len(unused))))
+			for _, c := range splitCorners(ind, stride) {
+				for offset, index := range c {
+					if !unused[uint64(offset)] {
+						compacted = append(compacted, index)
+					}
+				}
+			}
+			return
+		}
+		eachIndices(prim, compact)
+		for i, in := range prim.Inputs {
+			//	InputShareds may be shared by other primitives, so they are copied rather than modified
+			shifted := *in
+			for offset := range unused {
+				if offset < in.Offset {
+					shifted.Offset--
+				}
+			}
+			prim.Inputs[i] = &shifted
+		}
+	}
+}
+
+//	Calls fn for, and replaces with its result, prim.Indices and the Indices and Holes of all prim.PolyHoles.
+func eachIndices(prim *cdom.GeometryPrimitives, fn func([]uint64) []uint64) {
+	prim.Indices = fn(prim.Indices)
+	for _, ph := range prim.PolyHoles {
+		ph.Indices = fn(ph.Indices)
+		for i := range ph.Holes {
+			ph.Holes[i] = fn(ph.Holes[i])
+		}
+	}
+}
+
+func inputSet(in *cdom.InputShared) uint64 {
+	if in.Set == nil {
+		return 0
+	}
+	return *in.Set
+}
+
+func triangulateStrips(mesh *cdom.GeometryMesh) {
+	for _, prim := range mesh.Primitives {
+		if (prim.Kind == cdom.GeometryPrimitiveKindTrifans) || (prim.Kind == cdom.GeometryPrimitiveKindTristrips) {
+			triangulate(mesh, prim)
+		}
+	}
+}
+
+//	Returns id if mesh has no Source with that Id, otherwise id with the smallest number (from 2) appended that is not taken.
+func uniqueSourceId(mesh *cdom.GeometryMesh, id string) string {
+	for i, base := 2, id; mesh.Sources[id] != nil; i++ {
+		id = fmt.Sprintf("%s%d", base, i)
+	}
+	return id
+}
+
+//	Appends one index to every corner of prim: the one in indices keyed by (the address of) the corner's first index,
+//	or 0 if there is none. Returns the offset of the new indices.
+func widenPrimitives(prim *cdom.GeometryPrimitives, indices map[*uint64]uint64) (offset uint64) {
+	offset = prim.Stride()
+	eachIndices(prim, func(ind []uint64) (wide []uint64) {
+		wide = make([]uint64, 0, uint64(len(ind))/offset*(offset+1))
+		for _, c := range splitCorners(ind, offset) {
+			wide = append(append(wide, c...), indices[&c[0]])
+		}
+		return
+	})
+	return
+}
+
+//	A corner of a meshFace.
+type meshCorner struct {
+	//	The indices of this corner, aliasing those in its GeometryPrimitives.
+	corner []uint64
+
+	face *meshFace
+
+	//	The angle of face at this corner, or 0 if unknown.
+	weight float64
+}
+
+//	A polygon (or triangle) of a GeometryPrimitives.
+type meshFace struct {
+	//	The corners of the outer boundary, followed by those of each hole (if any).
+	rings [][][]uint64
+
+	//	The positions of all corners in rings, if ok.
+	pos [][][3]float64
+	ok  bool
+
+	//	The unit normal of the outer boundary, if ok and the polygon is not degenerate.
+	normal [3]float64
+}
+
+//	Returns all polygons (or triangles) of prim, which must not be a trifans or tristrips primitive.
+func meshFaces(prim *cdom.GeometryPrimitives) (faces []*meshFace) {
+	stride := prim.Stride()
+	if stride == 0 {
+		return
+	}
+	all := splitCorners(prim.Indices, stride)
+	switch prim.Kind {
+	case cdom.GeometryPrimitiveKindTriangles:
+		for i := 0; (i + 3) <= len(all); i += 3 {
+			faces = append(faces, &meshFace{rings: [][][]uint64{all[i : i+3]}})
+		}
+	case cdom.GeometryPrimitiveKindPolygons, cdom.GeometryPrimitiveKindPolylist:
+		eachVcount(all, prim.Vcount, func(corners [][]uint64) {
+			faces = append(faces, &meshFace{rings: [][][]uint64{corners}})
+		})
+		if prim.Kind == cdom.GeometryPrimitiveKindPolygons {
+			for _, ph := range prim.PolyHoles {
+				if outer := splitCorners(ph.Indices, stride); len(outer) >= 3 {
+					face := &meshFace{rings: [][][]uint64{outer}}
+					for _, h := range ph.Holes {
+						face.rings = append(face.rings, splitCorners(h, stride))
+					}
+					faces = append(faces, face)
+				}
+			}
+		}
+	}
+	return
+}
+
+func (me *meshFace) corners() (corners []*meshCorner) {
+	for r, ring := range me.rings {
+		for i, c := range ring {
+			mc := &meshCorner{corner: c, face: me}
+			if me.ok {
+				p := me.pos[r]
+				a, b := vsub(p[(i+len(p)-1)%len(p)], p[i]), vsub(p[(i+1)%len(p)], p[i])
+				if la, lb := vlen(a), vlen(b); (la > 0) && (lb > 0) {
+					mc.weight = math.Acos(math.Max(-1, math.Min(1, vdot(a, b)/(la*lb))))
+				}
+			}
+			corners = append(corners, mc)
+		}
+	}
+	return
+}
+
+func (me *meshFace) init(pos compileInput) {
+	me.ok, me.pos = true, make([][][3]float64, len(me.rings))
+	for r, ring := range me.rings {
+		me.pos[r] = make([][3]float64, len(ring))
+		for i, c := range ring {
+			me.ok = me.ok && pos.read(c, me.pos[r][i][:])
+		}
+	}
+	if me.ok {
+		me.normal = vnorm(newell(me.pos[0]))
+	}
+}
+
+//	Returns the area-weighted sum of the (unit) tangents and bitangents of a fan
+//	triangulation of the outer boundary of me, given its texture coordinates.
+func (me *meshFace) tangents(uvs [][2]float64) (t, b [3]float64) {
+	p := me.pos[0]
+	for i := 2; i < len(p); i++ {
+		e1, e2 := vsub(p[i-1], p[0]), vsub(p[i], p[0])
+		du1, dv1, du2, dv2 := uvs[i-1][0]-uvs[0][0], uvs[i-1][1]-uvs[0][1], uvs[i][0]-uvs[0][0], uvs[i][1]-uvs[0][1]
+		if r := du1*dv2 - du2*dv1; r != 0 {
+			area := vlen(vcross(e1, e2))
+			t = vadd(t, vscale(vnorm(vscale(vsub(vscale(e1, dv2), vscale(e2, dv1)), 1/r)), area))
+			b = vadd(b, vscale(vnorm(vscale(vsub(vscale(e2, du1), vscale(e1, du2)), 1/r)), area))
+		}
+	}
+	return
+}
+
+//	Assigns consecutive indices to distinct vectors of a fixed size.
+type vecIndex struct {
+	size    int
+	floats  []float64
+	indices map[string]uint64
+}
+
+func newVecIndex(size int) *vecIndex {
+	return &vecIndex{size: size, indices: map[string]uint64{}}
+}
+
+//	Returns the index of vec, which is added if it was not already present.
+func (me *vecIndex) index(vec []float64) (index uint64) {
+	key := make([]byte, 0, 8*len(vec))
+	for _, f := range vec {
+		bits := math.Float64bits(f)
+		for i := uint(0); i < 64; i += 8 {
+			key = append(key, byte(bits>>i))
+		}
+	}
+	index, ok := me.indices[string(key)]
+	if !ok {
+		index = uint64(len(me.floats) / me.size)
+		me.indices[string(key)] = index
+		me.floats = append(me.floats, vec...)
+	}
+	return
+}
+
+//	Returns the (unnormalized) normal of the polygon described by pts, as per Newell's
+//	method, which is robust for concave and slightly non-planar polygons.
+func newell(pts [][3]float64) (n [3]float64) {
+	for i, p := range pts {
+		q := pts[(i+1)%len(pts)]
+		n[0] += (p[1] - q[1]) * (p[2] + q[2])
+		n[1] += (p[2] - q[2]) * (p[0] + q[0])
+		n[2] += (p[0] - q[0]) * (p[1] + q[1])
+	}
+	return
+}
+
+func vadd(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] + b[0], a[1] + b[1], a[2] + b[2]}
+}
+
+func vcross(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func vdot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func vlen(a [3]float64) float64 {
+	return math.Sqrt(vdot(a, a))
+}
+
+//	Returns a unit vector in the direction of a, or the zero vector if a is (almost) zero.
+func vnorm(a [3]float64) [3]float64 {
+	if l := vlen(a); l > 1e-300 {
+		return vscale(a, 1/l)
+	}
+	return [3]float64{}
+}
+
+//	Returns a unit vector perpendicular to the unit vector a.
+func vperp(a [3]float64) [3]float64 {
+	if math.Abs(a[0]) < 0.9 {
+		return vnorm(vcross(a, [3]float64{1, 0, 0}))
+	}
+	return vnorm(vcross(a, [3]float64{0, 1, 0}))
+}
+
+func vscale(a [3]float64, f float64) [3]float64 {
+	return [3]float64{a[0] * f, a[1] * f, a[2] * f}
+}
+
+func vsub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
@@ -0,0 +1,54 @@
+package cdomutil
+
+import (
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+func TestGeometryGenerateNormalsVertexNormals(t *testing.T) {
+	for _, withLines := range []bool{false, true} {
+		pos := NewSourceFloats("pos", []float64{0, 0, 0, 1, 0, 0, 0, 1, 0}, "X", "Y", "Z")
+		nrm := NewSourceFloats("nrm", []float64{0, 0, 1, 0, 0, 1, 0, 0, 1}, "X", "Y", "Z")
+		mesh := &cdom.GeometryMesh{Vertices: &cdom.GeometryVertices{}}
+		mesh.Sources = cdom.Sources{pos.Id: pos, nrm.Id: nrm}
+		mesh.Vertices.Id = "v"
+		mesh.Vertices.Inputs = []*cdom.Input{{Semantic: "POSITION", Source: cdom.RefId(pos.Id)}, {Semantic: "NORMAL", Source: cdom.RefId(nrm.Id)}}
+		kinds := []cdom.GeometryPrimitiveKind{cdom.GeometryPrimitiveKindTriangles}
+		if withLines {
+			kinds = append(kinds, cdom.GeometryPrimitiveKindLines)
+		}
+		for _, kind := range kinds {
+			prim := &cdom.GeometryPrimitives{Kind: kind}
+			prim.Count, prim.Indices = 1, []uint64{0, 1, 2}
+			if kind == cdom.GeometryPrimitiveKindLines {
+				prim.Indices = prim.Indices[:2]
+			}
+			prim.Inputs = []*cdom.InputShared{{Input: cdom.Input{Semantic: "VERTEX", Source: "v"}}}
+			mesh.Primitives = append(mesh.Primitives, prim)
+		}
+		if err := GeometryGenerateNormals(mesh, 0); err != nil {
+			t.Fatalf("lines %v: unexpected error: %v", withLines, err)
+		}
+		for _, in := range mesh.Vertices.Inputs {
+			if in.Semantic == "NORMAL" {
+				t.Errorf("lines %v: mesh.Vertices still has a NORMAL input", withLines)
+			}
+		}
+		for _, prim := range mesh.Primitives {
+			var normals []*cdom.InputShared
+			for _, in := range prim.Inputs {
+				if in.Semantic == "NORMAL" {
+					normals = append(normals, in)
+				}
+			}
+			if len(normals) != 1 {
+				t.Errorf("lines %v: kind %d: got %d NORMAL inputs, want 1", withLines, prim.Kind, len(normals))
+			} else if isLines, fromNrm := (prim.Kind == cdom.GeometryPrimitiveKindLines), (normals[0].Source == cdom.RefId(nrm.Id)); isLines != fromNrm {
+				t.Errorf("lines %v: kind %d: got NORMAL source %q", withLines, prim.Kind, normals[0].Source)
+			} else if isLines && (normals[0].Offset != 0) {
+				t.Errorf("lines %v: got NORMAL offset %d for lines, want that of their VERTEX input", withLines, normals[0].Offset)
+			}
+		}
+	}
+}
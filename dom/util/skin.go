@@ -0,0 +1,414 @@
+package cdomutil
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Evaluates a skin controller on the CPU, as created by NewSkinner().
+type Skinner struct {
+	//	The controller instance being skinned.
+	Inst *cdom.ControllerInst
+
+	//	The skin of the ControllerDef of Inst.
+	Skin *cdom.ControllerSkin
+
+	//	The base mesh referred to by Skin.Source, or Morpher.Mesh if Skin.Source refers to a morph controller.
+	Mesh *cdom.GeometryMesh
+
+	//	If Skin.Source refers to a morph controller rather than a GeometryDef, evaluates it: Update()
+	//	calls Morpher.Update() before reading BindPositions and BindNormals from Morpher.Mesh.
+	Morpher *Morpher
+
+	//	The scene node occurrence of each joint, in the order of the "JOINT" Source of Skin.Joints.
+	Joints []*SceneNode
+
+	//	The inverse bind matrix of each joint, from the "INV_BIND_MATRIX" Source of Skin.Joints.
+	InvBindMatrices []cdom.Float4x4
+
+	//	The skinning matrix of each joint, as computed by Update(): the product of
+	//	its current World matrix, its inverse bind matrix and Skin.BindShapeMatrix.
+	Palette []cdom.Float4x4
+
+	//	Whether Update() uses dual-quaternion skinning (which preserves volume around twisting joints)
+	//	rather than linear blend skinning. Only the rotation and translation of each joint are blended,
+	//	so any scaling or skewing in the World and inverse bind matrices of the joints is ignored.
+	DualQuaternion bool
+
+	//	The bind-shape positions: one per element of the "POSITION" Source of Mesh.Vertices.
+	BindPositions []cdom.Float3
+
+	//	The bind-shape normals: one per element of the "NORMAL" Source of Mesh (in Mesh.Vertices, or else
+	//	in the first of Mesh.Primitives that has one), or empty if Mesh has no normals.
+	BindNormals []cdom.Float3
+
+	//	The deformed positions and normals, as computed by Update(), corresponding to BindPositions and BindNormals.
+	//	Both are in the coordinate space of the scene containing the Joints.
+	Positions, Normals []cdom.Float3
+
+	influences         [][]skinInfluence
+	normalVertex       []int
+	positions, normSrc *cdom.Source
+	dualQuats          []dualQuat
+	normals            []cdom.Float4x4
+}
+
+type skinInfluence struct {
+	//	the index into Skinner.Joints, or -1 for the bind shape
+	joint  int
+	weight float64
+}
+
+//	Prepares the skinning of inst, the ControllerDef of which (resolved via inst.EnsureDef(reg)) must declare a Skin
+//	whose Source refers to a GeometryDef with a Mesh, or to a ControllerDef declaring a Morph (see NewMorpher()). The joints are looked up among nodes (as returned by
+//	VisualSceneNodes()) within the subtrees of the nodes whose Ids are listed in inst.SkinSkeletons (or all nodes,
+//	if there are none): first by Sid, then by Id. Joint indices in Skin.VertexWeights that exceed the number of
+//	joints refer to the bind shape. Returns an error if any of the joints cannot be found, or if the Sources of
+//	the skin or the mesh are missing or disagree on the number of joints or vertices. Call Update() to skin.
+func NewSkinner(inst *cdom.ControllerInst, nodes []*SceneNode, reg *cdom.Registry) (me *Skinner, err error) {
+	me = &Skinner{Inst: inst}
+	if def := inst.EnsureDef(reg); def == nil {
+		err = fmt.Errorf("no controller '%s'", inst.DefRef)
+	} else if me.Skin = def.Skin; me.Skin == nil {
+		err = fmt.Errorf("controller '%s' is not a skin", def.Id)
+	} else if err = me.initSource(reg); err != nil {
+		err = fmt.Errorf("controller '%s': %s", def.Id, err.Error())
+	} else {
+		if err = me.initMesh(); err == nil {
+			if err = me.initJoints(nodes); err == nil {
+				err = me.initInfluences()
+			}
+		}
+		if err != nil {
+			err = fmt.Errorf("controller '%s': %s", def.Id, err.Error())
+		}
+	}
+	if err != nil {
+		me = nil
+	}
+	return
+}
+
+func (me *Skinner) initInfluences() (err error) {
+	var vw *vertexWeights
+	if vw, err = readVertexWeights(me.Skin); err != nil {
+		return
+	}
+	if len(vw.corners) != len(me.BindPositions) {
+		return fmt.Errorf("%d vertex weights for %d positions", len(vw.corners), len(me.BindPositions))
+	}
+	me.influences = make([][]skinInfluence, len(vw.corners))
+	for v, corners := range vw.corners {
+		for _, c := range corners {
+			inf := skinInfluence{joint: -1, weight: vw.weights[c[vw.weightOff]]}
+			if j := c[vw.jointOff]; j < uint64(len(me.Joints)) {
+				inf.joint = int(j)
+			}
+			me.influences[v] = append(me.influences[v], inf)
+		}
+	}
+	return
+}
+
+func (me *Skinner) initJoints(nodes []*SceneNode) (err error) {
+	var names []string
+	for _, in := range me.Skin.Joints.Inputs {
+		if src := me.Skin.Sources[in.Source.S()]; src == nil {
+			err = fmt.Errorf("no joints source '%s'", in.Source)
+		} else if in.Semantic == "JOINT" {
+			names, err = src.Strings()
+		} else if in.Semantic == "INV_BIND_MATRIX" {
+			me.InvBindMatrices, err = src.Float4x4s()
+		}
+		if err != nil {
+			return
+		}
+	}
+	if len(names) != len(me.InvBindMatrices) {
+		return fmt.Errorf("%d joints but %d inverse bind matrices", len(names), len(me.InvBindMatrices))
+	}
+	roots := map[*SceneNode]bool{}
+	for _, skel := range me.Inst.SkinSkeletons {
+		for _, sn := range nodes {
+			if sn.Node.Id == strings.TrimLeft(skel, "#") {
+				roots[sn] = true
+			}
+		}
+	}
+	inScope := func(sn *SceneNode) bool {
+		for ; (len(roots) > 0) && (sn != nil); sn = sn.Parent {
+			if roots[sn] {
+				return true
+			}
+		}
+		return len(roots) == 0
+	}
+	find := func(name string) *SceneNode {
+		for _, bySid := range []bool{true, false} {
+			for _, sn := range nodes {
+				if ((bySid && (sn.Node.Sid == name)) || (!bySid && (sn.Node.Id == name))) && inScope(sn) {
+					return sn
+				}
+			}
+		}
+		return nil
+	}
+	me.Joints = make([]*SceneNode, len(names))
+	for j, name := range names {
+		if me.Joints[j] = find(name); me.Joints[j] == nil {
+			return fmt.Errorf("no joint node '%s'", name)
+		}
+	}
+	me.Palette, me.dualQuats, me.normals = make([]cdom.Float4x4, len(names)), make([]dualQuat, len(names)), make([]cdom.Float4x4, len(names))
+	return
+}
+
+func (me *Skinner) initMesh() (err error) {
+	var normals *cdom.Source
+	if me.Mesh.Vertices != nil {
+		for _, in := range me.Mesh.Vertices.Inputs {
+			if src := me.Mesh.Sources[in.Source.S()]; (in.Semantic == "POSITION") && (src != nil) {
+				if me.BindPositions, err = src.Float3s(); err != nil {
+					return
+				}
+				me.positions = src
+			} else if (in.Semantic == "NORMAL") && (src != nil) {
+				normals = src
+			}
+		}
+	}
+	if me.BindPositions == nil {
+		return fmt.Errorf("mesh has no positions")
+	}
+	if normals != nil {
+		if me.BindNormals, err = normals.Float3s(); err != nil {
+			return
+		}
+		me.normalVertex = make([]int, len(me.BindNormals))
+		for i := range me.normalVertex {
+			me.normalVertex[i] = i
+		}
+	} else {
+		for _, prim := range me.Mesh.Primitives {
+			var vOff, nOff uint64
+			hasV, hasN := false, false
+			for _, in := range prim.Inputs {
+				if in.Semantic == "VERTEX" {
+					vOff, hasV = in.Offset, true
+				} else if src := me.Mesh.Sources[in.Source.S()]; (in.Semantic == "NORMAL") && (src != nil) && ((normals == nil) || (src == normals)) {
+					if nOff, hasN = in.Offset, true; normals == nil {
+						normals = src
+						if me.BindNormals, err = src.Float3s(); err != nil {
+							return
+						}
+						me.normalVertex = make([]int, len(me.BindNormals))
+						for i := range me.normalVertex {
+							me.normalVertex[i] = -1
+						}
+					}
+				}
+			}
+			if stride := prim.Stride(); hasV && hasN {
+				eachIndices(prim, func(ind []uint64) []uint64 {
+					for c := uint64(0); (c + stride) <= uint64(len(ind)); c += stride {
+						if n, v := ind[c+nOff], ind[c+vOff]; (n < uint64(len(me.normalVertex))) && (me.normalVertex[n] < 0) {
+							me.normalVertex[n] = int(v)
+						}
+					}
+					return ind
+				})
+			}
+		}
+	}
+	me.normSrc = normals
+	me.Positions, me.Normals = make([]cdom.Float3, len(me.BindPositions)), make([]cdom.Float3, len(me.BindNormals))
+	return
+}
+
+func (me *Skinner) initSource(reg *cdom.Registry) (err error) {
+	if geo := me.Skin.Source.GeometryDef(reg); geo != nil {
+		me.Mesh = geo.Mesh
+	} else if def := me.Skin.Source.ControllerDef(reg); (def != nil) && (def.Morph != nil) {
+		if me.Morpher, err = NewMorpher(def, reg); err == nil {
+			me.Mesh = me.Morpher.Mesh
+		}
+	}
+	if (err == nil) && (me.Mesh == nil) {
+		err = fmt.Errorf("no geometry mesh or morph controller '%s'", me.Skin.Source)
+	}
+	return
+}
+
+//	Computes me.Palette from the current World matrices of me.Joints (see SceneNode.Update()),
+//	then deforms all me.BindPositions and me.BindNormals into me.Positions and me.Normals.
+//	If me.Morpher is set, it is updated first (re-reading its possibly animated Weights from its WeightSource),
+//	and the bind shape is re-read from its Mesh.
+//	The weights of each vertex are normalized first. A normal that is not used by any
+//	primitive together with a position is only transformed by Skin.BindShapeMatrix.
+func (me *Skinner) Update() {
+	if me.Morpher != nil {
+		me.Morpher.Update()
+		me.BindPositions, _ = me.positions.Float3s()
+		if me.normSrc != nil {
+			me.BindNormals, _ = me.normSrc.Float3s()
+		}
+	}
+	bindShape := cdom.Float4x4(me.Skin.BindShapeMatrix)
+	bindNormal := Float4x4NormalMatrix(&bindShape)
+	for j, joint := range me.Joints {
+		m := Float4x4Mul(&joint.World, &me.InvBindMatrices[j])
+		if me.Palette[j] = Float4x4Mul(&m, &bindShape); me.DualQuaternion {
+			me.dualQuats[j] = newDualQuat(&m)
+		} else {
+			me.normals[j] = Float4x4NormalMatrix(&me.Palette[j])
+		}
+	}
+	for v, pos := range me.BindPositions {
+		if me.DualQuaternion {
+			me.Positions[v] = me.blendDualQuat(v).point(mulPoint(&bindShape, pos, 1))
+		} else {
+			me.Positions[v] = me.blendLinear(v, pos, 1, me.Palette, &bindShape)
+		}
+	}
+	for n, nor := range me.BindNormals {
+		if v := me.normalVertex[n]; (v < 0) || (v >= len(me.influences)) {
+			me.Normals[n] = mulPoint(&bindNormal, nor, 0)
+		} else if me.DualQuaternion {
+			me.Normals[n] = me.blendDualQuat(v).vector(mulPoint(&bindNormal, nor, 0))
+		} else {
+			me.Normals[n] = me.blendLinear(v, nor, 0, me.normals, &bindNormal)
+		}
+		me.Normals[n] = vnorm(me.Normals[n])
+	}
+}
+
+//	Returns the normalized blend of the dual quaternions of all influences of vertex v.
+func (me *Skinner) blendDualQuat(v int) (dq dualQuat) {
+	var first *dualQuat
+	for _, inf := range me.influences[v] {
+		jdq, w := identityDualQuat, inf.weight
+		if inf.joint >= 0 {
+			jdq = me.dualQuats[inf.joint]
+		}
+		if first == nil {
+			first = &jdq
+		} else if qdot(first.r, jdq.r) < 0 {
+			//	blend along the shortest path
+			w = -w
+		}
+		for i := 0; i < 4; i++ {
+			dq.r[i] += w * jdq.r[i]
+			dq.d[i] += w * jdq.d[i]
+		}
+	}
+	if l := math.Sqrt(qdot(dq.r, dq.r)); l > 0 {
+		for i := 0; i < 4; i++ {
+			dq.r[i], dq.d[i] = dq.r[i]/l, dq.d[i]/l
+		}
+	} else {
+		dq = identityDualQuat
+	}
+	return
+}
+
+//	Returns the weighted sum of vec (a point if w is 1, a direction if w is 0) transformed by the matrices of all
+//	influences of vertex v, divided by the sum of their weights. Without any weight, vec is transformed by bindShape.
+func (me *Skinner) blendLinear(v int, vec cdom.Float3, w float64, mats []cdom.Float4x4, bindShape *cdom.Float4x4) (res cdom.Float3) {
+	var sum float64
+	for _, inf := range me.influences[v] {
+		m := bindShape
+		if inf.joint >= 0 {
+			m = &mats[inf.joint]
+		}
+		res, sum = vadd(res, vscale(mulPoint(m, vec, w), inf.weight)), sum+inf.weight
+	}
+	if sum == 0 {
+		return mulPoint(bindShape, vec, w)
+	}
+	return vscale(res, 1/sum)
+}
+
+//	Returns the joints (indices into me.Joints, or -1 for the bind shape) and the weights of all influences of the
+//	v-th vertex (the v-th element of me.BindPositions), in the order of Skin.VertexWeights. The weights are not
+//	normalized. Returns nil for both if v is out of range.
+func (me *Skinner) Influences(v int) (joints []int, weights []float64) {
+	if (v >= 0) && (v < len(me.influences)) {
+		joints, weights = make([]int, len(me.influences[v])), make([]float64, len(me.influences[v]))
+		for i, inf := range me.influences[v] {
+			joints[i], weights[i] = inf.joint, inf.weight
+		}
+	}
+	return
+}
+
+//	A unit dual quaternion representing a rotation (r) followed by a translation (encoded in d).
+//	Quaternions are stored as X, Y, Z, W.
+type dualQuat struct {
+	r, d [4]float64
+}
+
+var (
+	identityDualQuat = dualQuat{r: [4]float64{0, 0, 0, 1}}
+)
+
+//	Returns the dual quaternion for the rotation and translation of m, disregarding any scaling.
+func newDualQuat(m *cdom.Float4x4) (dq dualQuat) {
+	var rot [3][3]float64
+	for c := 0; c < 3; c++ {
+		col := vnorm([3]float64{m[c], m[4+c], m[8+c]})
+		for r := 0; r < 3; r++ {
+			rot[r][c] = col[r]
+		}
+	}
+	if tr := rot[0][0] + rot[1][1] + rot[2][2]; tr > 0 {
+		s := 2 * math.Sqrt(tr+1)
+		dq.r = [4]float64{(rot[2][1] - rot[1][2]) / s, (rot[0][2] - rot[2][0]) / s, (rot[1][0] - rot[0][1]) / s, s / 4}
+	} else if (rot[0][0] > rot[1][1]) && (rot[0][0] > rot[2][2]) {
+		s := 2 * math.Sqrt(1+rot[0][0]-rot[1][1]-rot[2][2])
+		dq.r = [4]float64{s / 4, (rot[0][1] + rot[1][0]) / s, (rot[0][2] + rot[2][0]) / s, (rot[2][1] - rot[1][2]) / s}
+	} else if rot[1][1] > rot[2][2] {
+		s := 2 * math.Sqrt(1+rot[1][1]-rot[0][0]-rot[2][2])
+		dq.r = [4]float64{(rot[0][1] + rot[1][0]) / s, s / 4, (rot[1][2] + rot[2][1]) / s, (rot[0][2] - rot[2][0]) / s}
+	} else {
+		s := 2 * math.Sqrt(1+rot[2][2]-rot[0][0]-rot[1][1])
+		dq.r = [4]float64{(rot[0][2] + rot[2][0]) / s, (rot[1][2] + rot[2][1]) / s, s / 4, (rot[1][0] - rot[0][1]) / s}
+	}
+	dq.d = qmul([4]float64{m[3] / 2, m[7] / 2, m[11] / 2, 0}, dq.r)
+	return
+}
+
+//	Rotates and translates p by me.
+func (me dualQuat) point(p [3]float64) [3]float64 {
+	t := qmul(me.d, [4]float64{-me.r[0], -me.r[1], -me.r[2], me.r[3]})
+	return vadd(me.vector(p), [3]float64{2 * t[0], 2 * t[1], 2 * t[2]})
+}
+
+//	Rotates v by me.
+func (me dualQuat) vector(v [3]float64) [3]float64 {
+	axis := [3]float64{me.r[0], me.r[1], me.r[2]}
+	return vadd(v, vscale(vcross(axis, vadd(vcross(axis, v), vscale(v, me.r[3]))), 2))
+}
+
+//	Returns m * (p, w).
+func mulPoint(m *cdom.Float4x4, p [3]float64, w float64) (res cdom.Float3) {
+	for r := 0; r < 3; r++ {
+		res[r] = m[r*4]*p[0] + m[r*4+1]*p[1] + m[r*4+2]*p[2] + m[r*4+3]*w
+	}
+	return
+}
+
+func qdot(a, b [4]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2] + a[3]*b[3]
+}
+
+func qmul(a, b [4]float64) [4]float64 {
+	return [4]float64{
+		a[3]*b[0] + a[0]*b[3] + a[1]*b[2] - a[2]*b[1],
+		a[3]*b[1] - a[0]*b[2] + a[1]*b[3] + a[2]*b[0],
+		a[3]*b[2] + a[0]*b[1] - a[1]*b[0] + a[2]*b[3],
+		a[3]*b[3] - a[0]*b[0] - a[1]*b[1] - a[2]*b[2],
+	}
+}
@@ -0,0 +1,75 @@
+package cdomutil_test
+
+import (
+	"math"
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	Skins two vertices at (1,0,0) to the joints "j0" and "j1": the first half to each joint, the second fully to "j1".
+const testSkinDoc = testDocHead + `<library_controllers><controller id="c"><skin source="#g">
+<source id="jn"><Name_array id="jn-a" count="2">j0 j1</Name_array><technique_common><accessor source="#jn-a" count="2"><param name="JOINT" type="name"/></accessor></technique_common></source>
+<source id="ib"><float_array id="ib-a" count="32">1 0 0 0 0 1 0 0 0 0 1 0 0 0 0 1 1 0 0 0 0 1 0 0 0 0 1 0 0 0 0 1</float_array><technique_common><accessor source="#ib-a" count="2" stride="16"><param name="TRANSFORM" type="float4x4"/></accessor></technique_common></source>
+<source id="w"><float_array id="w-a" count="2">0.5 1</float_array><technique_common><accessor source="#w-a" count="2"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<joints><input semantic="JOINT" source="#jn"/><input semantic="INV_BIND_MATRIX" source="#ib"/></joints>
+<vertex_weights count="2"><input semantic="JOINT" source="#jn" offset="0"/><input semantic="WEIGHT" source="#w" offset="1"/><vcount>2 1</vcount><v>0 0 1 0 1 1</v></vertex_weights>
+</skin></controller></library_controllers>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="6">1 0 0 1 0 0</float_array><technique_common><accessor source="#pos-a" count="2" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<source id="nor"><float_array id="nor-a" count="6">1 0 0 1 0 0</float_array><technique_common><accessor source="#nor-a" count="2" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/><input semantic="NORMAL" source="#nor"/></vertices>
+</mesh></geometry></library_geometries>
+<library_visual_scenes><visual_scene id="vs">
+<node id="j0" sid="j0" type="JOINT"/>
+<node id="j1" sid="j1" type="JOINT"><rotate sid="rz">0 0 1 0</rotate></node>
+<node id="m"><instance_controller url="#c"/></node>
+</visual_scene></library_visual_scenes>
+</COLLADA>`
+
+func TestSkinnerLinearVsDualQuaternion(t *testing.T) {
+	reg := testImport(t, testSkinDoc).Registry
+	nodes, _ := cdomutil.VisualSceneNodes(reg.VisualSceneDefs.M["vs"], reg)
+	var (
+		inst *cdom.ControllerInst
+		j1   *cdom.NodeDef
+	)
+	for _, sn := range nodes {
+		if sn.Node.Id == "m" {
+			inst = sn.Node.Insts.Controller[0]
+		} else if sn.Node.Id == "j1" {
+			j1 = sn.Node
+		}
+	}
+	j1.Transforms[0].F[3] = 90
+	for _, sn := range nodes {
+		sn.Update()
+	}
+	h := math.Sqrt(0.5)
+	for _, test := range []struct {
+		dq   bool
+		half cdom.Float3
+	}{
+		//	linear blending averages (1,0,0) and (0,1,0), shrinking the half-rotated vertex towards the pivot
+		{false, cdom.Float3{0.5, 0.5, 0}},
+		//	dual-quaternion blending rotates it by 45 degrees instead, preserving its distance from the pivot
+		{true, cdom.Float3{h, h, 0}},
+	} {
+		sk, err := cdomutil.NewSkinner(inst, nodes, reg)
+		if err != nil {
+			t.Fatalf("dq %v: %v", test.dq, err)
+		}
+		sk.DualQuaternion = test.dq
+		sk.Update()
+		for i, want := range [][2]cdom.Float3{{test.half, {h, h, 0}}, {{0, 1, 0}, {0, 1, 0}}} {
+			pos, nor := sk.Positions[i], sk.Normals[i]
+			for c := 0; c < 3; c++ {
+				if !testNearly(pos[c], want[0][c]) || !testNearly(nor[c], want[1][c]) {
+					t.Errorf("dq %v, vertex %d: got position %v and normal %v, want %v and %v", test.dq, i, pos, nor, want[0], want[1])
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package cdomutil
+
+import (
+	"fmt"
+	"sort"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	The influences of all vertices of a ControllerSkin in fixed-width form, as returned by SkinNormalizeWeights():
+//	the influences of vertex v are Joints[v*Width:(v+1)*Width] and Weights[v*Width:(v+1)*Width], by descending
+//	weight. Vertices with fewer than Width influences are padded with joint 0 and weight 0.
+type SkinWeights struct {
+	//	The number of influences per vertex.
+	Width int
+
+	//	Indices into the "JOINT" Source of the skin.
+	Joints []uint64
+
+	//	The weights of Joints.
+	Weights []float64
+}
+
+//	Cleans up the VertexWeights of skin, in-place: per vertex, drops all influences with a weight below epsilon,
+//	keeps only the maxInfluences (if positive) with the highest weights, and scales the remaining weights to sum up to 1.
+//	Vcount and Indices are rewritten accordingly, and the Source referred to by the "WEIGHT" Input is replaced with one
+//	weight per remaining influence, in order. Also returns the result in fixed-width form, with a Width of maxInfluences
+//	(if positive) or else the highest number of influences of any vertex. A vertex whose influences were all dropped
+//	has none left (and only padding in packed). Returns an error if the VertexWeights of skin lack a "JOINT" or
+//	"WEIGHT" Input, or if the "WEIGHT" Source is missing or too short for the Indices.
+func SkinNormalizeWeights(skin *cdom.ControllerSkin, maxInfluences int, epsilon float64) (packed *SkinWeights, err error) {
+	var vw *vertexWeights
+	if vw, err = readVertexWeights(skin); err != nil {
+		return
+	}
+	packed = &SkinWeights{Width: maxInfluences}
+	kept, sums := make([][][]uint64, len(vw.corners)), make([]float64, len(vw.corners))
+	for v, corners := range vw.corners {
+		for _, c := range corners {
+			if vw.weights[c[vw.weightOff]] >= epsilon {
+				kept[v] = append(kept[v], c)
+			}
+		}
+		if sort.Stable(skinCornersByWeight{kept[v], vw}); (maxInfluences > 0) && (len(kept[v]) > maxInfluences) {
+			kept[v] = kept[v][:maxInfluences]
+		} else if (maxInfluences <= 0) && (len(kept[v]) > packed.Width) {
+			packed.Width = len(kept[v])
+		}
+		for _, c := range kept[v] {
+			sums[v] += vw.weights[c[vw.weightOff]]
+		}
+	}
+	var (
+		weights []float64
+		indices []uint64
+	)
+	vcount := make([]int64, len(kept))
+	packed.Joints, packed.Weights = make([]uint64, len(kept)*packed.Width), make([]float64, len(kept)*packed.Width)
+	for v, corners := range kept {
+		vcount[v] = int64(len(corners))
+		for i, c := range corners {
+			w := vw.weights[c[vw.weightOff]]
+			if sums[v] != 0 {
+				w /= sums[v]
+			}
+			corner := append([]uint64{}, c...)
+			corner[vw.weightOff] = uint64(len(weights))
+			indices, weights = append(indices, corner...), append(weights, w)
+			packed.Joints[v*packed.Width+i], packed.Weights[v*packed.Width+i] = c[vw.jointOff], w
+		}
+	}
+	skin.VertexWeights.Vcount, skin.VertexWeights.Indices = vcount, indices
+	src := vw.source
+	if src.Array.Floats = weights; src.TC.Accessor == nil {
+		src.TC.Accessor = cdom.NewSourceAccessor()
+		src.TC.Accessor.Source = cdom.RefId(src.Array.Id)
+	}
+	src.TC.Accessor.Offset, src.TC.Accessor.Stride, src.TC.Accessor.Count = 0, 1, uint64(len(weights))
+	if len(src.TC.Accessor.Params) != 1 {
+		param := &cdom.Param{Type: "float"}
+		param.Name = "WEIGHT"
+		src.TC.Accessor.Params = []*cdom.Param{param}
+	}
+	return
+}
+
+type skinCornersByWeight struct {
+	corners [][]uint64
+	vw      *vertexWeights
+}
+
+func (me skinCornersByWeight) Len() int { return len(me.corners) }
+
+func (me skinCornersByWeight) Less(i, j int) bool {
+	return me.vw.weights[me.corners[i][me.vw.weightOff]] > me.vw.weights[me.corners[j][me.vw.weightOff]]
+}
+
+func (me skinCornersByWeight) Swap(i, j int) {
+	me.corners[i], me.corners[j] = me.corners[j], me.corners[i]
+}
+
+//	The VertexWeights of a ControllerSkin, as read by readVertexWeights().
+type vertexWeights struct {
+	//	the Source referred to by the "WEIGHT" Input, and its values
+	source  *cdom.Source
+	weights []float64
+
+	//	the offsets of the "JOINT" and "WEIGHT" Inputs
+	jointOff, weightOff uint64
+
+	//	per vertex, the Indices of each of its influences
+	corners [][][]uint64
+}
+
+func readVertexWeights(skin *cdom.ControllerSkin) (me *vertexWeights, err error) {
+	var hasJoint bool
+	me = &vertexWeights{}
+	vw := &skin.VertexWeights
+	for _, in := range vw.Inputs {
+		switch in.Semantic {
+		case "JOINT":
+			me.jointOff, hasJoint = in.Offset, true
+		case "WEIGHT":
+			if me.source = skin.Sources[in.Source.S()]; me.source == nil {
+				err = fmt.Errorf("no weights source '%s'", in.Source)
+			} else if me.weights, err = me.source.Floats(); err == nil {
+				me.weightOff = in.Offset
+			}
+		}
+		if err != nil {
+			me = nil
+			return
+		}
+	}
+	if !(hasJoint && (me.source != nil)) {
+		me, err = nil, fmt.Errorf("vertex weights need both JOINT and WEIGHT inputs")
+		return
+	}
+	ind, stride := vw.Indices, vw.Stride()
+	me.corners = make([][][]uint64, len(vw.Vcount))
+	for v, vc := range vw.Vcount {
+		for i := int64(0); i < vc; i++ {
+			if uint64(len(ind)) < stride {
+				me, err = nil, fmt.Errorf("too few vertex weight indices for %d vertices", len(vw.Vcount))
+				return
+			} else if w := ind[me.weightOff]; w >= uint64(len(me.weights)) {
+				me, err = nil, fmt.Errorf("weight index %d out of range", w)
+				return
+			}
+			me.corners[v] = append(me.corners[v], ind[:stride])
+			ind = ind[stride:]
+		}
+	}
+	return
+}
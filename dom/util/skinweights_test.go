@@ -0,0 +1,52 @@
+package cdomutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+func TestSkinNormalizeWeights(t *testing.T) {
+	//	vertex 0 has three influences, vertex 1 one below epsilon, and vertex 2 only one below epsilon
+	reg := testImport(t, testDocHead+`<library_controllers><controller id="c"><skin source="#g">
+<source id="jn"><Name_array id="jn-a" count="3">j0 j1 j2</Name_array><technique_common><accessor source="#jn-a" count="3"><param name="JOINT" type="name"/></accessor></technique_common></source>
+<source id="w"><float_array id="w-a" count="6">0.1 0.5 0.3 0.005 0.2 0.001</float_array><technique_common><accessor source="#w-a" count="6"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<joints><input semantic="JOINT" source="#jn"/></joints>
+<vertex_weights count="3"><input semantic="JOINT" source="#jn" offset="0"/><input semantic="WEIGHT" source="#w" offset="1"/><vcount>3 2 1</vcount><v>0 0 1 1 2 2 0 3 2 4 1 5</v></vertex_weights>
+</skin></controller></library_controllers>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="9">0 0 0 1 0 0 2 0 0</float_array><technique_common><accessor source="#pos-a" count="3" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+</COLLADA>`).Registry
+	nearly := func(a, b []float64) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !testNearly(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	skin := reg.ControllerDefs.M["c"].Skin
+	packed, err := cdomutil.SkinNormalizeWeights(skin, 2, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{2, 1, 0}; !reflect.DeepEqual(skin.VertexWeights.Vcount, want) {
+		t.Errorf("got vcount %v, want %v", skin.VertexWeights.Vcount, want)
+	}
+	if want := []uint64{1, 0, 2, 1, 2, 2}; !reflect.DeepEqual(skin.VertexWeights.Indices, want) {
+		t.Errorf("got indices %v, want %v", skin.VertexWeights.Indices, want)
+	}
+	src := skin.Sources["w"]
+	if want := []float64{0.625, 0.375, 1}; !nearly(src.Array.Floats, want) || (src.TC.Accessor.Count != 3) {
+		t.Errorf("got weights %v (accessor count %d), want %v", src.Array.Floats, src.TC.Accessor.Count, want)
+	}
+	if (packed.Width != 2) || !reflect.DeepEqual(packed.Joints, []uint64{1, 2, 2, 0, 0, 0}) || !nearly(packed.Weights, []float64{0.625, 0.375, 1, 0, 0, 0}) {
+		t.Errorf("got packed %+v, want a width of 2 padded with joint 0 and weight 0", packed)
+	}
+}
@@ -0,0 +1,40 @@
+package cdomutil
+
+import (
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Creates and returns a new cdom.Source with the specified Id, containing the specified floats and
+//	a SourceAccessor that reads them in elements of one "float" Param per each of the specified paramNames
+//	(such as "X", "Y", "Z"). The Id of its SourceArray is id + "-array".
+func NewSourceFloats(id string, floats []float64, paramNames ...string) (me *cdom.Source) {
+	me = newSource(id, "float", len(floats), paramNames)
+	me.Array.Floats = floats
+	return
+}
+
+//	Creates and returns a new cdom.Source with the specified Id, containing the specified names and
+//	a SourceAccessor that reads them in elements of one "name" Param per each of the specified paramNames
+//	(such as "INTERPOLATION" or "JOINT"). The Id of its SourceArray is id + "-array".
+func NewSourceNames(id string, names []string, paramNames ...string) (me *cdom.Source) {
+	me = newSource(id, "name", len(names), paramNames)
+	me.Array.Names = names
+	return
+}
+
+func newSource(id, paramType string, length int, paramNames []string) (me *cdom.Source) {
+	me = &cdom.Source{}
+	me.Id, me.Array.Id = id, id+"-array"
+	me.TC.Accessor = cdom.NewSourceAccessor()
+	me.TC.Accessor.Source = cdom.RefId(me.Array.Id)
+	if len(paramNames) > 0 {
+		me.TC.Accessor.Stride = uint64(len(paramNames))
+	}
+	me.TC.Accessor.Count = uint64(length) / me.TC.Accessor.Stride
+	for _, name := range paramNames {
+		param := &cdom.Param{Type: paramType}
+		param.Name = name
+		me.TC.Accessor.Params = append(me.TC.Accessor.Params, param)
+	}
+	return
+}
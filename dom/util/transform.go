@@ -0,0 +1,212 @@
+package cdomutil
+
+import (
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	One occurrence of a NodeDef in the scene graph of a VisualSceneDef, as returned by VisualSceneNodes().
+//	A NodeDef instantiated via several NodeInsts occurs several times, with different World matrices.
+type SceneNode struct {
+	//	The node definition.
+	Node *cdom.NodeDef
+
+	//	The NodeInst through which Node was instantiated, or nil if Node is defined inline.
+	Inst *cdom.NodeInst
+
+	//	The parent occurrence, or nil if Node is one of the VisualSceneDef's root Nodes.
+	Parent *SceneNode
+
+	//	The product of all Node.Transforms, as per TransformsMatrix().
+	Local cdom.Float4x4
+
+	//	The product of Parent.World (if any) and Local.
+	World cdom.Float4x4
+
+	//	The inverse-transpose of World, for transforming normals (its fourth row and column are
+	//	those of the identity matrix). If World is singular, equals World in that respect instead.
+	Normal cdom.Float4x4
+}
+
+//	Recomputes me.Local, me.World and me.Normal from the current Transforms of me.Node and the current
+//	World of me.Parent, such as after animating them. To update a whole scene graph, call Update()
+//	on all SceneNodes in the order returned by VisualSceneNodes(), so that parents precede children.
+func (me *SceneNode) Update() {
+	if me.Local = TransformsMatrix(me.Node.Transforms); me.Parent != nil {
+		me.World = Float4x4Mul(&me.Parent.World, &me.Local)
+	} else {
+		me.World = me.Local
+	}
+	me.Normal = Float4x4NormalMatrix(&me.World)
+}
+
+//	Returns the identity matrix.
+func Float4x4Identity() (m cdom.Float4x4) {
+	m[0], m[5], m[10], m[15] = 1, 1, 1, 1
+	return
+}
+
+//	Returns the inverse of m, and whether m is invertible at all (if not, inv is the zero matrix).
+func Float4x4Inverse(m *cdom.Float4x4) (inv cdom.Float4x4, ok bool) {
+	inv[0] = m[5]*m[10]*m[15] - m[5]*m[11]*m[14] - m[9]*m[6]*m[15] + m[9]*m[7]*m[14] + m[13]*m[6]*m[11] - m[13]*m[7]*m[10]
+	inv[4] = -m[4]*m[10]*m[15] + m[4]*m[11]*m[14] + m[8]*m[6]*m[15] - m[8]*m[7]*m[14] - m[12]*m[6]*m[11] + m[12]*m[7]*m[10]
+	inv[8] = m[4]*m[9]*m[15] - m[4]*m[11]*m[13] - m[8]*m[5]*m[15] + m[8]*m[7]*m[13] + m[12]*m[5]*m[11] - m[12]*m[7]*m[9]
+	inv[12] = -m[4]*m[9]*m[14] + m[4]*m[10]*m[13] + m[8]*m[5]*m[14] - m[8]*m[6]*m[13] - m[12]*m[5]*m[10] + m[12]*m[6]*m[9]
+	inv[1] = -m[1]*m[10]*m[15] + m[1]*m[11]*m[14] + m[9]*m[2]*m[15] - m[9]*m[3]*m[14] - m[13]*m[2]*m[11] + m[13]*m[3]*m[10]
+	inv[5] = m[0]*m[10]*m[15] - m[0]*m[11]*m[14] - m[8]*m[2]*m[15] + m[8]*m[3]*m[14] + m[12]*m[2]*m[11] - m[12]*m[3]*m[10]
+	inv[9] = -m[0]*m[9]*m[15] + m[0]*m[11]*m[13] + m[8]*m[1]*m[15] - m[8]*m[3]*m[13] - m[12]*m[1]*m[11] + m[12]*m[3]*m[9]
+	inv[13] = m[0]*m[9]*m[14] - m[0]*m[10]*m[13] - m[8]*m[1]*m[14] + m[8]*m[2]*m[13] + m[12]*m[1]*m[10] - m[12]*m[2]*m[9]
+	inv[2] = m[1]*m[6]*m[15] - m[1]*m[7]*m[14] - m[5]*m[2]*m[15] + m[5]*m[3]*m[14] + m[13]*m[2]*m[7] - m[13]*m[3]*m[6]
+	inv[6] = -m[0]*m[6]*m[15] + m[0]*m[7]*m[14] + m[4]*m[2]*m[15] - m[4]*m[3]*m[14] - m[12]*m[2]*m[7] + m[12]*m[3]*m[6]
+	inv[10] = m[0]*m[5]*m[15] - m[0]*m[7]*m[13] - m[4]*m[1]*m[15] + m[4]*m[3]*m[13] + m[12]*m[1]*m[7] - m[12]*m[3]*m[5]
+	inv[14] = -m[0]*m[5]*m[14] + m[0]*m[6]*m[13] + m[4]*m[1]*m[14] - m[4]*m[2]*m[13] - m[12]*m[1]*m[6] + m[12]*m[2]*m[5]
+	inv[3] = -m[1]*m[6]*m[11] + m[1]*m[7]*m[10] + m[5]*m[2]*m[11] - m[5]*m[3]*m[10] - m[9]*m[2]*m[7] + m[9]*m[3]*m[6]
+	inv[7] = m[0]*m[6]*m[11] - m[0]*m[7]*m[10] - m[4]*m[2]*m[11] + m[4]*m[3]*m[10] + m[8]*m[2]*m[7] - m[8]*m[3]*m[6]
+	inv[11] = -m[0]*m[5]*m[11] + m[0]*m[7]*m[9] + m[4]*m[1]*m[11] - m[4]*m[3]*m[9] - m[8]*m[1]*m[7] + m[8]*m[3]*m[5]
+	inv[15] = m[0]*m[5]*m[10] - m[0]*m[6]*m[9] - m[4]*m[1]*m[10] + m[4]*m[2]*m[9] + m[8]*m[1]*m[6] - m[8]*m[2]*m[5]
+	if det := m[0]*inv[0] + m[1]*inv[4] + m[2]*inv[8] + m[3]*inv[12]; det != 0 {
+		for i := range inv {
+			inv[i] /= det
+		}
+		ok = true
+	} else {
+		inv = cdom.Float4x4{}
+	}
+	return
+}
+
+//	Returns the matrix product a * b.
+func Float4x4Mul(a, b *cdom.Float4x4) (m cdom.Float4x4) {
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			m[r*4+c] = a[r*4]*b[c] + a[r*4+1]*b[4+c] + a[r*4+2]*b[8+c] + a[r*4+3]*b[12+c]
+		}
+	}
+	return
+}
+
+//	Returns the matrix for transforming normals by m: the inverse-transpose of the upper-left 3x3 of m,
+//	with the fourth row and column of the identity matrix. If m is singular, returns m in that respect instead.
+func Float4x4NormalMatrix(m *cdom.Float4x4) (n cdom.Float4x4) {
+	n = Float4x4Identity()
+	a0, a1, a2 := [3]float64{m[0], m[1], m[2]}, [3]float64{m[4], m[5], m[6]}, [3]float64{m[8], m[9], m[10]}
+	cof := [3][3]float64{vcross(a1, a2), vcross(a2, a0), vcross(a0, a1)}
+	det := vdot(a0, cof[0])
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			if det != 0 {
+				n[r*4+c] = cof[r][c] / det
+			} else {
+				n[r*4+c] = m[r*4+c]
+			}
+		}
+	}
+	return
+}
+
+//	Returns the transpose of m.
+func Float4x4Transpose(m *cdom.Float4x4) (t cdom.Float4x4) {
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			t[c*4+r] = m[r*4+c]
+		}
+	}
+	return
+}
+
+//	Returns the 4x4 matrix that t represents. All matrices are row-major, as in Collada documents, and meant
+//	to transform column vectors. The matrix of a TransformKindLookat positions an object at the eye position,
+//	with its -Z axis facing the interest point and its +Y axis facing up. The matrix of a TransformKindSkew
+//	moves every point along the translation axis by the tangent of the angle, multiplied with the extent of the
+//	point along the rotation axis (hence, both axes are meant to be perpendicular). If t.F has fewer values
+//	than t.Kind requires, returns the identity matrix.
+func TransformMatrix(t *cdom.Transform) (m cdom.Float4x4) {
+	m, f := Float4x4Identity(), t.F
+	switch t.Kind {
+	case cdom.TransformKindLookat:
+		if len(f) >= 9 {
+			eye, fwd, up := [3]float64{f[0], f[1], f[2]}, vnorm([3]float64{f[3] - f[0], f[4] - f[1], f[5] - f[2]}), [3]float64{f[6], f[7], f[8]}
+			side := vnorm(vcross(fwd, up))
+			up = vcross(side, fwd)
+			for r := 0; r < 3; r++ {
+				m[r*4], m[r*4+1], m[r*4+2], m[r*4+3] = side[r], up[r], -fwd[r], eye[r]
+			}
+		}
+	case cdom.TransformKindMatrix:
+		if len(f) >= 16 {
+			copy(m[:], f)
+		}
+	case cdom.TransformKindRotate:
+		if len(f) >= 4 {
+			a := vnorm([3]float64{f[0], f[1], f[2]})
+			s, c := math.Sincos(f[3] * math.Pi / 180)
+			x, y, z, t := a[0], a[1], a[2], 1-c
+			m[0], m[1], m[2] = t*x*x+c, t*x*y-s*z, t*x*z+s*y
+			m[4], m[5], m[6] = t*x*y+s*z, t*y*y+c, t*y*z-s*x
+			m[8], m[9], m[10] = t*x*z-s*y, t*y*z+s*x, t*z*z+c
+		}
+	case cdom.TransformKindScale:
+		if len(f) >= 3 {
+			m[0], m[5], m[10] = f[0], f[1], f[2]
+		}
+	case cdom.TransformKindSkew:
+		if len(f) >= 7 {
+			s, rot, trans := math.Tan(f[0]*math.Pi/180), vnorm([3]float64{f[1], f[2], f[3]}), vnorm([3]float64{f[4], f[5], f[6]})
+			for r := 0; r < 3; r++ {
+				for c := 0; c < 3; c++ {
+					m[r*4+c] += s * trans[r] * rot[c]
+				}
+			}
+		}
+	case cdom.TransformKindTranslate:
+		if len(f) >= 3 {
+			m[3], m[7], m[11] = f[0], f[1], f[2]
+		}
+	}
+	return
+}
+
+//	Returns the product of the matrices of all transforms, in order, as per TransformMatrix().
+//	This folds the transformation stack of a NodeDef, as in TransformsMatrix(node.Transforms).
+func TransformsMatrix(transforms []*cdom.Transform) (m cdom.Float4x4) {
+	m = Float4x4Identity()
+	for _, t := range transforms {
+		tm := TransformMatrix(t)
+		m = Float4x4Mul(&m, &tm)
+	}
+	return
+}
+
+//	Walks the scene graph of vs depth-first, following all NodeInsts (resolved via their EnsureDef(reg) method),
+//	and returns every occurrence of every node in it, parents before children. A NodeInst that refers to one of
+//	its own ancestor nodes would lead to an endless hierarchy: it is not followed, but returned in cycles instead.
+//	NodeInsts that cannot be resolved are skipped.
+func VisualSceneNodes(vs *cdom.VisualSceneDef, reg *cdom.Registry) (nodes []*SceneNode, cycles []*cdom.NodeInst) {
+	path := map[*cdom.NodeDef]bool{}
+	var walk func(*cdom.NodeDef, *cdom.NodeInst, *SceneNode)
+	walk = func(node *cdom.NodeDef, inst *cdom.NodeInst, parent *SceneNode) {
+		sn := &SceneNode{Node: node, Inst: inst, Parent: parent}
+		sn.Update()
+		nodes = append(nodes, sn)
+		path[node] = true
+		for _, cn := range node.Nodes {
+			if cn.Def != nil {
+				walk(cn.Def, nil, sn)
+			} else if cn.Inst != nil {
+				if def := cn.Inst.EnsureDef(reg); def == nil {
+					continue
+				} else if path[def] {
+					cycles = append(cycles, cn.Inst)
+				} else {
+					walk(def, cn.Inst, sn)
+				}
+			}
+		}
+		delete(path, node)
+	}
+	for _, node := range vs.Nodes {
+		walk(node, nil, nil)
+	}
+	return
+}
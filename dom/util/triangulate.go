@@ -0,0 +1,304 @@
+package cdomutil
+
+import (
+	"math"
+	"sort"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Converts all GeometryPrimitiveKindPolygons, GeometryPrimitiveKindPolylist, GeometryPrimitiveKindTrifans and
+//	GeometryPrimitiveKindTristrips primitives in mesh.Primitives into GeometryPrimitiveKindTriangles, in-place.
+//	Polygons (as delimited by Vcount, if any) are ear-clipped in the plane of their POSITION values, so concave
+//	polygons are triangulated correctly, and the Holes of all PolyHoles are bridged into their outer polygon
+//	beforehand. If no POSITION values can be found for a polygon, it is triangulated as a fan instead.
+//	Triangles keep the winding order of the polygon, fan or strip they were created from, and every
+//	triangle corner keeps all the indices of its original corner, so all InputShared offsets and sets
+//	remain valid as-is. Degenerate triangles (such as those stitching tristrips together) are dropped.
+//	Afterwards, Count is the number of triangles, and Vcount and PolyHoles are nil.
+//	GeometryPrimitiveKindLines and GeometryPrimitiveKindLineStrips primitives are left untouched.
+func GeometryTriangulate(mesh *cdom.GeometryMesh) {
+	for _, prim := range mesh.Primitives {
+		switch prim.Kind {
+		case cdom.GeometryPrimitiveKindPolygons, cdom.GeometryPrimitiveKindPolylist, cdom.GeometryPrimitiveKindTrifans, cdom.GeometryPrimitiveKindTristrips:
+			triangulate(mesh, prim)
+		}
+	}
+}
+
+func triangulate(mesh *cdom.GeometryMesh, prim *cdom.GeometryPrimitives) {
+	if stride := prim.Stride(); stride > 0 {
+		tri := &triangulator{stride: stride, pos: compileInputs(mesh, prim, layoutPositions)[0]}
+		tri.primitives(prim)
+		prim.Kind, prim.Count, prim.Indices, prim.Vcount, prim.PolyHoles = cdom.GeometryPrimitiveKindTriangles, tri.count, tri.indices, nil, nil
+	}
+}
+
+type triangulator struct {
+	pos           compileInput
+	stride, count uint64
+	indices       []uint64
+}
+
+func (me *triangulator) emit(c0, c1, c2 []uint64) {
+	if !(equalCorners(c0, c1) || equalCorners(c1, c2) || equalCorners(c2, c0)) {
+		me.indices = append(append(append(me.indices, c0...), c1...), c2...)
+		me.count++
+	}
+}
+
+func (me *triangulator) fan(corners [][]uint64) {
+	for i := 2; i < len(corners); i++ {
+		me.emit(corners[0], corners[i-1], corners[i])
+	}
+}
+
+func (me *triangulator) polygon(outer [][]uint64, holes [][][]uint64) {
+	var (
+		ring, hole []triVertex
+		normal     [3]float64
+		ok         bool
+	)
+	if (len(outer) == 3) && (len(holes) == 0) {
+		me.emit(outer[0], outer[1], outer[2])
+		return
+	}
+	pos := make([][3]float64, len(outer))
+	for i, c := range outer {
+		if ok = me.pos.read(c, pos[i][:]); !ok {
+			me.fan(outer)
+			return
+		}
+	}
+	normal = newell(pos)
+	axis := 0
+	for i := 1; i < 3; i++ {
+		if math.Abs(normal[i]) > math.Abs(normal[axis]) {
+			axis = i
+		}
+	}
+	if normal[axis] == 0 {
+		me.fan(outer)
+		return
+	}
+	//	project onto the plane most perpendicular to the normal, such that outer runs counter-clockwise
+	u, v, flip := (axis+1)%3, (axis+2)%3, normal[axis] < 0
+	project := func(c []uint64, p [3]float64) (tv triVertex) {
+		if tv.c, tv.x, tv.y = c, p[u], p[v]; flip {
+			tv.y = -tv.y
+		}
+		return
+	}
+	ring = make([]triVertex, len(outer))
+	for i, c := range outer {
+		ring[i] = project(c, pos[i])
+	}
+	var bridged [][]triVertex
+	for _, hc := range holes {
+		if hole = make([]triVertex, 0, len(hc)); len(hc) >= 3 {
+			for _, c := range hc {
+				var p [3]float64
+				if ok = me.pos.read(c, p[:]); !ok {
+					break
+				}
+				hole = append(hole, project(c, p))
+			}
+			if ok {
+				if area(hole) > 0 {
+					for i, j := 0, len(hole)-1; i < j; i, j = i+1, j-1 {
+						hole[i], hole[j] = hole[j], hole[i]
+					}
+				}
+				bridged = append(bridged, hole)
+			}
+		}
+	}
+	//	holes are bridged right-most first, so that earlier bridges cannot cross later holes
+	sort.Sort(triHolesByMaxX(bridged))
+	for _, h := range bridged {
+		ring = bridge(ring, h)
+	}
+	me.earClip(ring)
+}
+
+func (me *triangulator) primitives(prim *cdom.GeometryPrimitives) {
+	each := me.fan
+	switch prim.Kind {
+	case cdom.GeometryPrimitiveKindPolygons, cdom.GeometryPrimitiveKindPolylist:
+		each = func(corners [][]uint64) {
+			me.polygon(corners, nil)
+		}
+	case cdom.GeometryPrimitiveKindTristrips:
+		each = me.strip
+	}
+	eachVcount(splitCorners(prim.Indices, me.stride), prim.Vcount, each)
+	if prim.Kind == cdom.GeometryPrimitiveKindPolygons {
+		for _, ph := range prim.PolyHoles {
+			if outer := splitCorners(ph.Indices, me.stride); len(outer) >= 3 {
+				holes := make([][][]uint64, 0, len(ph.Holes))
+				for _, h := range ph.Holes {
+					holes = append(holes, splitCorners(h, me.stride))
+				}
+				me.polygon(outer, holes)
+			}
+		}
+	}
+}
+
+func (me *triangulator) strip(corners [][]uint64) {
+	for i := 2; i < len(corners); i++ {
+		if (i % 2) == 0 {
+			me.emit(corners[i-2], corners[i-1], corners[i])
+		} else {
+			me.emit(corners[i-1], corners[i-2], corners[i])
+		}
+	}
+}
+
+//	Clips ears off ring (running counter-clockwise) until only one triangle remains.
+func (me *triangulator) earClip(ring []triVertex) {
+	n := len(ring)
+	prev, next := make([]int, n), make([]int, n)
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for i, tv := range ring {
+		prev[i], next[i] = (i+n-1)%n, (i+1)%n
+		minX, minY, maxX, maxY = math.Min(minX, tv.x), math.Min(minY, tv.y), math.Max(maxX, tv.x), math.Max(maxY, tv.y)
+	}
+	eps := 1e-12 * math.Max((maxX-minX)*(maxX-minX), (maxY-minY)*(maxY-minY))
+	isEar := func(i int) bool {
+		a, b, c := &ring[prev[i]], &ring[i], &ring[next[i]]
+		if cross(a, b, c) <= eps {
+			return false
+		}
+		for j := next[next[i]]; j != prev[i]; j = next[j] {
+			if p := &ring[j]; !(p.same(a) || p.same(b) || p.same(c)) && (cross(a, b, p) >= 0) && (cross(b, c, p) >= 0) && (cross(c, a, p) >= 0) {
+				return false
+			}
+		}
+		return true
+	}
+	clip := func(i int, emit bool) {
+		if emit {
+			me.emit(ring[prev[i]].c, ring[i].c, ring[next[i]].c)
+		}
+		next[prev[i]], prev[next[i]] = next[i], prev[i]
+		n--
+	}
+	for i, stalled := 0, 0; n > 3; {
+		if isEar(i) {
+			clip(i, true)
+			i, stalled = next[i], 0
+		} else if i, stalled = next[i], stalled+1; stalled > n {
+			//	no ear left (the polygon is self-intersecting or has collinear edges):
+			//	drop a collinear vertex if there is one, otherwise clip any vertex
+			j := i
+			for k := 0; k < n; k, j = k+1, next[j] {
+				if math.Abs(cross(&ring[prev[j]], &ring[j], &ring[next[j]])) <= eps {
+					break
+				}
+			}
+			clip(j, math.Abs(cross(&ring[prev[j]], &ring[j], &ring[next[j]])) > eps)
+			i, stalled = next[j], 0
+		}
+	}
+	for i := range ring {
+		if next[prev[i]] == i {
+			me.emit(ring[prev[i]].c, ring[i].c, ring[next[i]].c)
+			break
+		}
+	}
+}
+
+func area(ring []triVertex) (a float64) {
+	for i := range ring {
+		j := (i + 1) % len(ring)
+		a += ring[i].x*ring[j].y - ring[j].x*ring[i].y
+	}
+	return a / 2
+}
+
+//	Connects hole (running clockwise) to ring via a pair of coincident edges between the
+//	right-most vertex of hole and a vertex of ring visible from it, as per David Eberly's
+//	"Triangulation by Ear Clipping".
+func bridge(ring, hole []triVertex) []triVertex {
+	hi := 0
+	for i := range hole {
+		if hole[i].x > hole[hi].x {
+			hi = i
+		}
+	}
+	m, ri, ix := &hole[hi], -1, math.Inf(1)
+	for i := range ring {
+		a, b := &ring[i], &ring[(i+1)%len(ring)]
+		if (a.y != b.y) && (((a.y <= m.y) && (b.y >= m.y)) || ((a.y >= m.y) && (b.y <= m.y))) {
+			if x := a.x + (m.y-a.y)*(b.x-a.x)/(b.y-a.y); (x >= m.x) && (x < ix) {
+				if ix, ri = x, i; b.x > a.x {
+					ri = (i + 1) % len(ring)
+				}
+			}
+		}
+	}
+	if ri < 0 {
+		//	the hole is not inside ring
+		return ring
+	}
+	//	any ring vertex inside the triangle (m, intersection, ring[ri]) would hide ring[ri] from m:
+	//	pick the one forming the smallest angle with the ray from m instead
+	p, best, angle := triVertex{x: ix, y: m.y}, ri, math.Inf(1)
+	for j := range ring {
+		if q := &ring[j]; (j != ri) && (q.x >= m.x) && !q.same(&ring[ri]) && inTriangle(m, &p, &ring[ri], q) {
+			if a := math.Atan2(math.Abs(q.y-m.y), q.x-m.x); a < angle {
+				best, angle = j, a
+			}
+		}
+	}
+	bridged := make([]triVertex, 0, len(ring)+len(hole)+2)
+	bridged = append(bridged, ring[:best+1]...)
+	bridged = append(bridged, hole[hi:]...)
+	bridged = append(bridged, hole[:hi+1]...)
+	bridged = append(bridged, ring[best:]...)
+	return bridged
+}
+
+func cross(a, b, c *triVertex) float64 {
+	return (b.x-a.x)*(c.y-b.y) - (b.y-a.y)*(c.x-b.x)
+}
+
+func equalCorners(c0, c1 []uint64) bool {
+	for i := range c0 {
+		if c0[i] != c1[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func inTriangle(a, b, c, p *triVertex) bool {
+	d1, d2, d3 := cross(a, b, p), cross(b, c, p), cross(c, a, p)
+	return !(((d1 < 0) || (d2 < 0) || (d3 < 0)) && ((d1 > 0) || (d2 > 0) || (d3 > 0)))
+}
+
+type triVertex struct {
+	x, y float64
+	c    []uint64
+}
+
+func (me *triVertex) same(tv *triVertex) bool {
+	return (me.x == tv.x) && (me.y == tv.y)
+}
+
+type triHolesByMaxX [][]triVertex
+
+func (me triHolesByMaxX) Len() int { return len(me) }
+
+func (me triHolesByMaxX) Less(i, j int) bool { return ringMaxX(me[i]) > ringMaxX(me[j]) }
+
+func (me triHolesByMaxX) Swap(i, j int) { me[i], me[j] = me[j], me[i] }
+
+func ringMaxX(ring []triVertex) (x float64) {
+	x = math.Inf(-1)
+	for i := range ring {
+		x = math.Max(x, ring[i].x)
+	}
+	return
+}
@@ -0,0 +1,277 @@
+package cdom
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//	Categorizes a ValidationFinding.
+type ValidationKind int
+
+const (
+	//	Not exactly one of a set of mutually exclusive fields is set, such as the Def and Inst of a ChildNode,
+	//	the Brep, Mesh and Spline of a GeometryDef, or the Morph and Skin of a ControllerDef.
+	ValidationOneOf ValidationKind = iota
+
+	//	More than one of the slices of a SourceArray is non-empty.
+	ValidationSourceArray
+
+	//	A GeometryPrimitives has PolyHoles but is not of GeometryPrimitiveKindPolygons.
+	ValidationPolyHoles
+
+	//	An Id is used by more than one object, across all libraries.
+	ValidationDuplicateId
+
+	//	A RefId (such as the DefRef of a FooInst, or the Source of an Input) refers to an Id that does not exist.
+	ValidationDanglingRefId
+
+	//	A RefSid cannot be resolved.
+	ValidationUnresolvedRefSid
+
+	//	The number of Indices disagrees with the Stride, Vcount or Count of their IndexedInputs.
+	ValidationIndexCount
+
+	//	An index exceeds the number of elements of the Source that its Input refers to.
+	ValidationIndexRange
+
+	//	A SourceAccessor reads beyond the end of its SourceArray, as per Source.Validate().
+	ValidationAccessorOverrun
+)
+
+//	Returns the name of me without the "Validation" prefix, such as "OneOf" or "DuplicateId".
+func (me ValidationKind) String() string {
+	switch me {
+	case ValidationOneOf:
+		return "OneOf"
+	case ValidationSourceArray:
+		return "SourceArray"
+	case ValidationPolyHoles:
+		return "PolyHoles"
+	case ValidationDuplicateId:
+		return "DuplicateId"
+	case ValidationDanglingRefId:
+		return "DanglingRefId"
+	case ValidationUnresolvedRefSid:
+		return "UnresolvedRefSid"
+	case ValidationIndexCount:
+		return "IndexCount"
+	case ValidationIndexRange:
+		return "IndexRange"
+	case ValidationAccessorOverrun:
+		return "AccessorOverrun"
+	}
+	return fmt.Sprintf("ValidationKind(%d)", int(me))
+}
+
+//	A single violation of a Collada 1.5 rule, as returned by Document.Validate().
+type ValidationFinding struct {
+	//	Which rule is violated.
+	Kind ValidationKind
+
+	//	The Id of the nearest resource definition containing Obj, or empty if Obj is not contained in one.
+	DefId string
+
+	//	The offending object, such as a *ChildNode, *GeometryPrimitives, *Source, *RefId or *RefSid.
+	Obj interface{}
+
+	//	Describes the issue.
+	Msg string
+}
+
+//	Returns a human-readable single-line representation of me.
+func (me *ValidationFinding) String() (s string) {
+	if s = me.Kind.String(); len(me.DefId) > 0 {
+		s += fmt.Sprintf(" in '%s'", me.DefId)
+	}
+	return s + ": " + me.Msg
+}
+
+type validator struct {
+	reg      *Registry
+	findings []*ValidationFinding
+	ids      map[string][]interface{}
+	hasIds   map[*HasId]bool
+	refIds   []validatorRef
+}
+
+type validatorRef struct {
+	ref   *RefId
+	defId string
+}
+
+//	Checks me and all resource definitions of me.Libs() against the rules of Collada 1.5 that the types of this
+//	package document but do not enforce, without modifying anything, and returns all violations found, in no
+//	particular order. See the ValidationKind enumerated constants for the rules being checked. RefIds that refer to
+//	other documents (such as "other.dae#some-id") are not checked, and RefSids are resolved as per ResolveAll().
+func (me *Document) Validate() (findings []*ValidationFinding) {
+	v := &validator{reg: me.Libs(), ids: map[string][]interface{}{}, hasIds: map[*HasId]bool{}}
+	walkDocument(me, v.visit)
+	for _, ref := range v.refIds {
+		if s := ref.ref.S(); (len(s) > 0) && !strings.ContainsAny(s, "#/:") && (len(v.ids[s]) == 0) {
+			v.add(ValidationDanglingRefId, ref.defId, ref.ref, "no object with Id '%s'", s)
+		}
+	}
+	for id, objs := range v.ids {
+		if len(objs) > 1 {
+			for _, obj := range objs {
+				v.add(ValidationDuplicateId, "", obj, "Id '%s' is used by %d objects", id, len(objs))
+			}
+		}
+	}
+	findings = v.findings
+	return
+}
+
+func (me *validator) add(kind ValidationKind, defId string, obj interface{}, msg string, args ...interface{}) {
+	me.findings = append(me.findings, &ValidationFinding{Kind: kind, DefId: defId, Obj: obj, Msg: fmt.Sprintf(msg, args...)})
+}
+
+func (me *validator) indexedInputs(defId string, obj interface{}, ii *IndexedInputs, sources Sources, vertexCount uint64, cornersPerPrim uint64) {
+	stride := ii.Stride()
+	if stride == 0 {
+		return
+	}
+	if (uint64(len(ii.Indices)) % stride) != 0 {
+		me.add(ValidationIndexCount, defId, obj, "%d indices are not a multiple of the stride %d", len(ii.Indices), stride)
+		return
+	}
+	corners := uint64(len(ii.Indices)) / stride
+	if len(ii.Vcount) > 0 {
+		var sum int64
+		for _, vc := range ii.Vcount {
+			sum += vc
+		}
+		if uint64(sum) != corners {
+			me.add(ValidationIndexCount, defId, obj, "vcount adds up to %d, but there are %d indices of stride %d", sum, len(ii.Indices), stride)
+		}
+	} else if (cornersPerPrim > 0) && ((ii.Count * cornersPerPrim) != corners) {
+		me.add(ValidationIndexCount, defId, obj, "count %d requires %d indices of stride %d, but there are %d", ii.Count, ii.Count*cornersPerPrim*stride, stride, len(ii.Indices))
+	}
+	for _, in := range ii.Inputs {
+		var count uint64
+		if in.Semantic == "VERTEX" {
+			count = vertexCount
+		} else if src := sources[in.Source.S()]; src != nil {
+			count = src.Count()
+		} else {
+			continue
+		}
+		for c := uint64(0); c < corners; c++ {
+			if i := ii.Indices[c*stride+in.Offset]; i >= count {
+				me.add(ValidationIndexRange, defId, obj, "%s index %d exceeds the %d elements of '%s'", in.Semantic, i, count, in.Source)
+				break
+			}
+		}
+	}
+}
+
+func (me *validator) oneOf(defId string, obj interface{}, names string, set ...bool) {
+	n := 0
+	for _, b := range set {
+		if b {
+			n++
+		}
+	}
+	if n != 1 {
+		me.add(ValidationOneOf, defId, obj, "%d of %s are set, but exactly 1 must be", n, names)
+	}
+}
+
+//	Like oneOf(), for all pointer fields of the struct elem.
+func (me *validator) oneOfFields(defId string, obj interface{}, elem interface{}) {
+	rv := reflect.ValueOf(elem)
+	names, set := make([]string, 0, rv.NumField()), make([]bool, 0, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		if rv.Field(i).Kind() == reflect.Ptr {
+			names, set = append(names, rv.Type().Field(i).Name), append(set, !rv.Field(i).IsNil())
+		}
+	}
+	me.oneOf(defId, obj, strings.Join(names, ", "), set...)
+}
+
+func (me *validator) visit(obj interface{}, root RefSidRoot, defId string) bool {
+	if rv := reflect.ValueOf(obj).Elem(); rv.Kind() == reflect.Struct {
+		if hid := rv.FieldByName("HasId"); hid.IsValid() && (hid.Type() == typeHasId) && hid.CanAddr() {
+			if h := hid.Addr().Interface().(*HasId); (len(h.Id) > 0) && !me.hasIds[h] {
+				me.hasIds[h] = true
+				me.ids[h.Id] = append(me.ids[h.Id], obj)
+			}
+		}
+	}
+	switch o := obj.(type) {
+	case *RefId:
+		me.refIds = append(me.refIds, validatorRef{ref: o, defId: defId})
+	case *RefSid:
+		if len(o.S) > 0 {
+			rs := RefSid{S: o.S}
+			if root = sidRoot(me.reg, &rs, root); root != nil {
+				rs.Resolve(root, true)
+			}
+			if _, err := rs.Path(); err != nil {
+				me.add(ValidationUnresolvedRefSid, defId, o, "%s", err.Error())
+			} else if rs.V == nil {
+				me.add(ValidationUnresolvedRefSid, defId, o, "cannot resolve '%s'", o.S)
+			}
+		}
+	case *ChildNode:
+		me.oneOf(defId, o, "Def, Inst", o.Def != nil, o.Inst != nil)
+	case *ControllerDef:
+		me.oneOf(defId, o, "Morph, Skin", o.Morph != nil, o.Skin != nil)
+	case *ControllerSkin:
+		var count uint64
+		for _, in := range o.VertexWeights.Inputs {
+			if src := o.Sources[in.Source.S()]; (src != nil) && (in.Semantic == "JOINT") {
+				count = src.Count()
+			}
+		}
+		me.indexedInputs(defId, o, &o.VertexWeights, o.Sources, count, 0)
+	case *GeometryBrepCurve:
+		me.oneOfFields(defId, o, o.Element)
+	case *GeometryBrepSurface:
+		me.oneOfFields(defId, o, o.Element)
+	case *GeometryDef:
+		me.oneOf(defId, o, "Brep, Mesh, Spline", o.Brep != nil, o.Mesh != nil, o.Spline != nil)
+	case *GeometryMesh:
+		var vertexCount uint64
+		if o.Vertices != nil {
+			for _, in := range o.Vertices.Inputs {
+				if src := o.Sources[in.Source.S()]; (src != nil) && (in.Semantic == "POSITION") {
+					vertexCount = src.Count()
+				}
+			}
+		}
+		for _, prim := range o.Primitives {
+			if (len(prim.PolyHoles) > 0) && (prim.Kind != GeometryPrimitiveKindPolygons) {
+				me.add(ValidationPolyHoles, defId, prim, "%d polygons with holes in primitives that are not polygons", len(prim.PolyHoles))
+			}
+			var cornersPerPrim uint64
+			switch prim.Kind {
+			case GeometryPrimitiveKindLines:
+				cornersPerPrim = 2
+			case GeometryPrimitiveKindTriangles:
+				cornersPerPrim = 3
+			}
+			me.indexedInputs(defId, prim, &prim.IndexedInputs, o.Sources, vertexCount, cornersPerPrim)
+		}
+	case *LightDef:
+		me.oneOfFields(defId, o, o.TC)
+	case *PxShape:
+		me.oneOfFields(defId, o, o.Geometry)
+	case *Source:
+		if err := o.Validate(); err != nil {
+			me.add(ValidationAccessorOverrun, defId, o, "%s", err.Error())
+		}
+	case *SourceArray:
+		var kinds []string
+		for i, n := range []int{len(o.Bools), len(o.Floats), len(o.IdRefs), len(o.Ints), len(o.Names), len(o.SidRefs), len(o.Tokens)} {
+			if n > 0 {
+				kinds = append(kinds, []string{"Bools", "Floats", "IdRefs", "Ints", "Names", "SidRefs", "Tokens"}[i])
+			}
+		}
+		if len(kinds) > 1 {
+			me.add(ValidationSourceArray, defId, o, "source array '%s' has %s", o.Id, strings.Join(kinds, " and "))
+		}
+	}
+	return true
+}
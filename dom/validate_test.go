@@ -0,0 +1,80 @@
+package cdom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDocumentValidate(t *testing.T) {
+	reg := NewRegistry()
+	doc := &Document{Registry: reg}
+	if findings := doc.Validate(); len(findings) > 0 {
+		t.Errorf("got findings %v for an empty document, want none", findings)
+	}
+
+	geo := reg.GeometryDefs.New("g")
+	geo.Mesh = NewGeometryMesh()
+	pos := &Source{}
+	pos.Id, pos.Array.Id, pos.Array.Floats, pos.Array.Ints = "pos", "pos-array", make([]float64, 9), []int64{1}
+	pos.TC.Accessor = &SourceAccessor{Count: 3, Stride: 3}
+	over := &Source{}
+	over.Id, over.Array.Id, over.Array.Floats = "over", "over-array", make([]float64, 9)
+	over.TC.Accessor = &SourceAccessor{Count: 4, Stride: 3}
+	geo.Mesh.Sources[pos.Id], geo.Mesh.Sources[over.Id] = pos, over
+	geo.Mesh.Vertices = &GeometryVertices{}
+	geo.Mesh.Vertices.Id = "v"
+	geo.Mesh.Vertices.Inputs = []*Input{&Input{Semantic: "POSITION", Source: "pos"}}
+	prim := &GeometryPrimitives{Kind: GeometryPrimitiveKindTriangles, PolyHoles: []*GeometryPolygonHole{&GeometryPolygonHole{}}}
+	prim.Count, prim.Indices = 1, []uint64{0, 1, 5}
+	prim.Inputs = []*InputShared{&InputShared{Input: Input{Semantic: "VERTEX", Source: "v"}}}
+	geo.Mesh.Primitives = []*GeometryPrimitives{prim}
+	reg.GeometryDefs.Add(geo)
+	reg.GeometryDefs.Add(reg.GeometryDefs.New("g2"))
+
+	node := reg.NodeDefs.New("g")
+	node.Nodes = []ChildNode{ChildNode{}}
+	inst := &GeometryInst{}
+	inst.DefRef = "missing"
+	node.Insts.Geometry = []*GeometryInst{inst}
+	reg.NodeDefs.Add(node)
+
+	anim := reg.AnimationDefs.New("a")
+	anim.Channels = []*AnimationChannel{&AnimationChannel{Target: *NewRefSid("g/nosuch.X")}}
+	reg.AnimationDefs.Add(anim)
+
+	kinds := map[ValidationKind]int{}
+	for _, f := range doc.Validate() {
+		kinds[f.Kind]++
+		switch f.Kind {
+		case ValidationPolyHoles, ValidationIndexRange:
+			if (f.Obj != prim) || (f.DefId != "g") {
+				t.Errorf("got %s on %T in '%s', want it on the triangles of 'g'", f, f.Obj, f.DefId)
+			}
+		case ValidationSourceArray:
+			if (f.Obj != &pos.Array) || (f.DefId != "g") {
+				t.Errorf("got %s on %T in '%s', want it on the array of 'pos'", f, f.Obj, f.DefId)
+			}
+		case ValidationAccessorOverrun:
+			if f.Obj != over {
+				t.Errorf("got %s on %T, want it on 'over'", f, f.Obj)
+			}
+		case ValidationDanglingRefId:
+			if (f.Obj != &inst.DefRef) || (f.DefId != "g") {
+				t.Errorf("got %s on %T in '%s', want it on the geometry instance", f, f.Obj, f.DefId)
+			}
+		}
+	}
+	want := map[ValidationKind]int{
+		ValidationOneOf:            2,
+		ValidationSourceArray:      1,
+		ValidationPolyHoles:        1,
+		ValidationDuplicateId:      2,
+		ValidationDanglingRefId:    1,
+		ValidationUnresolvedRefSid: 1,
+		ValidationIndexRange:       1,
+		ValidationAccessorOverrun:  1,
+	}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("got findings by kind %v, want %v", kinds, want)
+	}
+}
@@ -108,9 +108,9 @@ func newVisualSceneDef(id string) (me *VisualSceneDef) {
 //	That instance is created once when this method is first called on me,
 //	and will have its Def field readily set to me.
 func (me *VisualSceneDef) DefaultInst() (inst *VisualSceneInst) {
-	if inst = defaultVisualSceneInsts[me]; inst == nil {
+	if inst, _ = me.defaultInst.(*VisualSceneInst); inst == nil {
 		inst = me.NewInst()
-		defaultVisualSceneInsts[me] = inst
+		me.defaultInst = inst
 	}
 	return
 }
@@ -125,39 +125,50 @@ func (me *VisualSceneDef) NewInst() (inst *VisualSceneInst) {
 }
 
 //	If me is "dirty" or me.Def is nil, sets me.Def to the correct VisualSceneDef
-//	according to the current me.DefRef value (by searching AllVisualSceneDefLibs).
-//	Then returns me.Def.
+//	according to the current me.DefRef value (by searching reg.VisualSceneDefLibs,
+//	or AllVisualSceneDefLibs if reg is nil). Then returns me.Def.
 //	(Note, every VisualSceneInst's Def is nil initially, unless it was created via VisualSceneDef.NewInst().)
-func (me *VisualSceneInst) EnsureDef() *VisualSceneDef {
+func (me *VisualSceneInst) EnsureDef(reg *Registry) *VisualSceneDef {
 	if (me.Def == nil) || me.dirty {
-		me.Def = me.DefRef.VisualSceneDef()
+		me.Def = me.DefRef.VisualSceneDef(reg)
 	}
 	return me.Def
 }
 
 var (
 	//	A hash-table that contains LibVisualSceneDefs libraries associated by their Id.
-	AllVisualSceneDefLibs = LibsVisualSceneDef{}
+	//	This is the VisualSceneDefLibs of the DefaultRegistry.
+	AllVisualSceneDefLibs = DefaultRegistry.VisualSceneDefLibs
 
 	//	The "default" LibVisualSceneDefs library for VisualSceneDefs.
-	VisualSceneDefs = AllVisualSceneDefLibs.AddNew("")
-
-	defaultVisualSceneInsts = map[*VisualSceneDef]*VisualSceneInst{}
+	//	This is the VisualSceneDefs of the DefaultRegistry.
+	VisualSceneDefs = DefaultRegistry.VisualSceneDefs
 )
 
 func init() {
-	syncHandlers = append(syncHandlers, func() {
-		for _, lib := range AllVisualSceneDefLibs {
+	syncHandlers = append(syncHandlers, func(reg *Registry) {
+		for _, lib := range reg.VisualSceneDefLibs {
 			lib.SyncChanges()
 		}
 	})
+	libsOfRegistry = append(libsOfRegistry, func(reg *Registry) (libs []*BaseLib) {
+		for _, lib := range reg.VisualSceneDefLibs {
+			libs = append(libs, &lib.BaseLib)
+		}
+		return
+	})
+}
+
+func (me *Registry) initVisualSceneDefs() {
+	me.VisualSceneDefLibs = LibsVisualSceneDef{}
+	me.VisualSceneDefs = me.VisualSceneDefLibs.AddNew("")
 }
 
-//	Searches (all LibVisualSceneDefs contained in AllVisualSceneDefLibs) for the VisualSceneDef
-//	whose Id is referenced by me, returning the first match found.
-func (me RefId) VisualSceneDef() (def *VisualSceneDef) {
+//	Searches (all LibVisualSceneDefs contained in reg.VisualSceneDefLibs, or AllVisualSceneDefLibs if reg is nil)
+//	for the VisualSceneDef whose Id is referenced by me, returning the first match found.
+func (me RefId) VisualSceneDef(reg *Registry) (def *VisualSceneDef) {
 	id := me.S()
-	for _, lib := range AllVisualSceneDefLibs {
+	for _, lib := range reg.orDefault().VisualSceneDefLibs {
 		if def = lib.M[id]; def != nil {
 			return
 		}
@@ -165,7 +176,7 @@ func (me RefId) VisualSceneDef() (def *VisualSceneDef) {
 	return
 }
 
-//	The underlying type of the global AllVisualSceneDefLibs variable:
+//	The underlying type of the global AllVisualSceneDefLibs variable and the Registry.VisualSceneDefLibs field:
 //	a hash-table that contains LibVisualSceneDefs libraries associated by their Id.
 type LibsVisualSceneDef map[string]*LibVisualSceneDefs
 
@@ -207,6 +218,7 @@ func newLibVisualSceneDefs(id string) (me *LibVisualSceneDefs) {
 func (me *LibVisualSceneDefs) Add(d *VisualSceneDef) (n *VisualSceneDef) {
 	if me.M[d.Id] == nil {
 		n, me.M[d.Id] = d, d
+		me.index.add(d.Id, d)
 		me.SetDirty()
 	}
 	return
@@ -223,8 +235,18 @@ func (me *LibVisualSceneDefs) Len() int { return len(me.M) }
 //	but does not add it to this LibVisualSceneDefs.
 func (me *LibVisualSceneDefs) New(id string) (def *VisualSceneDef) { def = newVisualSceneDef(id); return }
 
+//	Rebuilds the index used by all RefId lookup methods from the VisualSceneDef definitions in this LibVisualSceneDefs.
+//	The index is kept up-to-date by the Add() and Remove() methods: call Reindex() only after adding, removing
+//	or re-identifying objects with an Id (such as Sources) in VisualSceneDef definitions already contained in me.
+func (me *LibVisualSceneDefs) Reindex() {
+	me.index.init()
+	for _, def := range me.M {
+		me.index.add(def.Id, def)
+	}
+}
+
 //	Removes the VisualSceneDef with the specified Id from this LibVisualSceneDefs.
-func (me *LibVisualSceneDefs) Remove(id string) { delete(me.M, id); me.SetDirty() }
+func (me *LibVisualSceneDefs) Remove(id string) { me.index.remove(id); delete(me.M, id); me.SetDirty() }
 
 //	Signals to the core package (or your custom package) that changes have been made to this LibVisualSceneDefs
 //	that need to be picked up. Call this after you have made a number of changes to this LibVisualSceneDefs
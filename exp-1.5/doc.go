@@ -0,0 +1,3 @@
+// Saves the data structures provided by the go-collada/dom package as Collada 1.5 XML documents.
+// The output can be loaded back in using the go-collada/imp-1.5 package.
+package collexp
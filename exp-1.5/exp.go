@@ -0,0 +1,48 @@
+package collexp
+
+import (
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+//	Provides options for exporting Collada documents.
+type ExportBag struct {
+}
+
+//	Initializes and returns a newly created ExportBag instance.
+func NewExportBag() (me *ExportBag) {
+	me = &ExportBag{}
+	return
+}
+
+//	Exports the specified doc and all library definitions in its Registry (or cdom.DefaultRegistry if nil)
+//	as a Collada 1.5 XML document, using the export options specified in exportBag.
+//	Returns an error (and no colladaDoc) if any raw XML data in doc, such as the Data of a
+//	Technique or the MathML of a FormulaDef, is not well-formed, rather than dropping it.
+func ExportCollada(doc *cdom.Document, exportBag *ExportBag) (colladaDoc []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ee, ok := r.(exportError)
+			if !ok {
+				panic(r)
+			}
+			colladaDoc, err = nil, ee.err
+		}
+	}()
+	xdoc := xmlx.New()
+	xdoc.Root = xmlx.NewNode(xmlx.NT_ROOT)
+	xn := xnew(xdoc.Root, "COLLADA")
+	xas(xn, "xmlns", xmlns)
+	xas(xn, "version", "1.5.0")
+	if doc.Asset != nil {
+		has_Asset(xn, &doc.HasAsset)
+	} else {
+		save_Asset(xnew(xn, "asset"), cdom.NewAsset())
+	}
+	libs_All(xn, doc.Libs())
+	save_Document(xn, doc)
+	has_Extras(xn, &doc.HasExtras)
+	colladaDoc = xdoc.SaveBytes()
+	return
+}
@@ -0,0 +1,210 @@
+package collexp_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	collexp "github.com/metaleap/go-collada/exp-1.5"
+	collimp "github.com/metaleap/go-collada/imp-1.5"
+)
+
+const testPolygonsDoc = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="33">0 0 0 1 0 0 0 1 0 0 0 0 4 0 0 4 4 0 0 4 0 1 1 0 2 1 0 2 2 0 1 2 0</float_array><technique_common><accessor source="#pos-a" count="11" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+<polygons count="2"><input semantic="VERTEX" source="#v" offset="0"/><p>0 1 2</p><ph><p>3 4 5 6</p><h>7 8 9 10</h></ph></polygons>
+</mesh></geometry></library_geometries>
+</COLLADA>`
+
+func importPolygons(t *testing.T, src []byte) (doc *cdom.Document, prim *cdom.GeometryPrimitives) {
+	bag := collimp.NewImportBag()
+	bag.Log, bag.Registry = nil, cdom.NewRegistry()
+	var err error
+	if doc, err = collimp.ImportCollada(src, bag); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	geo := doc.Registry.GeometryDefs.M["g"]
+	if (geo == nil) || (geo.Mesh == nil) || (len(geo.Mesh.Primitives) != 1) {
+		t.Fatalf("import: geometry g not loaded")
+	}
+	prim = geo.Mesh.Primitives[0]
+	return
+}
+
+func TestPolygonHolesRoundTrip(t *testing.T) {
+	src := []byte(testPolygonsDoc)
+	for pass := 0; pass < 2; pass++ {
+		doc, prim := importPolygons(t, src)
+		if !reflect.DeepEqual(prim.Indices, []uint64{0, 1, 2}) {
+			t.Errorf("pass %d: got Indices %v, want [0 1 2]", pass, prim.Indices)
+		}
+		if len(prim.Vcount) > 1 {
+			t.Errorf("pass %d: got Vcount %v, want a single polygon", pass, prim.Vcount)
+		}
+		if (len(prim.PolyHoles) != 1) || !reflect.DeepEqual(prim.PolyHoles[0].Indices, []uint64{3, 4, 5, 6}) || !reflect.DeepEqual(prim.PolyHoles[0].Holes, [][]uint64{{7, 8, 9, 10}}) {
+			t.Fatalf("pass %d: got PolyHoles %#v", pass, prim.PolyHoles)
+		}
+		var err error
+		if src, err = collexp.ExportCollada(doc, nil); err != nil {
+			t.Fatalf("pass %d: export: %v", pass, err)
+		}
+	}
+}
+
+func TestAssetRoundTrip(t *testing.T) {
+	asset := cdom.NewAsset()
+	asset.Created, asset.Keywords, asset.UpAxis = "2020-01-01T00:00:00Z", "a b", "Z"
+	asset.Unit.Meter, asset.Unit.Name = 0.01, "centimeter"
+	for _, test := range []struct {
+		asset   *cdom.Asset
+		created string
+	}{{nil, ""}, {cdom.NewAsset(), ""}, {asset, asset.Created}} {
+		doc := &cdom.Document{Registry: cdom.NewRegistry()}
+		doc.Asset = test.asset
+		src, err := collexp.ExportCollada(doc, nil)
+		if err != nil {
+			t.Fatalf("export: %v", err)
+		}
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry = nil, cdom.NewRegistry()
+		if doc, err = collimp.ImportCollada(src, bag); err != nil {
+			t.Fatalf("import: %v", err)
+		}
+		got := doc.Asset
+		for name, val := range map[string]string{"created": got.Created, "modified": got.Modified} {
+			if _, err = time.Parse(time.RFC3339, val); err != nil {
+				t.Errorf("<%s>: got %q, want a date-time: %v", name, val, err)
+			}
+		}
+		if want := test.asset; (len(test.created) > 0) && ((got.Created != want.Created) || (got.Keywords != want.Keywords) || (got.UpAxis != want.UpAxis) || (got.Unit != want.Unit)) {
+			t.Errorf("got %#v, want %#v", got, want)
+		}
+	}
+}
+
+const testDocHead = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+`
+
+//	Imports src, exports it and imports the export again, failing t on any error.
+func roundTrip(t *testing.T, src string) (docs [2]*cdom.Document, exported string) {
+	data := []byte(src)
+	for i := range docs {
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry = nil, cdom.NewRegistry()
+		var err error
+		if docs[i], err = collimp.ImportCollada(data, bag); err != nil {
+			t.Fatalf("import %d: %v", i, err)
+		}
+		if i == 0 {
+			if data, err = collexp.ExportCollada(docs[i], nil); err != nil {
+				t.Fatalf("export: %v", err)
+			}
+			exported = string(data)
+		}
+	}
+	return
+}
+
+func TestSkinRoundTrip(t *testing.T) {
+	docs, exported := roundTrip(t, testDocHead+`<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="3">0 0 0</float_array><technique_common><accessor source="#pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+<library_controllers><controller id="c"><skin source="#g">
+<source id="jn"><Name_array id="jn-a" count="1">j0</Name_array><technique_common><accessor source="#jn-a" count="1"><param name="JOINT" type="name"/></accessor></technique_common></source>
+<source id="w"><float_array id="w-a" count="2">0.75 0.25</float_array><technique_common><accessor source="#w-a" count="2"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<joints><input semantic="JOINT" source="#jn"/></joints>
+<vertex_weights count="1"><input semantic="JOINT" source="#jn" offset="0"/><input semantic="WEIGHT" source="#w" offset="1"/><vcount>2</vcount><v>0 0 -1 1</v></vertex_weights>
+</skin></controller></library_controllers>
+</COLLADA>`)
+	if !strings.Contains(exported, "<v>0 0 -1 1</v>") {
+		t.Errorf("exported <v> does not keep the bind-shape joint index -1:\n%s", exported)
+	}
+	for i, doc := range docs {
+		ctl := doc.Registry.ControllerDefs.M["c"]
+		if (ctl == nil) || (ctl.Skin == nil) {
+			t.Fatalf("import %d: skin c not loaded", i)
+		}
+		vw := &ctl.Skin.VertexWeights
+		if want := []uint64{0, 0, math.MaxUint64, 1}; !reflect.DeepEqual(vw.Indices, want) {
+			t.Errorf("import %d: got <v> %v, want %v", i, vw.Indices, want)
+		}
+		if !reflect.DeepEqual(vw.Vcount, []int64{2}) || (len(vw.Inputs) != 2) || (len(ctl.Skin.Sources) != 2) {
+			t.Errorf("import %d: got vcount %v, %d inputs, %d sources", i, vw.Vcount, len(vw.Inputs), len(ctl.Skin.Sources))
+		}
+	}
+}
+
+func TestExtraTechniquesRoundTrip(t *testing.T) {
+	docs, _ := roundTrip(t, testDocHead+`<library_nodes><node id="n"><extra type="hint"><technique profile="p"><foo a="1">bar<baz/></foo></technique><technique profile="empty"/></extra></node></library_nodes>
+</COLLADA>`)
+	for i, doc := range docs {
+		node := doc.Registry.NodeDefs.M["n"]
+		if (node == nil) || (len(node.Extras) != 1) || (len(node.Extras[0].Techniques) != 2) {
+			t.Fatalf("import %d: extra of node n not loaded", i)
+		}
+		ex := node.Extras[0]
+		if ex.Type != "hint" {
+			t.Errorf("import %d: got extra type %q, want \"hint\"", i, ex.Type)
+		}
+		tech := ex.Techniques[0]
+		if (tech.Profile != "p") || !strings.Contains(tech.Data, `<foo a="1">bar<baz></baz></foo>`) {
+			t.Errorf("import %d: got technique %q with data %q", i, tech.Profile, tech.Data)
+		}
+		if ex.Techniques[1].Profile != "empty" {
+			t.Errorf("import %d: got technique %q, want \"empty\"", i, ex.Techniques[1].Profile)
+		}
+	}
+}
+
+func TestExportInvalidTechnique(t *testing.T) {
+	doc := &cdom.Document{Registry: cdom.NewRegistry()}
+	doc.Extras = []*cdom.Extra{{HasTechniques: cdom.HasTechniques{Techniques: []*cdom.Technique{{Profile: "p", Data: "<broken"}}}}}
+	if src, err := collexp.ExportCollada(doc, nil); (err == nil) || (src != nil) {
+		t.Fatalf("got %q and error %v, want an error for the malformed technique", src, err)
+	} else if !strings.Contains(err.Error(), "technique 'p'") {
+		t.Errorf("got error %q, want it to name technique 'p'", err)
+	}
+}
+
+func TestAnimationRoundTrip(t *testing.T) {
+	docs, _ := roundTrip(t, testDocHead+`<library_animations><animation id="a"><animation id="a-x">
+<source id="in"><float_array id="in-a" count="2">0 1</float_array><technique_common><accessor source="#in-a" count="2"><param name="TIME" type="float"/></accessor></technique_common></source>
+<source id="out"><float_array id="out-a" count="2">1 2</float_array><technique_common><accessor source="#out-a" count="2"><param name="X" type="float"/></accessor></technique_common></source>
+<source id="ip"><Name_array id="ip-a" count="2">LINEAR STEP</Name_array><technique_common><accessor source="#ip-a" count="2"><param name="INTERPOLATION" type="name"/></accessor></technique_common></source>
+<sampler id="s" pre_behavior="CONSTANT" post_behavior="CYCLE"><input semantic="INPUT" source="#in"/><input semantic="OUTPUT" source="#out"/><input semantic="INTERPOLATION" source="#ip"/></sampler>
+<channel source="#s" target="n/t.X"/></animation></animation></library_animations>
+<library_nodes><node id="n"><translate sid="t">0 0 0</translate></node></library_nodes>
+</COLLADA>`)
+	for i, doc := range docs {
+		outer := doc.Registry.AnimationDefs.M["a"]
+		if (outer == nil) || (len(outer.AnimationDefs) != 1) {
+			t.Fatalf("import %d: animation a not loaded", i)
+		}
+		anim := outer.AnimationDefs[0]
+		if (anim.Id != "a-x") || (len(anim.Sources) != 3) || (len(anim.Samplers) != 1) || (len(anim.Channels) != 1) {
+			t.Fatalf("import %d: got animation %q with %d sources, %d samplers, %d channels", i, anim.Id, len(anim.Sources), len(anim.Samplers), len(anim.Channels))
+		}
+		if src := anim.Sources["out"]; (src == nil) || !reflect.DeepEqual(src.Array.Floats, []float64{1, 2}) {
+			t.Errorf("import %d: output source not preserved", i)
+		}
+		if src := anim.Sources["ip"]; (src == nil) || !reflect.DeepEqual(src.Array.Names, []string{"LINEAR", "STEP"}) {
+			t.Errorf("import %d: interpolation source not preserved", i)
+		}
+		samp, ch := anim.Samplers[0], anim.Channels[0]
+		if (samp.Id != "s") || (len(samp.Inputs) != 3) || (samp.PreBehavior != cdom.AnimSamplerBehaviorConstant) || (samp.PostBehavior != cdom.AnimSamplerBehaviorCycle) {
+			t.Errorf("import %d: got sampler %#v", i, samp)
+		}
+		if (ch.Source != "s") || (ch.Target.S != "n/t.X") {
+			t.Errorf("import %d: got channel %q -> %q, want s -> n/t.X", i, ch.Source, ch.Target.S)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package collexp
+
+import (
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+func libs_animation_clips(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibAnimationClipDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.AnimationClipDefLibs) {
+		if lib = reg.AnimationClipDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_animation_clips")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_AnimationClipDef(xnew(ln, "animation_clip"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_animations(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibAnimationDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.AnimationDefLibs) {
+		if lib = reg.AnimationDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_animations")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_AnimationDef(xnew(ln, "animation"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_cameras(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibCameraDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.CameraDefLibs) {
+		if lib = reg.CameraDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_cameras")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_CameraDef(xnew(ln, "camera"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_controllers(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibControllerDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.ControllerDefLibs) {
+		if lib = reg.ControllerDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_controllers")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_ControllerDef(xnew(ln, "controller"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_formulas(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibFormulaDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.FormulaDefLibs) {
+		if lib = reg.FormulaDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_formulas")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_FormulaDef(xnew(ln, "formula"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_geometries(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibGeometryDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.GeometryDefLibs) {
+		if lib = reg.GeometryDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_geometries")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_GeometryDef(xnew(ln, "geometry"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_lights(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibLightDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.LightDefLibs) {
+		if lib = reg.LightDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_lights")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_LightDef(xnew(ln, "light"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_nodes(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibNodeDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.NodeDefLibs) {
+		if lib = reg.NodeDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_nodes")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_NodeDef(xnew(ln, "node"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_visual_scenes(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibVisualSceneDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.VisualSceneDefLibs) {
+		if lib = reg.VisualSceneDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_visual_scenes")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_VisualSceneDef(xnew(ln, "visual_scene"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_force_fields(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibPxForceFieldDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.PxForceFieldDefLibs) {
+		if lib = reg.PxForceFieldDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_force_fields")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_PxForceFieldDef(xnew(ln, "force_field"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_physics_materials(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibPxMaterialDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.PxMaterialDefLibs) {
+		if lib = reg.PxMaterialDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_physics_materials")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_PxMaterialDef(xnew(ln, "physics_material"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_physics_models(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibPxModelDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.PxModelDefLibs) {
+		if lib = reg.PxModelDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_physics_models")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_PxModelDef(xnew(ln, "physics_model"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_physics_scenes(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibPxSceneDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.PxSceneDefLibs) {
+		if lib = reg.PxSceneDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_physics_scenes")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_PxSceneDef(xnew(ln, "physics_scene"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_effects(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibFxEffectDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.FxEffectDefLibs) {
+		if lib = reg.FxEffectDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_effects")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_FxEffectDef(xnew(ln, "effect"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_images(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibFxImageDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.FxImageDefLibs) {
+		if lib = reg.FxImageDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_images")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_FxImageDef(xnew(ln, "image"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_materials(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibFxMaterialDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.FxMaterialDefLibs) {
+		if lib = reg.FxMaterialDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_materials")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_FxMaterialDef(xnew(ln, "material"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_articulated_systems(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibKxArticulatedSystemDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.KxArticulatedSystemDefLibs) {
+		if lib = reg.KxArticulatedSystemDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_articulated_systems")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_KxArticulatedSystemDef(xnew(ln, "articulated_system"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_joints(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibKxJointDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.KxJointDefLibs) {
+		if lib = reg.KxJointDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_joints")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_KxJointDef(xnew(ln, "joint"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_kinematics_models(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibKxModelDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.KxModelDefLibs) {
+		if lib = reg.KxModelDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_kinematics_models")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_KxModelDef(xnew(ln, "kinematics_model"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_kinematics_scenes(xn *xmlx.Node, reg *cdom.Registry) {
+	var (
+		lib *cdom.LibKxSceneDefs
+		ln  *xmlx.Node
+	)
+	for _, id := range xkeys(reg.KxSceneDefLibs) {
+		if lib = reg.KxSceneDefLibs[id]; (lib != nil) && (lib.Len() > 0) {
+			ln = xnew(xn, "library_kinematics_scenes")
+			has_Id(ln, &lib.HasId)
+			has_Name(ln, &lib.HasName)
+			for _, defId := range xkeys(lib.M) {
+				save_KxSceneDef(xnew(ln, "kinematics_scene"), lib.M[defId])
+			}
+		}
+	}
+}
+
+func libs_All(xn *xmlx.Node, reg *cdom.Registry) {
+	libs_animation_clips(xn, reg)
+	libs_animations(xn, reg)
+	libs_cameras(xn, reg)
+	libs_controllers(xn, reg)
+	libs_formulas(xn, reg)
+	libs_geometries(xn, reg)
+	libs_lights(xn, reg)
+	libs_nodes(xn, reg)
+	libs_visual_scenes(xn, reg)
+	libs_force_fields(xn, reg)
+	libs_physics_materials(xn, reg)
+	libs_physics_models(xn, reg)
+	libs_physics_scenes(xn, reg)
+	libs_effects(xn, reg)
+	libs_images(xn, reg)
+	libs_materials(xn, reg)
+	libs_articulated_systems(xn, reg)
+	libs_joints(xn, reg)
+	libs_kinematics_models(xn, reg)
+	libs_kinematics_scenes(xn, reg)
+}
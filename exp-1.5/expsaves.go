@@ -0,0 +1,1953 @@
+package collexp
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+func save_AnimationChannel(xn *xmlx.Node, obj *cdom.AnimationChannel) {
+	xas(xn, "source", refId(obj.Source))
+	xas(xn, "target", obj.Target.S)
+}
+
+func save_AnimationClipDef(xn *xmlx.Node, obj *cdom.AnimationClipDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	xaf64(xn, "start", obj.Start)
+	if obj.End != 0 {
+		xaf64(xn, "end", obj.End)
+	}
+	has_Asset(xn, &obj.HasAsset)
+	for _, inst := range obj.Animations {
+		save_AnimationInst(xnew(xn, "instance_animation"), inst)
+	}
+	for _, inst := range obj.Formulas {
+		save_FormulaInst(xnew(xn, "instance_formula"), inst)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_AnimationDef(xn *xmlx.Node, obj *cdom.AnimationDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, def := range obj.AnimationDefs {
+		save_AnimationDef(xnew(xn, "animation"), def)
+	}
+	has_Sources(xn, &obj.HasSources)
+	for _, as := range obj.Samplers {
+		save_AnimationSampler(xnew(xn, "sampler"), as)
+	}
+	for _, ac := range obj.Channels {
+		save_AnimationChannel(xnew(xn, "channel"), ac)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_AnimationInst(xn *xmlx.Node, obj *cdom.AnimationInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_AnimationSampler(xn *xmlx.Node, obj *cdom.AnimationSampler) {
+	has_Id(xn, &obj.HasId)
+	xas(xn, "pre_behavior", get_AnimSamplerBehavior(obj.PreBehavior))
+	xas(xn, "post_behavior", get_AnimSamplerBehavior(obj.PostBehavior))
+	has_Inputs(xn, &obj.HasInputs)
+}
+
+func save_Asset(xn *xmlx.Node, obj *cdom.Asset) {
+	for _, c := range obj.Contributors {
+		save_AssetContributor(xnew(xn, "contributor"), c)
+	}
+	if obj.Coverage != nil {
+		save_AssetGeographicLocation(xnew(xnew(xn, "coverage"), "geographic_location"), obj.Coverage)
+	}
+	//	both are required by the schema, so default to the current time
+	created, modified, now := obj.Created, obj.Modified, time.Now().UTC().Format(time.RFC3339)
+	if len(created) == 0 {
+		created = now
+	}
+	if len(modified) == 0 {
+		modified = now
+	}
+	xs(xn, "created", created)
+	xs(xn, "keywords", obj.Keywords)
+	xs(xn, "modified", modified)
+	xs(xn, "revision", obj.Revision)
+	xs(xn, "subject", obj.Subject)
+	xs(xn, "title", obj.Title)
+	if un := xnew(xn, "unit"); obj.Unit.Meter > 0 {
+		xaf64(un, "meter", obj.Unit.Meter)
+		xas(un, "name", obj.Unit.Name)
+	}
+	if len(obj.UpAxis) > 0 {
+		xs(xn, "up_axis", strings.ToUpper(obj.UpAxis[:1])+"_UP")
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_AssetContributor(xn *xmlx.Node, obj *cdom.AssetContributor) {
+	xs(xn, "author", obj.Author)
+	xs(xn, "author_email", obj.AuthorEmail)
+	xs(xn, "author_website", obj.AuthorWebsite)
+	xs(xn, "authoring_tool", obj.AuthoringTool)
+	xs(xn, "comments", obj.Comments)
+	xs(xn, "copyright", obj.Copyright)
+	xs(xn, "source_data", obj.SourceData)
+}
+
+func save_AssetGeographicLocation(xn *xmlx.Node, obj *cdom.AssetGeographicLocation) {
+	xf64(xn, "longitude", obj.Longitude)
+	xf64(xn, "latitude", obj.Latitude)
+	an := xnew(xn, "altitude")
+	an.Value = xf64s(obj.Altitude)
+	if obj.AltitudeAbsolute {
+		xas(an, "mode", "absolute")
+	} else {
+		xas(an, "mode", "relativeToGround")
+	}
+}
+
+func save_BaseInst(xn *xmlx.Node, obj *cdom.BaseInst, refAtt string) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	setInstDefRef(xn, obj, refAtt)
+}
+
+func save_CameraDef(xn *xmlx.Node, obj *cdom.CameraDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	save_CameraOptics(xnew(xn, "optics"), &obj.Optics)
+	if obj.Imager != nil {
+		save_CameraImager(xnew(xn, "imager"), obj.Imager)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_CameraImager(xn *xmlx.Node, obj *cdom.CameraImager) {
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_CameraInst(xn *xmlx.Node, obj *cdom.CameraInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_CameraOptics(xn *xmlx.Node, obj *cdom.CameraOptics) {
+	var cn *xmlx.Node
+	tcn := node_TechCommon(xn)
+	if obj.TC.Orthographic != nil {
+		cn = xnew(tcn, "orthographic")
+		save_CameraOrthographic(cn, obj.TC.Orthographic)
+	} else {
+		cn = xnew(tcn, "perspective")
+		if obj.TC.Perspective != nil {
+			save_CameraPerspective(cn, obj.TC.Perspective)
+		}
+	}
+	if obj.TC.AspectRatio != nil {
+		save_SidFloat(xnew(cn, "aspect_ratio"), obj.TC.AspectRatio)
+	}
+	save_SidFloat(xnew(cn, "znear"), &obj.TC.Znear)
+	save_SidFloat(xnew(cn, "zfar"), &obj.TC.Zfar)
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_CameraOrthographic(xn *xmlx.Node, obj *cdom.CameraOrthographic) {
+	if obj.MagX != nil {
+		save_SidFloat(xnew(xn, "xmag"), obj.MagX)
+	}
+	if obj.MagY != nil {
+		save_SidFloat(xnew(xn, "ymag"), obj.MagY)
+	}
+}
+
+func save_CameraPerspective(xn *xmlx.Node, obj *cdom.CameraPerspective) {
+	if obj.FovX != nil {
+		save_SidFloat(xnew(xn, "xfov"), obj.FovX)
+	}
+	if obj.FovY != nil {
+		save_SidFloat(xnew(xn, "yfov"), obj.FovY)
+	}
+}
+
+func save_ChildNode(xn *xmlx.Node, obj *cdom.ChildNode) {
+	if obj.Def != nil {
+		save_NodeDef(xnew(xn, "node"), obj.Def)
+	} else if obj.Inst != nil {
+		save_NodeInst(xnew(xn, "instance_node"), obj.Inst)
+	}
+}
+
+func save_ControllerDef(xn *xmlx.Node, obj *cdom.ControllerDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	if obj.Skin != nil {
+		save_ControllerSkin(xnew(xn, "skin"), obj.Skin)
+	} else if obj.Morph != nil {
+		save_ControllerMorph(xnew(xn, "morph"), obj.Morph)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_ControllerInputs(xn *xmlx.Node, obj *cdom.ControllerInputs) {
+	has_Inputs(xn, &obj.HasInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_ControllerInst(xn *xmlx.Node, obj *cdom.ControllerInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	for _, s := range obj.SkinSkeletons {
+		xnew(xn, "skeleton").Value = s
+	}
+	if obj.BindMaterial != nil {
+		save_MaterialBinding(xnew(xn, "bind_material"), obj.BindMaterial)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_ControllerMorph(xn *xmlx.Node, obj *cdom.ControllerMorph) {
+	xas(xn, "source", refId(obj.Source))
+	if obj.Relative {
+		xas(xn, "method", "RELATIVE")
+	} else {
+		xas(xn, "method", "NORMALIZED")
+	}
+	has_Sources(xn, &obj.HasSources)
+	save_ControllerInputs(xnew(xn, "targets"), &obj.Targets)
+}
+
+func save_ControllerSkin(xn *xmlx.Node, obj *cdom.ControllerSkin) {
+	xas(xn, "source", refId(obj.Source))
+	xm4(xn, "bind_shape_matrix", &obj.BindShapeMatrix)
+	has_Sources(xn, &obj.HasSources)
+	save_ControllerInputs(xnew(xn, "joints"), &obj.Joints)
+	save_IndexedInputs(xnew(xn, "vertex_weights"), &obj.VertexWeights)
+}
+
+func save_Document(xn *xmlx.Node, obj *cdom.Document) {
+	if obj.Scene != nil {
+		save_Scene(xnew(xn, "scene"), obj.Scene)
+	}
+}
+
+func save_Extra(xn *xmlx.Node, obj *cdom.Extra) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	xas(xn, "type", obj.Type)
+	has_Asset(xn, &obj.HasAsset)
+	has_Techniques(xn, &obj.HasTechniques)
+}
+
+func save_Formula(xn *xmlx.Node, obj *cdom.Formula) {
+	if obj.Def != nil {
+		save_FormulaDef(xnew(xn, "formula"), obj.Def)
+	} else if obj.Inst != nil {
+		save_FormulaInst(xnew(xn, "instance_formula"), obj.Inst)
+	}
+}
+
+func save_FormulaDef(xn *xmlx.Node, obj *cdom.FormulaDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	save_ParamOrFloat(xnew(xn, "target"), &obj.Target)
+	if len(strings.TrimSpace(obj.TC.MathML)) == 0 {
+		node_TechCommon(xn)
+	} else {
+		xraw(xn, obj.TC.MathML, "formula '"+obj.Id+"'")
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FormulaInst(xn *xmlx.Node, obj *cdom.FormulaInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxAnnotation(xn *xmlx.Node, obj *cdom.FxAnnotation) {
+	has_Name(xn, &obj.HasName)
+	xv(xn, obj.Value)
+}
+
+func save_FxBinding(xn *xmlx.Node, obj *cdom.FxBinding) {
+	xas(xn, "semantic", obj.Semantic)
+	xas(xn, "target", obj.Target.S)
+}
+
+func save_FxColor(xn *xmlx.Node, obj *cdom.FxColor) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = list_Rgba32(&obj.Rgba32)
+}
+
+func save_FxColorOrTexture(xn *xmlx.Node, obj *cdom.FxColorOrTexture, opaque bool) {
+	if opaque {
+		xas(xn, "opaque", get_TextureOpaque(obj.Opaque))
+	}
+	if obj.Color != nil {
+		save_FxColor(xnew(xn, "color"), obj.Color)
+	} else if obj.Texture != nil {
+		save_FxTexture(xnew(xn, "texture"), obj.Texture)
+	} else if len(obj.ParamRef.S) > 0 {
+		xas(xnew(xn, "param"), "ref", obj.ParamRef.S)
+	}
+}
+
+func save_FxCreate(xn *xmlx.Node, obj *cdom.FxCreate) {
+	if obj.ArrayLength > 0 {
+		xau64(xnew(xn, "array"), "length", obj.ArrayLength)
+	}
+	if obj.Format != nil {
+		save_FxCreateFormat(xnew(xn, "format"), obj.Format)
+	}
+}
+
+func save_FxCreate2D(xn *xmlx.Node, obj *cdom.FxCreate2D) {
+	if obj.Size.Exact != nil {
+		save_FxCreate2DSizeExact(xnew(xn, "size_exact"), obj.Size.Exact)
+	} else if obj.Size.Ratio != nil {
+		save_FxCreate2DSizeRatio(xnew(xn, "size_ratio"), obj.Size.Ratio)
+	}
+	if obj.Mips != nil {
+		save_FxCreateMips(xnew(xn, "mips"), obj.Mips)
+	}
+	if obj.Unnormalized {
+		xnew(xn, "unnormalized")
+	}
+	save_FxCreate(xn, &obj.FxCreate)
+	for _, inf := range obj.InitFrom {
+		save_FxCreateInitFrom(xnew(xn, "init_from"), inf)
+	}
+}
+
+func save_FxCreate2DSizeExact(xn *xmlx.Node, obj *cdom.FxCreate2DSizeExact) {
+	xau64(xn, "width", obj.Width)
+	xau64(xn, "height", obj.Height)
+}
+
+func save_FxCreate2DSizeRatio(xn *xmlx.Node, obj *cdom.FxCreate2DSizeRatio) {
+	xaf64(xn, "width", obj.Width)
+	xaf64(xn, "height", obj.Height)
+}
+
+func save_FxCreate3D(xn *xmlx.Node, obj *cdom.FxCreate3D) {
+	sn := xnew(xn, "size")
+	xau64(sn, "width", obj.Size.Width)
+	xau64(sn, "height", obj.Size.Height)
+	xau64(sn, "depth", obj.Size.Depth)
+	save_FxCreateMips(xnew(xn, "mips"), &obj.Mips)
+	save_FxCreate(xn, &obj.FxCreate)
+	for _, inf := range obj.InitFrom {
+		save_FxCreate3DInitFrom(xnew(xn, "init_from"), inf)
+	}
+}
+
+func save_FxCreate3DInitFrom(xn *xmlx.Node, obj *cdom.FxCreate3DInitFrom) {
+	save_FxCreateInitFrom(xn, &obj.FxCreateInitFrom)
+	xau64(xn, "depth", obj.Depth)
+}
+
+func save_FxCreateCube(xn *xmlx.Node, obj *cdom.FxCreateCube) {
+	xau64(xnew(xn, "size"), "width", obj.Size.Width)
+	save_FxCreateMips(xnew(xn, "mips"), &obj.Mips)
+	save_FxCreate(xn, &obj.FxCreate)
+	for _, inf := range obj.InitFrom {
+		save_FxCreateCubeInitFrom(xnew(xn, "init_from"), inf)
+	}
+}
+
+func save_FxCreateCubeInitFrom(xn *xmlx.Node, obj *cdom.FxCreateCubeInitFrom) {
+	save_FxCreateInitFrom(xn, &obj.FxCreateInitFrom)
+	xas(xn, "face", get_CubeFace(obj.Face))
+}
+
+func save_FxCreateFormat(xn *xmlx.Node, obj *cdom.FxCreateFormat) {
+	if obj.Hint != nil {
+		save_FxCreateFormatHint(xnew(xn, "hint"), obj.Hint)
+	}
+	xs(xn, "exact", obj.Exact)
+}
+
+func save_FxCreateFormatHint(xn *xmlx.Node, obj *cdom.FxCreateFormatHint) {
+	xas(xn, "channels", get_FormatChannels(obj.Channels))
+	xas(xn, "range", get_FormatRange(obj.Range))
+	xas(xn, "precision", get_FormatPrecision(obj.Precision))
+	xas(xn, "space", obj.Space)
+}
+
+func save_FxCreateInitFrom(xn *xmlx.Node, obj *cdom.FxCreateInitFrom) {
+	save_FxInitFrom(xn, &obj.FxInitFrom)
+	xau64(xn, "array_index", obj.ArrayIndex)
+	xau64(xn, "mip_index", obj.MipIndex)
+}
+
+func save_FxCreateMips(xn *xmlx.Node, obj *cdom.FxCreateMips) {
+	xau64(xn, "levels", obj.Levels)
+	xab(xn, "auto_generate", !obj.NoAutoGen)
+}
+
+func save_FxEffectDef(xn *xmlx.Node, obj *cdom.FxEffectDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, a := range obj.Annotations {
+		save_FxAnnotation(xnew(xn, "annotate"), a)
+	}
+	has_FxParamDefs(xn, &obj.HasFxParamDefs)
+	for _, fp := range obj.Profiles {
+		if fp.Common != nil {
+			save_FxProfile(xnew(xn, "profile_COMMON"), fp)
+		} else if fp.Glsl != nil {
+			save_FxProfile(xnew(xn, "profile_GLSL"), fp)
+		}
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxEffectInst(xn *xmlx.Node, obj *cdom.FxEffectInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	for _, th := range obj.TechniqueHints {
+		save_FxEffectInstTechniqueHint(xnew(xn, "technique_hint"), th)
+	}
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxEffectInstTechniqueHint(xn *xmlx.Node, obj *cdom.FxEffectInstTechniqueHint) {
+	xas(xn, "platform", obj.Platform)
+	xas(xn, "profile", obj.Profile)
+	xas(xn, "ref", obj.Ref)
+}
+
+func save_FxImageDef(xn *xmlx.Node, obj *cdom.FxImageDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	if obj.Renderable.Is {
+		xab(xnew(xn, "renderable"), "share", obj.Renderable.Shared)
+	}
+	if obj.InitFrom != nil {
+		save_FxImageInitFrom(xnew(xn, "init_from"), obj.InitFrom)
+	} else if obj.Create2D != nil {
+		save_FxCreate2D(xnew(xn, "create_2d"), obj.Create2D)
+	} else if obj.Create3D != nil {
+		save_FxCreate3D(xnew(xn, "create_3d"), obj.Create3D)
+	} else if obj.CreateCube != nil {
+		save_FxCreateCube(xnew(xn, "create_cube"), obj.CreateCube)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxImageInitFrom(xn *xmlx.Node, obj *cdom.FxImageInitFrom) {
+	xab(xn, "mips_generate", !obj.NoAutoMip)
+	save_FxInitFrom(xn, &obj.FxInitFrom)
+}
+
+func save_FxImageInst(xn *xmlx.Node, obj *cdom.FxImageInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxInitFrom(xn *xmlx.Node, obj *cdom.FxInitFrom) {
+	if len(obj.RefUrl) > 0 {
+		xs(xn, "ref", obj.RefUrl)
+	} else if len(obj.Raw.Data) > 0 {
+		hn := xnew(xn, "hex")
+		xas(hn, "format", obj.Raw.Format)
+		hn.Value = strings.ToUpper(hex.EncodeToString(obj.Raw.Data))
+	}
+}
+
+func save_FxMaterialDef(xn *xmlx.Node, obj *cdom.FxMaterialDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	save_FxEffectInst(xnew(xn, "instance_effect"), &obj.Effect)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxMaterialInst(xn *xmlx.Node, obj *cdom.FxMaterialInst) {
+	save_BaseInst(xn, &obj.BaseInst, "target")
+	xas(xn, "symbol", obj.Symbol)
+	for _, b := range obj.Bindings {
+		save_FxBinding(xnew(xn, "bind"), b)
+	}
+	for _, vib := range obj.VertexInputBindings {
+		save_FxVertexInputBinding(xnew(xn, "bind_vertex_input"), vib)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxParamDef(xn *xmlx.Node, obj *cdom.FxParamDef) {
+	has_Sid(xn, &obj.HasSid)
+	for _, a := range obj.Annotations {
+		save_FxAnnotation(xnew(xn, "annotate"), a)
+	}
+	xs(xn, "semantic", obj.Semantic)
+	xs(xn, "modifier", obj.Modifier)
+	xv(xn, obj.Value)
+}
+
+func save_FxPass(xn *xmlx.Node, obj *cdom.FxPass) {
+	has_Sid(xn, &obj.HasSid)
+	for _, a := range obj.Annotations {
+		save_FxAnnotation(xnew(xn, "annotate"), a)
+	}
+	if len(obj.States) > 0 {
+		sn := xnew(xn, "states")
+		for _, n := range xkeys(obj.States) {
+			save_FxPassState(xnew(sn, n), obj.States[n])
+		}
+	}
+	if obj.Program != nil {
+		save_FxPassProgram(xnew(xn, "program"), obj.Program)
+	}
+	if obj.Evaluate != nil {
+		save_FxPassEvaluation(xnew(xn, "evaluate"), obj.Evaluate)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxPassEvaluation(xn *xmlx.Node, obj *cdom.FxPassEvaluation) {
+	if obj.Color.Target != nil {
+		save_FxPassEvaluationTarget(xnew(xn, "color_target"), obj.Color.Target)
+	}
+	if obj.Depth.Target != nil {
+		save_FxPassEvaluationTarget(xnew(xn, "depth_target"), obj.Depth.Target)
+	}
+	if obj.Stencil.Target != nil {
+		save_FxPassEvaluationTarget(xnew(xn, "stencil_target"), obj.Stencil.Target)
+	}
+	if obj.Color.Clear != nil {
+		save_FxPassEvaluationClearColor(xnew(xn, "color_clear"), obj.Color.Clear)
+	}
+	if obj.Depth.Clear != nil {
+		save_FxPassEvaluationClearDepth(xnew(xn, "depth_clear"), obj.Depth.Clear)
+	}
+	if obj.Stencil.Clear != nil {
+		save_FxPassEvaluationClearStencil(xnew(xn, "stencil_clear"), obj.Stencil.Clear)
+	}
+	xs(xn, "draw", obj.Draw)
+}
+
+func save_FxPassEvaluationClearColor(xn *xmlx.Node, obj *cdom.FxPassEvaluationClearColor) {
+	xau64(xn, "index", obj.Index)
+	xn.Value = list_Rgba32(&obj.Rgba32)
+}
+
+func save_FxPassEvaluationClearDepth(xn *xmlx.Node, obj *cdom.FxPassEvaluationClearDepth) {
+	xau64(xn, "index", obj.Index)
+	xn.Value = xf64s(obj.F)
+}
+
+func save_FxPassEvaluationClearStencil(xn *xmlx.Node, obj *cdom.FxPassEvaluationClearStencil) {
+	xau64(xn, "index", obj.Index)
+	xn.Value = list_Uints([]uint64{uint64(obj.B)})
+}
+
+func save_FxPassEvaluationTarget(xn *xmlx.Node, obj *cdom.FxPassEvaluationTarget) {
+	xau64(xn, "index", obj.Index)
+	xau64(xn, "slice", obj.Slice)
+	xau64(xn, "mip", obj.Mip)
+	xas(xn, "face", get_CubeFace(obj.CubeFace))
+	if len(obj.Sampler.S) > 0 {
+		xas(xnew(xn, "param"), "ref", obj.Sampler.S)
+	} else if obj.Image != nil {
+		save_FxImageInst(xnew(xn, "instance_image"), obj.Image)
+	}
+}
+
+func save_FxPassProgram(xn *xmlx.Node, obj *cdom.FxPassProgram) {
+	for _, s := range obj.Shaders {
+		save_FxPassProgramShader(xnew(xn, "shader"), s)
+	}
+	for _, ba := range obj.BindAttributes {
+		save_FxPassProgramBindAttribute(xnew(xn, "bind_attribute"), ba)
+	}
+	for _, bu := range obj.BindUniforms {
+		save_FxPassProgramBindUniform(xnew(xn, "bind_uniform"), bu)
+	}
+}
+
+func save_FxPassProgramBindAttribute(xn *xmlx.Node, obj *cdom.FxPassProgramBindAttribute) {
+	xas(xn, "symbol", obj.Symbol)
+	xs(xn, "semantic", obj.Semantic)
+}
+
+func save_FxPassProgramBindUniform(xn *xmlx.Node, obj *cdom.FxPassProgramBindUniform) {
+	xas(xn, "symbol", obj.Symbol)
+	if len(obj.ParamRef.S) > 0 {
+		xas(xnew(xn, "param"), "ref", obj.ParamRef.S)
+	} else {
+		xv(xn, obj.Value)
+	}
+}
+
+func save_FxPassProgramShader(xn *xmlx.Node, obj *cdom.FxPassProgramShader) {
+	xas(xn, "stage", get_ShaderStage(obj.Stage))
+	if len(obj.Sources) > 0 {
+		sn := xnew(xn, "sources")
+		for _, pss := range obj.Sources {
+			if pss.IsImportRef {
+				xas(xnew(sn, "import"), "ref", pss.S)
+			} else {
+				xnew(sn, "inline").Value = pss.S
+			}
+		}
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxPassState(xn *xmlx.Node, obj *cdom.FxPassState) {
+	xas(xn, "value", obj.Value)
+	xas(xn, "param", obj.Param.S)
+	if obj.Index != 0 {
+		xaf64(xn, "index", obj.Index)
+	}
+}
+
+func save_FxProfile(xn *xmlx.Node, obj *cdom.FxProfile) {
+	has_Id(xn, &obj.HasId)
+	if obj.Glsl != nil {
+		xas(xn, "platform", obj.Glsl.Platform)
+	}
+	has_Asset(xn, &obj.HasAsset)
+	if obj.Glsl != nil {
+		for _, ci := range obj.Glsl.CodesIncludes {
+			save_FxProfileGlslCodeInclude(xn, &ci)
+		}
+	}
+	has_FxParamDefs(xn, &obj.HasFxParamDefs)
+	if obj.Common != nil {
+		save_FxProfileCommon(xn, obj.Common)
+	} else if obj.Glsl != nil {
+		save_FxProfileGlsl(xn, obj.Glsl)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxProfileCommon(xn *xmlx.Node, obj *cdom.FxProfileCommon) {
+	save_FxTechniqueCommon(xnew(xn, "technique"), &obj.Technique)
+}
+
+func save_FxProfileGlsl(xn *xmlx.Node, obj *cdom.FxProfileGlsl) {
+	for _, sid := range xkeys(obj.Techniques) {
+		save_FxTechniqueGlsl(xnew(xn, "technique"), obj.Techniques[sid])
+	}
+}
+
+func save_FxProfileGlslCodeInclude(xn *xmlx.Node, obj *cdom.FxProfileGlslCodeInclude) {
+	var cn *xmlx.Node
+	if obj.IsInclude {
+		cn = xnew(xn, "include")
+		xas(cn, "url", obj.S)
+	} else {
+		cn = xnew(xn, "code")
+		cn.Value = obj.S
+	}
+	has_Sid(cn, &obj.HasSid)
+}
+
+func save_FxSampler(xn *xmlx.Node, obj *cdom.FxSampler) {
+	if obj.Image != nil {
+		save_FxImageInst(xnew(xn, "instance_image"), obj.Image)
+	}
+	save_FxSamplerStates(xn, &obj.FxSamplerStates)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxSamplerFiltering(xn *xmlx.Node, obj *cdom.FxSamplerFiltering) {
+	xs(xn, "minfilter", get_FilterKind(obj.FilterMin))
+	xs(xn, "magfilter", get_FilterKind(obj.FilterMag))
+	xs(xn, "mipfilter", get_FilterKind(obj.FilterMip))
+	xu64(xn, "mip_max_level", uint64(obj.MipMaxLevel))
+	xu64(xn, "mip_min_level", uint64(obj.MipMinLevel))
+	xf64(xn, "mip_bias", obj.MipBias)
+	xu64(xn, "max_anisotropy", uint64(obj.MaxAnisotropy))
+}
+
+func save_FxSamplerImage(xn *xmlx.Node, obj *cdom.FxSamplerImage) {
+	save_FxImageInst(xn, &obj.FxImageInst)
+}
+
+func save_FxSamplerStates(xn *xmlx.Node, obj *cdom.FxSamplerStates) {
+	if obj.Wrapping != nil {
+		save_FxSamplerWrapping(xn, obj.Wrapping)
+	}
+	if obj.Filtering != nil {
+		save_FxSamplerFiltering(xn, obj.Filtering)
+	}
+	if obj.Wrapping != nil {
+		xnew(xn, "border_color").Value = list_Rgba32(&obj.Wrapping.BorderColor)
+	}
+}
+
+func save_FxSamplerWrapping(xn *xmlx.Node, obj *cdom.FxSamplerWrapping) {
+	xs(xn, "wrap_s", get_WrapKind(obj.WrapS))
+	xs(xn, "wrap_t", get_WrapKind(obj.WrapT))
+	xs(xn, "wrap_p", get_WrapKind(obj.WrapP))
+}
+
+func save_FxTechnique(xn *xmlx.Node, obj *cdom.FxTechnique) {
+	has_Id(xn, &obj.HasId)
+	has_Sid(xn, &obj.HasSid)
+	has_Asset(xn, &obj.HasAsset)
+}
+
+func save_FxTechniqueCommon(xn *xmlx.Node, obj *cdom.FxTechniqueCommon) {
+	var cn *xmlx.Node
+	save_FxTechnique(xn, &obj.FxTechnique)
+	switch obj.Kind {
+	case cdom.FxTechniqueKindBlinn:
+		cn = xnew(xn, "blinn")
+	case cdom.FxTechniqueKindLambert:
+		cn = xnew(xn, "lambert")
+	case cdom.FxTechniqueKindPhong:
+		cn = xnew(xn, "phong")
+	default:
+		cn = xnew(xn, "constant")
+	}
+	for _, ct := range []struct {
+		n string
+		c *cdom.FxColorOrTexture
+	}{{"emission", obj.Emission}, {"ambient", obj.Ambient}, {"diffuse", obj.Diffuse}, {"specular", obj.Specular}} {
+		if ct.c != nil {
+			save_FxColorOrTexture(xnew(cn, ct.n), ct.c, false)
+		}
+	}
+	if obj.Shininess != nil {
+		save_ParamOrSidFloat(xnew(cn, "shininess"), obj.Shininess)
+	}
+	if obj.Reflective != nil {
+		save_FxColorOrTexture(xnew(cn, "reflective"), obj.Reflective, false)
+	}
+	if obj.Reflectivity != nil {
+		save_ParamOrSidFloat(xnew(cn, "reflectivity"), obj.Reflectivity)
+	}
+	if obj.Transparent != nil {
+		save_FxColorOrTexture(xnew(cn, "transparent"), obj.Transparent, true)
+	}
+	if obj.Transparency != nil {
+		save_ParamOrSidFloat(xnew(cn, "transparency"), obj.Transparency)
+	}
+	if obj.IndexOfRefraction != nil {
+		save_ParamOrSidFloat(xnew(cn, "index_of_refraction"), obj.IndexOfRefraction)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxTechniqueGlsl(xn *xmlx.Node, obj *cdom.FxTechniqueGlsl) {
+	save_FxTechnique(xn, &obj.FxTechnique)
+	for _, a := range obj.Annotations {
+		save_FxAnnotation(xnew(xn, "annotate"), a)
+	}
+	for _, p := range obj.Passes {
+		save_FxPass(xnew(xn, "pass"), p)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxTexture(xn *xmlx.Node, obj *cdom.FxTexture) {
+	xas(xn, "texture", obj.Sampler2D.S)
+	xas(xn, "texcoord", obj.TexCoord)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_FxVertexInputBinding(xn *xmlx.Node, obj *cdom.FxVertexInputBinding) {
+	xas(xn, "semantic", obj.Semantic)
+	xas(xn, "input_semantic", obj.InputSemantic)
+	xau64p(xn, "input_set", obj.InputSet)
+}
+
+func save_GeometryBrep(xn *xmlx.Node, obj *cdom.GeometryBrep) {
+	if obj.Curves != nil {
+		save_GeometryBrepCurves(xnew(xn, "curves"), obj.Curves)
+	}
+	if obj.SurfaceCurves != nil {
+		save_GeometryBrepSurfaceCurves(xnew(xn, "surface_curves"), obj.SurfaceCurves)
+	}
+	if obj.Surfaces != nil {
+		save_GeometryBrepSurfaces(xnew(xn, "surfaces"), obj.Surfaces)
+	}
+	has_Sources(xn, &obj.HasSources)
+	save_GeometryVertices(xnew(xn, "vertices"), &obj.Vertices)
+	if obj.Edges != nil {
+		save_GeometryBrepEdges(xnew(xn, "edges"), obj.Edges)
+	}
+	if obj.Wires != nil {
+		save_GeometryBrepWires(xnew(xn, "wires"), obj.Wires)
+	}
+	if obj.Faces != nil {
+		save_GeometryBrepFaces(xnew(xn, "faces"), obj.Faces)
+	}
+	if obj.Pcurves != nil {
+		save_GeometryBrepPcurves(xnew(xn, "pcurves"), obj.Pcurves)
+	}
+	if obj.Shells != nil {
+		save_GeometryBrepShells(xnew(xn, "shells"), obj.Shells)
+	}
+	if obj.Solids != nil {
+		save_GeometryBrepSolids(xnew(xn, "solids"), obj.Solids)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepBox(xn *xmlx.Node, obj *cdom.GeometryBrepBox) {
+	xv3(xn, "half_extents", &obj.HalfExtents)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepCapsule(xn *xmlx.Node, obj *cdom.GeometryBrepCapsule) {
+	xf64(xn, "height", obj.Height)
+	xv3(xn, "radius", &obj.Radii)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepCircle(xn *xmlx.Node, obj *cdom.GeometryBrepCircle) {
+	xf64(xn, "radius", obj.Radius)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepCone(xn *xmlx.Node, obj *cdom.GeometryBrepCone) {
+	xf64(xn, "radius", obj.Radius)
+	xf64(xn, "angle", obj.Angle)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepCurve(xn *xmlx.Node, obj *cdom.GeometryBrepCurve) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	if el := &obj.Element; el.Line != nil {
+		save_GeometryBrepLine(xnew(xn, "line"), el.Line)
+	} else if el.Circle != nil {
+		save_GeometryBrepCircle(xnew(xn, "circle"), el.Circle)
+	} else if el.Ellipse != nil {
+		save_GeometryBrepEllipse(xnew(xn, "ellipse"), el.Ellipse)
+	} else if el.Parabola != nil {
+		save_GeometryBrepParabola(xnew(xn, "parabola"), el.Parabola)
+	} else if el.Hyperbola != nil {
+		save_GeometryBrepHyperbola(xnew(xn, "hyperbola"), el.Hyperbola)
+	} else if el.Nurbs != nil {
+		save_GeometryBrepNurbs(xnew(xn, "nurbs"), el.Nurbs)
+	}
+	save_GeometryPositioning(xn, &obj.Location)
+}
+
+func save_GeometryBrepCurves(xn *xmlx.Node, obj *cdom.GeometryBrepCurves) {
+	for _, c := range obj.All {
+		save_GeometryBrepCurve(xnew(xn, "curve"), c)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepCylinder(xn *xmlx.Node, obj *cdom.GeometryBrepCylinder) {
+	xnew(xn, "radius").Value = list_Floats(obj.Radii[:])
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepEdges(xn *xmlx.Node, obj *cdom.GeometryBrepEdges) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepEllipse(xn *xmlx.Node, obj *cdom.GeometryBrepEllipse) {
+	xnew(xn, "radius").Value = list_Floats(obj.Radii[:])
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepFaces(xn *xmlx.Node, obj *cdom.GeometryBrepFaces) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepHyperbola(xn *xmlx.Node, obj *cdom.GeometryBrepHyperbola) {
+	xnew(xn, "radius").Value = list_Floats(obj.Radii[:])
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepLine(xn *xmlx.Node, obj *cdom.GeometryBrepLine) {
+	xv3(xn, "origin", &obj.Origin)
+	xv3(xn, "direction", &obj.Direction)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepNurbs(xn *xmlx.Node, obj *cdom.GeometryBrepNurbs) {
+	xau64(xn, "degree", obj.Degree)
+	xab(xn, "closed", obj.Closed)
+	has_Sources(xn, &obj.HasSources)
+	save_GeometryControlVertices(xnew(xn, "control_vertices"), &obj.ControlVertices)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepNurbsSurface(xn *xmlx.Node, obj *cdom.GeometryBrepNurbsSurface) {
+	xau64(xn, "degree_u", obj.U.Degree)
+	xab(xn, "closed_u", obj.U.Closed)
+	xau64(xn, "degree_v", obj.V.Degree)
+	xab(xn, "closed_v", obj.V.Closed)
+	has_Sources(xn, &obj.HasSources)
+	save_GeometryControlVertices(xnew(xn, "control_vertices"), &obj.ControlVertices)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepOrientation(xn *xmlx.Node, obj *cdom.GeometryBrepOrientation) {
+	xn.Value = list_Floats([]float64{obj.Axis.X, obj.Axis.Y, obj.Axis.Z, obj.Angle})
+}
+
+func save_GeometryBrepParabola(xn *xmlx.Node, obj *cdom.GeometryBrepParabola) {
+	xf64(xn, "focal", obj.FocalLength)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepPcurves(xn *xmlx.Node, obj *cdom.GeometryBrepPcurves) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepPlane(xn *xmlx.Node, obj *cdom.GeometryBrepPlane) {
+	xnew(xn, "equation").Value = list_Floats(obj.Equation[:])
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepShells(xn *xmlx.Node, obj *cdom.GeometryBrepShells) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepSolids(xn *xmlx.Node, obj *cdom.GeometryBrepSolids) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepSphere(xn *xmlx.Node, obj *cdom.GeometryBrepSphere) {
+	xf64(xn, "radius", obj.Radius)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepSurface(xn *xmlx.Node, obj *cdom.GeometryBrepSurface) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	if el := &obj.Element; el.Cone != nil {
+		save_GeometryBrepCone(xnew(xn, "cone"), el.Cone)
+	} else if el.Plane != nil {
+		save_GeometryBrepPlane(xnew(xn, "plane"), el.Plane)
+	} else if el.Cylinder != nil {
+		save_GeometryBrepCylinder(xnew(xn, "cylinder"), el.Cylinder)
+	} else if el.NurbsSurface != nil {
+		save_GeometryBrepNurbsSurface(xnew(xn, "nurbs_surface"), el.NurbsSurface)
+	} else if el.Sphere != nil {
+		save_GeometryBrepSphere(xnew(xn, "sphere"), el.Sphere)
+	} else if el.Torus != nil {
+		save_GeometryBrepTorus(xnew(xn, "torus"), el.Torus)
+	} else if el.SweptSurface != nil {
+		save_GeometryBrepSweptSurface(xnew(xn, "swept_surface"), el.SweptSurface)
+	}
+	save_GeometryPositioning(xn, &obj.Location)
+}
+
+func save_GeometryBrepSurfaceCurves(xn *xmlx.Node, obj *cdom.GeometryBrepSurfaceCurves) {
+	for _, c := range obj.All {
+		save_GeometryBrepCurve(xnew(xn, "curve"), c)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepSurfaces(xn *xmlx.Node, obj *cdom.GeometryBrepSurfaces) {
+	for _, s := range obj.All {
+		save_GeometryBrepSurface(xnew(xn, "surface"), s)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepSweptSurface(xn *xmlx.Node, obj *cdom.GeometryBrepSweptSurface) {
+	if obj.Curve != nil {
+		save_GeometryBrepCurve(xnew(xn, "curve"), obj.Curve)
+	}
+	if obj.IsExtrusion() {
+		xv3(xn, "direction", obj.Extrusion.Direction)
+	} else {
+		xv3(xn, "origin", obj.Revolution.Origin)
+		xv3(xn, "axis", obj.Revolution.Direction)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepTorus(xn *xmlx.Node, obj *cdom.GeometryBrepTorus) {
+	xnew(xn, "radius").Value = list_Floats(obj.Radii[:])
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryBrepWires(xn *xmlx.Node, obj *cdom.GeometryBrepWires) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	save_IndexedInputs(xn, &obj.IndexedInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryControlVertices(xn *xmlx.Node, obj *cdom.GeometryControlVertices) {
+	has_Inputs(xn, &obj.HasInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryDef(xn *xmlx.Node, obj *cdom.GeometryDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	if obj.Mesh != nil {
+		if len(obj.Mesh.ConvexHullOf) > 0 {
+			save_GeometryMesh(xnew(xn, "convex_mesh"), obj.Mesh)
+		} else {
+			save_GeometryMesh(xnew(xn, "mesh"), obj.Mesh)
+		}
+	} else if obj.Brep != nil {
+		save_GeometryBrep(xnew(xn, "brep"), obj.Brep)
+	} else if obj.Spline != nil {
+		save_GeometrySpline(xnew(xn, "spline"), obj.Spline)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryInst(xn *xmlx.Node, obj *cdom.GeometryInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	if obj.MaterialBinding != nil {
+		save_MaterialBinding(xnew(xn, "bind_material"), obj.MaterialBinding)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryMesh(xn *xmlx.Node, obj *cdom.GeometryMesh) {
+	xas(xn, "convex_hull_of", refId(obj.ConvexHullOf))
+	has_Sources(xn, &obj.HasSources)
+	if obj.Vertices != nil {
+		save_GeometryVertices(xnew(xn, "vertices"), obj.Vertices)
+	}
+	for _, p := range obj.Primitives {
+		save_GeometryPrimitives(xnew(xn, get_PrimitiveKind(p.Kind)), p)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryPolygonHole(xn *xmlx.Node, obj *cdom.GeometryPolygonHole) {
+	xnew(xn, "p").Value = list_Uints(obj.Indices)
+	for _, h := range obj.Holes {
+		xnew(xn, "h").Value = list_Uints(h)
+	}
+}
+
+func save_GeometryPositioning(xn *xmlx.Node, obj *cdom.GeometryPositioning) {
+	for _, o := range obj.Orientations {
+		save_GeometryBrepOrientation(xnew(xn, "orient"), o)
+	}
+	xv3(xn, "origin", obj.Origin)
+}
+
+func save_GeometryPrimitives(xn *xmlx.Node, obj *cdom.GeometryPrimitives) {
+	has_Name(xn, &obj.HasName)
+	xau64(xn, "count", obj.Count)
+	xas(xn, "material", obj.Material)
+	for _, in := range obj.Inputs {
+		save_InputShared(xnew(xn, "input"), in)
+	}
+	switch obj.Kind {
+	case cdom.GeometryPrimitiveKindLines, cdom.GeometryPrimitiveKindTriangles:
+		xnew(xn, "p").Value = list_Uints(obj.Indices)
+	case cdom.GeometryPrimitiveKindPolylist:
+		xnew(xn, "vcount").Value = list_Ints(obj.Vcount)
+		xnew(xn, "p").Value = list_Uints(obj.Indices)
+	default:
+		stride, offset := obj.Stride(), uint64(0)
+		if len(obj.Vcount) == 0 {
+			if len(obj.Indices) > 0 {
+				xnew(xn, "p").Value = list_Uints(obj.Indices)
+			}
+		} else {
+			for _, vc := range obj.Vcount {
+				num := uint64(vc) * stride
+				if offset+num > uint64(len(obj.Indices)) {
+					num = uint64(len(obj.Indices)) - offset
+				}
+				xnew(xn, "p").Value = list_Uints(obj.Indices[offset : offset+num])
+				offset += num
+			}
+		}
+		for _, ph := range obj.PolyHoles {
+			save_GeometryPolygonHole(xnew(xn, "ph"), ph)
+		}
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometrySpline(xn *xmlx.Node, obj *cdom.GeometrySpline) {
+	xab(xn, "closed", obj.Closed)
+	has_Sources(xn, &obj.HasSources)
+	save_GeometryControlVertices(xnew(xn, "control_vertices"), &obj.ControlVertices)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_GeometryVertices(xn *xmlx.Node, obj *cdom.GeometryVertices) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Inputs(xn, &obj.HasInputs)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_IndexedInputs(xn *xmlx.Node, obj *cdom.IndexedInputs) {
+	xau64(xn, "count", obj.Count)
+	for _, in := range obj.Inputs {
+		save_InputShared(xnew(xn, "input"), in)
+	}
+	if len(obj.Vcount) > 0 {
+		xnew(xn, "vcount").Value = list_Ints(obj.Vcount)
+	}
+	if len(obj.Indices) > 0 {
+		if xn.Name.Local == "vertex_weights" {
+			//	math.MaxUint64 (or any other index beyond int64) is the joint index -1, referring to the bind shape
+			ints := make([]int64, len(obj.Indices))
+			for i, v := range obj.Indices {
+				if ints[i] = int64(v); ints[i] < 0 {
+					ints[i] = -1
+				}
+			}
+			xnew(xn, "v").Value = list_Ints(ints)
+		} else {
+			xnew(xn, "p").Value = list_Uints(obj.Indices)
+		}
+	}
+}
+
+func save_Input(xn *xmlx.Node, obj *cdom.Input) {
+	xas(xn, "semantic", obj.Semantic)
+	xas(xn, "source", refId(obj.Source))
+}
+
+func save_InputShared(xn *xmlx.Node, obj *cdom.InputShared) {
+	xau64(xn, "offset", obj.Offset)
+	save_Input(xn, &obj.Input)
+	xau64p(xn, "set", obj.Set)
+}
+
+func save_KxArticulatedSystemDef(xn *xmlx.Node, obj *cdom.KxArticulatedSystemDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	if obj.Kinematics != nil {
+		save_KxKinematicsSystem(xnew(xn, "kinematics"), obj.Kinematics)
+	} else if obj.Motion != nil {
+		save_KxMotionSystem(xnew(xn, "motion"), obj.Motion)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxArticulatedSystemInst(xn *xmlx.Node, obj *cdom.KxArticulatedSystemInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	for _, b := range obj.Bindings {
+		save_KxBinding(xnew(xn, "bind"), b)
+	}
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxAttachment(xn *xmlx.Node, obj *cdom.KxAttachment) {
+	xas(xn, "joint", obj.Joint.S)
+	set_Transforms(xn, obj.Transforms)
+	if obj.Link != nil {
+		save_KxLink(xnew(xn, "link"), obj.Link)
+	}
+}
+
+func save_KxAxisIndex(xn *xmlx.Node, obj *cdom.KxAxisIndex) {
+	xas(xn, "semantic", obj.Semantic)
+	save_ParamOrInt(xn, &obj.I)
+}
+
+func save_KxAxisLimits(xn *xmlx.Node, obj *cdom.KxAxisLimits) {
+	save_ParamOrFloat(xnew(xn, "min"), &obj.Min)
+	save_ParamOrFloat(xnew(xn, "max"), &obj.Max)
+}
+
+func save_KxBinding(xn *xmlx.Node, obj *cdom.KxBinding) {
+	xas(xn, "symbol", obj.Symbol)
+	if len(obj.Param.S) > 0 {
+		xas(xnew(xn, "param"), "ref", obj.Param.S)
+	} else {
+		xv(xn, obj.Value)
+	}
+}
+
+func save_KxEffector(xn *xmlx.Node, obj *cdom.KxEffector) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	for _, b := range obj.Bindings {
+		save_KxBinding(xnew(xn, "bind"), b)
+	}
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	for _, pf := range []struct {
+		n string
+		f *cdom.ParamOrFloat2
+	}{{"speed", obj.Speed}, {"acceleration", obj.Acceleration}, {"deceleration", obj.Deceleration}, {"jerk", obj.Jerk}} {
+		if pf.f != nil {
+			save_ParamOrFloat2(xnew(xn, pf.n), pf.f)
+		}
+	}
+}
+
+func save_KxFrame(xn *xmlx.Node, obj *cdom.KxFrame) {
+	xas(xn, "link", obj.Link.S)
+	set_Transforms(xn, obj.Transforms)
+}
+
+func save_KxJoint(xn *xmlx.Node, obj *cdom.KxJoint) {
+	has_Sid(xn, &obj.HasSid)
+	an := xnew(xn, "axis")
+	has_Sid(an, &obj.Axis.HasSid)
+	has_Name(an, &obj.Axis.HasName)
+	an.Value = list_Vec3(&obj.Axis.Vec3)
+	if obj.Limits != nil {
+		save_KxJointLimits(xnew(xn, "limits"), obj.Limits)
+	}
+}
+
+func save_KxJointAxisBinding(xn *xmlx.Node, obj *cdom.KxJointAxisBinding) {
+	xas(xn, "target", obj.Target.S)
+	save_ParamOrRefSid(xnew(xn, "axis"), &obj.Axis)
+	save_ParamOrFloat(xnew(xn, "value"), &obj.Value)
+}
+
+func save_KxJointDef(xn *xmlx.Node, obj *cdom.KxJointDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	has_Asset(xn, &obj.HasAsset)
+	for _, j := range obj.All {
+		switch j.Kind {
+		case cdom.KxJointKindPrismatic:
+			save_KxJoint(xnew(xn, "prismatic"), j)
+		case cdom.KxJointKindRevolute:
+			save_KxJoint(xnew(xn, "revolute"), j)
+		}
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxJointInst(xn *xmlx.Node, obj *cdom.KxJointInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxJointLimits(xn *xmlx.Node, obj *cdom.KxJointLimits) {
+	if obj.Min != nil {
+		save_SidFloat(xnew(xn, "min"), obj.Min)
+	}
+	if obj.Max != nil {
+		save_SidFloat(xnew(xn, "max"), obj.Max)
+	}
+}
+
+func save_KxKinematicsAxis(xn *xmlx.Node, obj *cdom.KxKinematicsAxis) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	xas(xn, "axis", obj.Axis.S)
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	save_ParamOrBool(xnew(xn, "active"), &obj.Active)
+	save_ParamOrBool(xnew(xn, "locked"), &obj.Locked)
+	for _, ai := range obj.Indices {
+		save_KxAxisIndex(xnew(xn, "index"), ai)
+	}
+	if obj.Limits != nil {
+		save_KxAxisLimits(xnew(xn, "limits"), obj.Limits)
+	}
+	for i := 0; i < len(obj.Formulas); i++ {
+		save_Formula(xn, &obj.Formulas[i])
+	}
+}
+
+func save_KxKinematicsSystem(xn *xmlx.Node, obj *cdom.KxKinematicsSystem) {
+	for _, inst := range obj.Models {
+		save_KxModelInst(xnew(xn, "instance_kinematics_model"), inst)
+	}
+	tcn := node_TechCommon(xn)
+	for _, ai := range obj.TC.AxisInfos {
+		save_KxKinematicsAxis(xnew(tcn, "axis_info"), ai)
+	}
+	save_KxFrame(xnew(tcn, "frame_origin"), &obj.TC.Frame.Origin.KxFrame)
+	save_KxFrame(xnew(tcn, "frame_tip"), &obj.TC.Frame.Tip.KxFrame)
+	if obj.TC.Frame.Tcp != nil {
+		save_KxFrame(xnew(tcn, "frame_tcp"), &obj.TC.Frame.Tcp.KxFrame)
+	}
+	if obj.TC.Frame.Object != nil {
+		save_KxFrame(xnew(tcn, "frame_object"), &obj.TC.Frame.Object.KxFrame)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+}
+
+func save_KxLink(xn *xmlx.Node, obj *cdom.KxLink) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	set_Transforms(xn, obj.Transforms)
+	for _, a := range obj.Attachments {
+		switch a.Kind {
+		case cdom.KxAttachmentKindFull:
+			save_KxAttachment(xnew(xn, "attachment_full"), a)
+		case cdom.KxAttachmentKindStart:
+			save_KxAttachment(xnew(xn, "attachment_start"), a)
+		case cdom.KxAttachmentKindEnd:
+			save_KxAttachment(xnew(xn, "attachment_end"), a)
+		}
+	}
+}
+
+func save_KxModelBinding(xn *xmlx.Node, obj *cdom.KxModelBinding) {
+	xas(xn, "node", obj.Node.S())
+	if len(obj.Model.ParamRef.S) > 0 {
+		xs(xn, "param", obj.Model.ParamRef.S)
+	} else {
+		xs(xn, "SIDREF", obj.Model.SidRef.S)
+	}
+}
+
+func save_KxModelDef(xn *xmlx.Node, obj *cdom.KxModelDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	tcn := node_TechCommon(xn)
+	has_ParamDefs(tcn, &obj.TC.HasParamDefs)
+	for _, l := range obj.TC.Links {
+		save_KxLink(xnew(tcn, "link"), l)
+	}
+	for i := 0; i < len(obj.TC.Formulas); i++ {
+		save_Formula(tcn, &obj.TC.Formulas[i])
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxModelInst(xn *xmlx.Node, obj *cdom.KxModelInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	for _, b := range obj.Bindings {
+		save_KxBinding(xnew(xn, "bind"), b)
+	}
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxMotionAxis(xn *xmlx.Node, obj *cdom.KxMotionAxis) {
+	has_Sid(xn, &obj.HasSid)
+	has_Name(xn, &obj.HasName)
+	xas(xn, "axis", obj.Axis.S)
+	for _, b := range obj.Bindings {
+		save_KxBinding(xnew(xn, "bind"), b)
+	}
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	for _, pf := range []struct {
+		n string
+		f *cdom.ParamOrFloat
+	}{{"speed", obj.Speed}, {"acceleration", obj.Acceleration}, {"deceleration", obj.Deceleration}, {"jerk", obj.Jerk}} {
+		if pf.f != nil {
+			save_ParamOrFloat(xnew(xn, pf.n), pf.f)
+		}
+	}
+}
+
+func save_KxMotionSystem(xn *xmlx.Node, obj *cdom.KxMotionSystem) {
+	if obj.ArticulatedSystem != nil {
+		save_KxArticulatedSystemInst(xnew(xn, "instance_articulated_system"), obj.ArticulatedSystem)
+	}
+	tcn := node_TechCommon(xn)
+	for _, ai := range obj.TC.AxisInfos {
+		save_KxMotionAxis(xnew(tcn, "axis_info"), ai)
+	}
+	if obj.TC.EffectorInfo != nil {
+		save_KxEffector(xnew(tcn, "effector_info"), obj.TC.EffectorInfo)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+}
+
+func save_KxSceneDef(xn *xmlx.Node, obj *cdom.KxSceneDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, inst := range obj.Models {
+		save_KxModelInst(xnew(xn, "instance_kinematics_model"), inst)
+	}
+	for _, inst := range obj.ArticulatedSystems {
+		save_KxArticulatedSystemInst(xnew(xn, "instance_articulated_system"), inst)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_KxSceneInst(xn *xmlx.Node, obj *cdom.KxSceneInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_ParamDefs(xn, &obj.HasParamDefs)
+	has_ParamInsts(xn, &obj.HasParamInsts)
+	for _, mb := range obj.ModelBindings {
+		save_KxModelBinding(xnew(xn, "bind_kinematics_model"), mb)
+	}
+	for _, jab := range obj.JointAxisBindings {
+		save_KxJointAxisBinding(xnew(xn, "bind_joint_axis"), jab)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_LightAttenuation(xn *xmlx.Node, obj *cdom.LightAttenuation) {
+	save_SidFloat(xnew(xn, "constant_attenuation"), &obj.Constant)
+	save_SidFloat(xnew(xn, "linear_attenuation"), &obj.Linear)
+	save_SidFloat(xnew(xn, "quadratic_attenuation"), &obj.Quadratic)
+}
+
+func save_LightDef(xn *xmlx.Node, obj *cdom.LightDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	tcn := node_TechCommon(xn)
+	if tc := &obj.TC; tc.Ambient != nil {
+		set_LightColor(xnew(tcn, "ambient"), &tc.Ambient.Color)
+	} else if tc.Directional != nil {
+		set_LightColor(xnew(tcn, "directional"), &tc.Directional.Color)
+	} else if tc.Point != nil {
+		pn := xnew(tcn, "point")
+		set_LightColor(pn, &tc.Point.Color)
+		save_LightAttenuation(pn, &tc.Point.Attenuation)
+	} else if tc.Spot != nil {
+		sn := xnew(tcn, "spot")
+		set_LightColor(sn, &tc.Spot.Color)
+		save_LightAttenuation(sn, &tc.Spot.Attenuation)
+		save_SidFloat(xnew(sn, "falloff_angle"), &tc.Spot.Falloff.Angle)
+		save_SidFloat(xnew(sn, "falloff_exponent"), &tc.Spot.Falloff.Exponent)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_LightInst(xn *xmlx.Node, obj *cdom.LightInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_MaterialBinding(xn *xmlx.Node, obj *cdom.MaterialBinding) {
+	for _, p := range obj.Params {
+		save_Param(xnew(xn, "param"), p)
+	}
+	tcn := node_TechCommon(xn)
+	for _, inst := range obj.TC.Materials {
+		save_FxMaterialInst(xnew(tcn, "instance_material"), inst)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_NodeDef(xn *xmlx.Node, obj *cdom.NodeDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	if obj.IsSkinJoint {
+		xas(xn, "type", "JOINT")
+	}
+	xas(xn, "layer", list_Strings(xkeys(obj.Layers)))
+	has_Asset(xn, &obj.HasAsset)
+	set_Transforms(xn, obj.Transforms)
+	for _, inst := range obj.Insts.Camera {
+		save_CameraInst(xnew(xn, "instance_camera"), inst)
+	}
+	for _, inst := range obj.Insts.Controller {
+		save_ControllerInst(xnew(xn, "instance_controller"), inst)
+	}
+	for _, inst := range obj.Insts.Geometry {
+		save_GeometryInst(xnew(xn, "instance_geometry"), inst)
+	}
+	for _, inst := range obj.Insts.Light {
+		save_LightInst(xnew(xn, "instance_light"), inst)
+	}
+	for i := 0; i < len(obj.Nodes); i++ {
+		save_ChildNode(xn, &obj.Nodes[i])
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_NodeInst(xn *xmlx.Node, obj *cdom.NodeInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	xas(xn, "proxy", refId(obj.Proxy))
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_Param(xn *xmlx.Node, obj *cdom.Param) {
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	xas(xn, "semantic", obj.Semantic)
+	xas(xn, "type", obj.Type)
+}
+
+func save_ParamDef(xn *xmlx.Node, obj *cdom.ParamDef) {
+	has_Sid(xn, &obj.HasSid)
+	xv(xn, obj.Value)
+}
+
+func save_ParamInst(xn *xmlx.Node, obj *cdom.ParamInst) {
+	xas(xn, "ref", obj.Ref.S)
+	if s, ok := obj.Value.(string); ok && obj.IsConnectParamRef {
+		xas(xnew(xn, "connect_param"), "ref", s)
+	} else {
+		xv(xn, obj.Value)
+	}
+}
+
+func save_ParamOrBool(xn *xmlx.Node, obj *cdom.ParamOrBool) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xb(xn, "bool", obj.B)
+	}
+}
+
+func save_ParamOrFloat(xn *xmlx.Node, obj *cdom.ParamOrFloat) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xf64(xn, "float", obj.F)
+	}
+}
+
+func save_ParamOrFloat2(xn *xmlx.Node, obj *cdom.ParamOrFloat2) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xnew(xn, "float2").Value = list_Floats(obj.F[:])
+	}
+}
+
+func save_ParamOrInt(xn *xmlx.Node, obj *cdom.ParamOrInt) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xi64(xn, "int", obj.I)
+	}
+}
+
+func save_ParamOrRefSid(xn *xmlx.Node, obj *cdom.ParamOrRefSid) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xs(xn, "SIDREF", obj.Sr.S)
+	}
+}
+
+func save_ParamOrSidFloat(xn *xmlx.Node, obj *cdom.ParamOrSidFloat) {
+	if len(obj.Param.S) > 0 {
+		xas(xnew(xn, "param"), "ref", obj.Param.S)
+	} else {
+		save_SidFloat(xnew(xn, "float"), &obj.F)
+	}
+}
+
+func save_ParamOrUint(xn *xmlx.Node, obj *cdom.ParamOrUint) {
+	if len(obj.Param.S) > 0 {
+		xs(xn, "param", obj.Param.S)
+	} else {
+		xu64(xn, "uint", obj.U)
+	}
+}
+
+func save_PxCylinder(xn *xmlx.Node, obj *cdom.PxCylinder) {
+	xf64(xn, "height", obj.Height)
+	save_GeometryBrepCylinder(xn, &obj.GeometryBrepCylinder)
+}
+
+func save_PxForceFieldDef(xn *xmlx.Node, obj *cdom.PxForceFieldDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxForceFieldInst(xn *xmlx.Node, obj *cdom.PxForceFieldInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxMaterial(xn *xmlx.Node, obj *cdom.PxMaterial) {
+	if obj.Def != nil {
+		save_PxMaterialDef(xnew(xn, "physics_material"), obj.Def)
+	} else if obj.Inst != nil {
+		save_PxMaterialInst(xnew(xn, "instance_physics_material"), obj.Inst)
+	}
+}
+
+func save_PxMaterialDef(xn *xmlx.Node, obj *cdom.PxMaterialDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	tcn := node_TechCommon(xn)
+	save_SidFloat(xnew(tcn, "dynamic_friction"), &obj.TC.DynamicFriction)
+	save_SidFloat(xnew(tcn, "restitution"), &obj.TC.Restitution)
+	save_SidFloat(xnew(tcn, "static_friction"), &obj.TC.StaticFriction)
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxMaterialInst(xn *xmlx.Node, obj *cdom.PxMaterialInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxModelDef(xn *xmlx.Node, obj *cdom.PxModelDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, sid := range xkeys(obj.RigidBodies) {
+		save_PxRigidBodyDef(xnew(xn, "rigid_body"), obj.RigidBodies[sid])
+	}
+	for _, sid := range xkeys(obj.RigidConstraints) {
+		save_PxRigidConstraintDef(xnew(xn, "rigid_constraint"), obj.RigidConstraints[sid])
+	}
+	for _, inst := range obj.Insts {
+		save_PxModelInst(xnew(xn, "instance_physics_model"), inst)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxModelInst(xn *xmlx.Node, obj *cdom.PxModelInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	xas(xn, "parent", refId(obj.Parent))
+	for _, inst := range obj.ForceFields {
+		save_PxForceFieldInst(xnew(xn, "instance_force_field"), inst)
+	}
+	for _, inst := range obj.RigidBodies {
+		save_PxRigidBodyInst(xnew(xn, "instance_rigid_body"), inst)
+	}
+	for _, inst := range obj.RigidConstraints {
+		save_PxRigidConstraintInst(xnew(xn, "instance_rigid_constraint"), inst)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidBodyCommon(xn *xmlx.Node, obj *cdom.PxRigidBodyCommon) {
+	save_SidBool(xnew(xn, "dynamic"), &obj.Dynamic)
+	if obj.Mass != nil {
+		save_SidFloat(xnew(xn, "mass"), obj.Mass)
+	}
+	if len(obj.MassFrame) > 0 {
+		set_Transforms(xnew(xn, "mass_frame"), obj.MassFrame)
+	}
+	if obj.Inertia != nil {
+		save_SidFloat3(xnew(xn, "inertia"), obj.Inertia)
+	}
+	save_PxMaterial(xn, &obj.Material)
+	for _, s := range obj.Shapes {
+		save_PxShape(xnew(xn, "shape"), s)
+	}
+}
+
+func save_PxRigidBodyDef(xn *xmlx.Node, obj *cdom.PxRigidBodyDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	has_Asset(xn, &obj.HasAsset)
+	save_PxRigidBodyCommon(node_TechCommon(xn), &obj.TC.PxRigidBodyCommon)
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidBodyInst(xn *xmlx.Node, obj *cdom.PxRigidBodyInst) {
+	save_BaseInst(xn, &obj.BaseInst, "body")
+	xas(xn, "target", refId(obj.TargetNode))
+	tcn := node_TechCommon(xn)
+	xv3(tcn, "angular_velocity", &obj.TC.AngularVelocity)
+	xv3(tcn, "velocity", &obj.TC.LinearVelocity)
+	save_PxRigidBodyCommon(tcn, &obj.TC.PxRigidBodyCommon)
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidConstraintAttachment(xn *xmlx.Node, obj *cdom.PxRigidConstraintAttachment) {
+	xas(xn, "rigid_body", obj.RigidBody.S)
+	set_Transforms(xn, obj.Transforms)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidConstraintDef(xn *xmlx.Node, obj *cdom.PxRigidConstraintDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	has_Asset(xn, &obj.HasAsset)
+	save_PxRigidConstraintAttachment(xnew(xn, "ref_attachment"), &obj.RefAttachment)
+	save_PxRigidConstraintAttachment(xnew(xn, "attachment"), &obj.Attachment)
+	tcn := node_TechCommon(xn)
+	save_SidBool(xnew(tcn, "enabled"), &obj.TC.Enabled)
+	save_SidBool(xnew(tcn, "interpenetrate"), &obj.TC.Interpenetrate)
+	if l := &obj.TC.Limits; (l.Angular != nil) || (l.Linear != nil) {
+		ln := xnew(tcn, "limits")
+		if l.Angular != nil {
+			save_PxRigidConstraintLimit(xnew(ln, "swing_cone_and_twist"), l.Angular)
+		}
+		if l.Linear != nil {
+			save_PxRigidConstraintLimit(xnew(ln, "linear"), l.Linear)
+		}
+	}
+	if s := &obj.TC.Spring; (s.Angular != nil) || (s.Linear != nil) {
+		sn := xnew(tcn, "spring")
+		if s.Angular != nil {
+			save_PxRigidConstraintSpring(xnew(sn, "angular"), s.Angular)
+		}
+		if s.Linear != nil {
+			save_PxRigidConstraintSpring(xnew(sn, "linear"), s.Linear)
+		}
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidConstraintInst(xn *xmlx.Node, obj *cdom.PxRigidConstraintInst) {
+	save_BaseInst(xn, &obj.BaseInst, "constraint")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxRigidConstraintLimit(xn *xmlx.Node, obj *cdom.PxRigidConstraintLimit) {
+	save_SidVec3(xnew(xn, "min"), &obj.Min)
+	save_SidVec3(xnew(xn, "max"), &obj.Max)
+}
+
+func save_PxRigidConstraintSpring(xn *xmlx.Node, obj *cdom.PxRigidConstraintSpring) {
+	save_SidFloat(xnew(xn, "stiffness"), &obj.Stiffness)
+	save_SidFloat(xnew(xn, "damping"), &obj.Damping)
+	save_SidFloat(xnew(xn, "target_value"), &obj.TargetValue)
+}
+
+func save_PxSceneDef(xn *xmlx.Node, obj *cdom.PxSceneDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, inst := range obj.ForceFields {
+		save_PxForceFieldInst(xnew(xn, "instance_force_field"), inst)
+	}
+	for _, inst := range obj.Models {
+		save_PxModelInst(xnew(xn, "instance_physics_model"), inst)
+	}
+	tcn := node_TechCommon(xn)
+	if obj.TC.Gravity != nil {
+		save_SidVec3(xnew(tcn, "gravity"), obj.TC.Gravity)
+	}
+	if obj.TC.TimeStep != nil {
+		save_SidFloat(xnew(tcn, "time_step"), obj.TC.TimeStep)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxSceneInst(xn *xmlx.Node, obj *cdom.PxSceneInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_PxShape(xn *xmlx.Node, obj *cdom.PxShape) {
+	save_SidBool(xnew(xn, "hollow"), &obj.Hollow)
+	if obj.Mass != nil {
+		save_SidFloat(xnew(xn, "mass"), obj.Mass)
+	}
+	if obj.Density != nil {
+		save_SidFloat(xnew(xn, "density"), obj.Density)
+	}
+	save_PxMaterial(xn, &obj.Material)
+	if g := &obj.Geometry; g.Inst != nil {
+		save_GeometryInst(xnew(xn, "instance_geometry"), g.Inst)
+	} else if g.Plane != nil {
+		save_GeometryBrepPlane(xnew(xn, "plane"), g.Plane)
+	} else if g.Box != nil {
+		save_GeometryBrepBox(xnew(xn, "box"), g.Box)
+	} else if g.Sphere != nil {
+		save_GeometryBrepSphere(xnew(xn, "sphere"), g.Sphere)
+	} else if g.Cylinder != nil {
+		save_PxCylinder(xnew(xn, "cylinder"), g.Cylinder)
+	} else if g.Capsule != nil {
+		save_GeometryBrepCapsule(xnew(xn, "capsule"), g.Capsule)
+	}
+	set_Transforms(xn, obj.Transforms)
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_Scene(xn *xmlx.Node, obj *cdom.Scene) {
+	for _, inst := range obj.Physics {
+		save_PxSceneInst(xnew(xn, "instance_physics_scene"), inst)
+	}
+	if obj.Visual != nil {
+		save_VisualSceneInst(xnew(xn, "instance_visual_scene"), obj.Visual)
+	}
+	if obj.Kinematics != nil {
+		save_KxSceneInst(xnew(xn, "instance_kinematics_scene"), obj.Kinematics)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_SidBool(xn *xmlx.Node, obj *cdom.SidBool) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = list_Bools([]bool{obj.B})
+}
+
+func save_SidFloat(xn *xmlx.Node, obj *cdom.SidFloat) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = xf64s(obj.F)
+}
+
+func save_SidFloat3(xn *xmlx.Node, obj *cdom.SidFloat3) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = list_Floats(obj.F[:])
+}
+
+func save_SidString(xn *xmlx.Node, obj *cdom.SidString) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = obj.S
+}
+
+func save_SidVec3(xn *xmlx.Node, obj *cdom.SidVec3) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = list_Vec3(&obj.Vec3)
+}
+
+func save_Source(xn *xmlx.Node, obj *cdom.Source) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	save_SourceArray(xn, &obj.Array)
+	if obj.TC.Accessor != nil {
+		save_SourceAccessor(xnew(node_TechCommon(xn), "accessor"), obj.TC.Accessor)
+	}
+	has_Techniques(xn, &obj.HasTechniques)
+}
+
+func save_SourceAccessor(xn *xmlx.Node, obj *cdom.SourceAccessor) {
+	xau64(xn, "count", obj.Count)
+	if obj.Offset > 0 {
+		xau64(xn, "offset", obj.Offset)
+	}
+	xas(xn, "source", refId(obj.Source))
+	xau64(xn, "stride", obj.Stride)
+	for _, p := range obj.Params {
+		save_Param(xnew(xn, "param"), p)
+	}
+}
+
+func save_SourceArray(xn *xmlx.Node, obj *cdom.SourceArray) {
+	var (
+		an  *xmlx.Node
+		num int
+	)
+	switch {
+	case obj.Bools != nil:
+		an, num = xnew(xn, "bool_array"), len(obj.Bools)
+		an.Value = list_Bools(obj.Bools)
+	case obj.Floats != nil:
+		an, num = xnew(xn, "float_array"), len(obj.Floats)
+		an.Value = list_Floats(obj.Floats)
+	case obj.IdRefs != nil:
+		an, num = xnew(xn, "IDREF_array"), len(obj.IdRefs)
+		an.Value = list_Strings(obj.IdRefs)
+	case obj.Ints != nil:
+		an, num = xnew(xn, "int_array"), len(obj.Ints)
+		an.Value = list_Ints(obj.Ints)
+	case obj.Names != nil:
+		an, num = xnew(xn, "Name_array"), len(obj.Names)
+		an.Value = list_Strings(obj.Names)
+	case obj.SidRefs != nil:
+		an, num = xnew(xn, "SIDREF_array"), len(obj.SidRefs)
+		an.Value = list_Strings(obj.SidRefs)
+	case obj.Tokens != nil:
+		an, num = xnew(xn, "token_array"), len(obj.Tokens)
+		an.Value = list_Strings(obj.Tokens)
+	}
+	if an != nil {
+		has_Id(an, &obj.HasId)
+		has_Name(an, &obj.HasName)
+		xau64(an, "count", uint64(num))
+	}
+}
+
+func save_Technique(xn *xmlx.Node, obj *cdom.Technique) {
+	xas(xn, "profile", obj.Profile)
+}
+
+func save_Transform(xn *xmlx.Node, obj *cdom.Transform) {
+	has_Sid(xn, &obj.HasSid)
+	xn.Value = list_Floats(obj.F)
+}
+
+func save_VisualSceneDef(xn *xmlx.Node, obj *cdom.VisualSceneDef) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Asset(xn, &obj.HasAsset)
+	for _, n := range obj.Nodes {
+		save_NodeDef(xnew(xn, "node"), n)
+	}
+	for _, ev := range obj.Evaluations {
+		save_VisualSceneEvaluation(xnew(xn, "evaluate_scene"), ev)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_VisualSceneEvaluation(xn *xmlx.Node, obj *cdom.VisualSceneEvaluation) {
+	has_Id(xn, &obj.HasId)
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	if obj.Disabled {
+		xab(xn, "enable", false)
+	}
+	has_Asset(xn, &obj.HasAsset)
+	for _, rp := range obj.RenderPasses {
+		save_VisualSceneRendering(xnew(xn, "render"), rp)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_VisualSceneInst(xn *xmlx.Node, obj *cdom.VisualSceneInst) {
+	save_BaseInst(xn, &obj.BaseInst, "url")
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_VisualSceneRendering(xn *xmlx.Node, obj *cdom.VisualSceneRendering) {
+	has_Name(xn, &obj.HasName)
+	has_Sid(xn, &obj.HasSid)
+	xas(xn, "camera_node", refId(obj.CameraNode))
+	for _, l := range xkeys(obj.Layers) {
+		xnew(xn, "layer").Value = l
+	}
+	if obj.MaterialInst != nil {
+		save_VisualSceneRenderingMaterialInst(xnew(xn, "instance_material"), obj.MaterialInst)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
+
+func save_VisualSceneRenderingMaterialInst(xn *xmlx.Node, obj *cdom.VisualSceneRenderingMaterialInst) {
+	if ot := &obj.OverrideTechnique; len(ot.Ref.S) > 0 {
+		tn := xnew(xn, "technique_override")
+		xas(tn, "ref", ot.Ref.S)
+		xas(tn, "pass", ot.Pass.S)
+	}
+	for _, b := range obj.Bindings {
+		save_FxBinding(xnew(xn, "bind"), b)
+	}
+	has_Extras(xn, &obj.HasExtras)
+}
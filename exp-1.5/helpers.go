@@ -0,0 +1,554 @@
+package collexp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	"github.com/metaleap/go-util/gfx"
+	"github.com/metaleap/go-util/num"
+)
+
+const (
+	xmlns = "http://www.collada.org/2008/03/COLLADASchema"
+)
+
+//	The save_* functions panic with an exportError for data that cannot be exported, and ExportCollada() returns its err.
+type exportError struct {
+	err error
+}
+
+func has_Asset(xn *xmlx.Node, obj *cdom.HasAsset) {
+	if obj.Asset != nil {
+		save_Asset(xnew(xn, "asset"), obj.Asset)
+	}
+}
+
+func has_Extras(xn *xmlx.Node, obj *cdom.HasExtras) {
+	for _, ex := range obj.Extras {
+		if ex != nil {
+			save_Extra(xnew(xn, "extra"), ex)
+		}
+	}
+}
+
+func has_FxParamDefs(xn *xmlx.Node, obj *cdom.HasFxParamDefs) {
+	for _, sid := range xkeys(obj.NewParams) {
+		if np := obj.NewParams[sid]; np != nil {
+			save_FxParamDef(xnew(xn, "newparam"), np)
+		}
+	}
+}
+
+func has_Id(xn *xmlx.Node, obj *cdom.HasId) {
+	xas(xn, "id", obj.Id)
+}
+
+func has_Inputs(xn *xmlx.Node, obj *cdom.HasInputs) {
+	for _, in := range obj.Inputs {
+		if in != nil {
+			save_Input(xnew(xn, "input"), in)
+		}
+	}
+}
+
+func has_Name(xn *xmlx.Node, obj *cdom.HasName) {
+	xas(xn, "name", obj.Name)
+}
+
+func has_ParamDefs(xn *xmlx.Node, obj *cdom.HasParamDefs) {
+	for _, sid := range xkeys(obj.NewParams) {
+		if np := obj.NewParams[sid]; np != nil {
+			save_ParamDef(xnew(xn, "newparam"), np)
+		}
+	}
+}
+
+func has_ParamInsts(xn *xmlx.Node, obj *cdom.HasParamInsts) {
+	for _, ref := range xkeys(obj.SetParams) {
+		if sp := obj.SetParams[ref]; sp != nil {
+			save_ParamInst(xnew(xn, "setparam"), sp)
+		}
+	}
+}
+
+func has_Sid(xn *xmlx.Node, obj *cdom.HasSid) {
+	xas(xn, "sid", obj.Sid)
+}
+
+func has_Sources(xn *xmlx.Node, obj *cdom.HasSources) {
+	for _, id := range xkeys(obj.Sources) {
+		if src := obj.Sources[id]; src != nil {
+			save_Source(xnew(xn, "source"), src)
+		}
+	}
+}
+
+func has_Techniques(xn *xmlx.Node, obj *cdom.HasTechniques) {
+	for _, t := range obj.Techniques {
+		if t != nil {
+			if len(strings.TrimSpace(t.Data)) == 0 {
+				xas(xnew(xn, "technique"), "profile", t.Profile)
+			} else {
+				xraw(xn, t.Data, "technique '"+t.Profile+"'")
+			}
+		}
+	}
+}
+
+func list_Bools(sl []bool) string {
+	s := make([]string, len(sl))
+	for i, b := range sl {
+		s[i] = strconv.FormatBool(b)
+	}
+	return list_Strings(s)
+}
+
+func list_Floats(sl []float64) string {
+	s := make([]string, len(sl))
+	for i, f := range sl {
+		s[i] = xf64s(f)
+	}
+	return list_Strings(s)
+}
+
+func list_Ints(sl []int64) string {
+	s := make([]string, len(sl))
+	for i, n := range sl {
+		s[i] = strconv.FormatInt(n, 10)
+	}
+	return list_Strings(s)
+}
+
+func list_Rgba32(obj *ugfx.Rgba32) string {
+	return list_Floats([]float64{float64(obj.R), float64(obj.G), float64(obj.B), float64(obj.A)})
+}
+
+func list_Strings(sl []string) string {
+	return strings.Join(sl, " ")
+}
+
+func list_Uints(sl []uint64) string {
+	s := make([]string, len(sl))
+	for i, n := range sl {
+		s[i] = strconv.FormatUint(n, 10)
+	}
+	return list_Strings(s)
+}
+
+func list_Vec3(v *unum.Vec3) string {
+	return list_Floats([]float64{v.X, v.Y, v.Z})
+}
+
+func node_TechCommon(xn *xmlx.Node) *xmlx.Node {
+	return xnew(xn, "technique_common")
+}
+
+func refId(ref cdom.RefId) (v string) {
+	if v = ref.S(); (len(v) > 0) && !strings.Contains(v, "#") {
+		v = "#" + v
+	}
+	return
+}
+
+func setInstDefRef(xn *xmlx.Node, inst *cdom.BaseInst, att string) {
+	if (att == "body") || (att == "constraint") {
+		xas(xn, att, inst.DefRef.S())
+	} else {
+		xas(xn, att, refId(inst.DefRef))
+	}
+}
+
+func set_LightColor(xn *xmlx.Node, c *cdom.Float3) {
+	xnew(xn, "color").Value = list_Floats(c[:])
+}
+
+func set_Transforms(xn *xmlx.Node, ts []*cdom.Transform) {
+	for _, t := range ts {
+		if (t != nil) && (t.Kind > 0) {
+			save_Transform(xnew(xn, get_TransformKind(t.Kind)), t)
+		}
+	}
+}
+
+func xab(xn *xmlx.Node, name string, v bool) {
+	xas(xn, name, strconv.FormatBool(v))
+}
+
+func xaf64(xn *xmlx.Node, name string, v float64) {
+	xas(xn, name, xf64s(v))
+}
+
+func xas(xn *xmlx.Node, name string, v string) {
+	if len(v) > 0 {
+		att := &xmlx.Attr{Value: v}
+		att.Name.Local = name
+		xn.Attributes = append(xn.Attributes, att)
+	}
+}
+
+func xau64(xn *xmlx.Node, name string, v uint64) {
+	xas(xn, name, strconv.FormatUint(v, 10))
+}
+
+func xau64p(xn *xmlx.Node, name string, v *uint64) {
+	if v != nil {
+		xau64(xn, name, *v)
+	}
+}
+
+func xb(xn *xmlx.Node, name string, v bool) {
+	xnew(xn, name).Value = strconv.FormatBool(v)
+}
+
+func xf64(xn *xmlx.Node, name string, v float64) {
+	xnew(xn, name).Value = xf64s(v)
+}
+
+func xf64s(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func xi64(xn *xmlx.Node, name string, v int64) {
+	xnew(xn, name).Value = strconv.FormatInt(v, 10)
+}
+
+func xkeys(m interface{}) (keys []string) {
+	mv := reflect.ValueOf(m)
+	keys = make([]string, 0, mv.Len())
+	for _, kv := range mv.MapKeys() {
+		keys = append(keys, kv.String())
+	}
+	sort.Strings(keys)
+	return
+}
+
+func xm4(xn *xmlx.Node, name string, mat *unum.Mat4) {
+	xnew(xn, name).Value = list_Floats(mat[:])
+}
+
+func xnew(xn *xmlx.Node, name string) (cn *xmlx.Node) {
+	cn = xmlx.NewNode(xmlx.NT_ELEMENT)
+	cn.Name.Local = name
+	xn.AddChild(cn)
+	return
+}
+
+//	Adds the elements of the raw XML data to xn. If data holds none, panics with an exportError naming what data belongs to.
+func xraw(xn *xmlx.Node, data string, what string) {
+	var added bool
+	doc := xmlx.New()
+	err := doc.LoadBytes([]byte(strings.Replace(data, xmlns+":", "", -1)), nil)
+	if (err == nil) && (doc.Root != nil) {
+		for _, cn := range doc.Root.Children {
+			if cn.Type == xmlx.NT_ELEMENT {
+				xrawClean(cn)
+				xn.AddChild(cn)
+				added = true
+			}
+		}
+	}
+	if (err == nil) && !added {
+		err = errors.New("no XML element")
+	}
+	if err != nil {
+		panic(exportError{fmt.Errorf("%s: invalid raw XML: %s", what, err.Error())})
+	}
+}
+
+func xrawClean(xn *xmlx.Node) {
+	xn.Name.Space = ""
+	for _, att := range xn.Attributes {
+		if att.Name.Space == xmlns {
+			att.Name.Space = ""
+		}
+	}
+	for _, cn := range xn.Children {
+		xrawClean(cn)
+	}
+}
+
+func xs(xn *xmlx.Node, name string, v string) {
+	if len(v) > 0 {
+		xnew(xn, name).Value = v
+	}
+}
+
+func xu64(xn *xmlx.Node, name string, v uint64) {
+	xnew(xn, name).Value = strconv.FormatUint(v, 10)
+}
+
+func xv(xn *xmlx.Node, val interface{}) {
+	switch v := val.(type) {
+	case []interface{}:
+		an := xnew(xn, "array")
+		xau64(an, "length", uint64(len(v)))
+		for _, av := range v {
+			xv(an, av)
+		}
+	case bool:
+		xb(xn, "bool", v)
+	case cdom.Bool2:
+		xnew(xn, "bool2").Value = list_Bools(v[:])
+	case cdom.Bool3:
+		xnew(xn, "bool3").Value = list_Bools(v[:])
+	case cdom.Bool4:
+		xnew(xn, "bool4").Value = list_Bools(v[:])
+	case float64:
+		xf64(xn, "float", v)
+	case cdom.Float2:
+		xnew(xn, "float2").Value = list_Floats(v[:])
+	case cdom.Float3:
+		xnew(xn, "float3").Value = list_Floats(v[:])
+	case cdom.Float4:
+		xnew(xn, "float4").Value = list_Floats(v[:])
+	case cdom.Float2x2:
+		xnew(xn, "float2x2").Value = list_Floats(v[:])
+	case cdom.Float2x3:
+		xnew(xn, "float2x3").Value = list_Floats(v[:])
+	case cdom.Float2x4:
+		xnew(xn, "float2x4").Value = list_Floats(v[:])
+	case cdom.Float3x2:
+		xnew(xn, "float3x2").Value = list_Floats(v[:])
+	case cdom.Float3x3:
+		xnew(xn, "float3x3").Value = list_Floats(v[:])
+	case cdom.Float3x4:
+		xnew(xn, "float3x4").Value = list_Floats(v[:])
+	case cdom.Float4x2:
+		xnew(xn, "float4x2").Value = list_Floats(v[:])
+	case cdom.Float4x3:
+		xnew(xn, "float4x3").Value = list_Floats(v[:])
+	case cdom.Float4x4:
+		xnew(xn, "float4x4").Value = list_Floats(v[:])
+	case int64:
+		xi64(xn, "int", v)
+	case cdom.Int2:
+		xnew(xn, "int2").Value = list_Ints(v[:])
+	case cdom.Int3:
+		xnew(xn, "int3").Value = list_Ints(v[:])
+	case cdom.Int4:
+		xnew(xn, "int4").Value = list_Ints(v[:])
+	case *cdom.FxSampler:
+		save_FxSampler(xnew(xn, get_SamplerKind(v.Kind)), v)
+	case *cdom.FxSamplerImage:
+		save_FxSamplerImage(xnew(xn, "sampler_image"), v)
+	case *cdom.FxSamplerStates:
+		save_FxSamplerStates(xnew(xn, "sampler_states"), v)
+	case *cdom.RefSid:
+		xnew(xn, "SIDREF").Value = v.S
+	case string:
+		xnew(xn, "string").Value = v
+	}
+}
+
+func xv3(xn *xmlx.Node, name string, v *unum.Vec3) {
+	if v != nil {
+		xnew(xn, name).Value = list_Vec3(v)
+	}
+}
+
+func get_AnimSamplerBehavior(b cdom.AnimSamplerBehavior) (s string) {
+	switch b {
+	case cdom.AnimSamplerBehaviorConstant:
+		s = "CONSTANT"
+	case cdom.AnimSamplerBehaviorCycle:
+		s = "CYCLE"
+	case cdom.AnimSamplerBehaviorCycleRelative:
+		s = "CYCLE_RELATIVE"
+	case cdom.AnimSamplerBehaviorGradient:
+		s = "GRADIENT"
+	case cdom.AnimSamplerBehaviorOscillate:
+		s = "OSCILLATE"
+	}
+	return
+}
+
+func get_CubeFace(cf cdom.FxCubeFace) (s string) {
+	switch cf {
+	case cdom.FxCubeFaceNy:
+		s = "NEGATIVE_Y"
+	case cdom.FxCubeFacePy:
+		s = "POSITIVE_Y"
+	case cdom.FxCubeFaceNz:
+		s = "NEGATIVE_Z"
+	case cdom.FxCubeFacePz:
+		s = "POSITIVE_Z"
+	case cdom.FxCubeFaceNx:
+		s = "NEGATIVE_X"
+	default:
+		s = "POSITIVE_X"
+	}
+	return
+}
+
+func get_FilterKind(fk cdom.FxFilterKind) (s string) {
+	switch fk {
+	case cdom.FxFilterKindAnisotropic:
+		s = "ANISOTROPIC"
+	case cdom.FxFilterKindNearest:
+		s = "NEAREST"
+	case cdom.FxFilterKindMipNone:
+		s = "NONE"
+	default:
+		s = "LINEAR"
+	}
+	return
+}
+
+func get_FormatChannels(fc cdom.FxFormatChannels) (s string) {
+	switch fc {
+	case cdom.FxFormatChannelsRgb:
+		s = "RGB"
+	case cdom.FxFormatChannelsRgba:
+		s = "RGBA"
+	case cdom.FxFormatChannelsRgbe:
+		s = "RGBE"
+	case cdom.FxFormatChannelsL:
+		s = "L"
+	case cdom.FxFormatChannelsLa:
+		s = "LA"
+	case cdom.FxFormatChannelsD:
+		s = "D"
+	}
+	return
+}
+
+func get_FormatPrecision(fp cdom.FxFormatPrecision) (s string) {
+	switch fp {
+	case cdom.FxFormatPrecisionLow:
+		s = "LOW"
+	case cdom.FxFormatPrecisionMid:
+		s = "MID"
+	case cdom.FxFormatPrecisionHigh:
+		s = "HIGH"
+	case cdom.FxFormatPrecisionMax:
+		s = "MAX"
+	default:
+		s = "DEFAULT"
+	}
+	return
+}
+
+func get_FormatRange(fr cdom.FxFormatRange) (s string) {
+	switch fr {
+	case cdom.FxFormatRangeFloat:
+		s = "FLOAT"
+	case cdom.FxFormatRangeSint:
+		s = "SINT"
+	case cdom.FxFormatRangeSnorm:
+		s = "SNORM"
+	case cdom.FxFormatRangeUint:
+		s = "UINT"
+	case cdom.FxFormatRangeUnorm:
+		s = "UNORM"
+	}
+	return
+}
+
+func get_PrimitiveKind(pk cdom.GeometryPrimitiveKind) (s string) {
+	switch pk {
+	case cdom.GeometryPrimitiveKindLines:
+		s = "lines"
+	case cdom.GeometryPrimitiveKindLineStrips:
+		s = "linestrips"
+	case cdom.GeometryPrimitiveKindPolygons:
+		s = "polygons"
+	case cdom.GeometryPrimitiveKindPolylist:
+		s = "polylist"
+	case cdom.GeometryPrimitiveKindTriangles:
+		s = "triangles"
+	case cdom.GeometryPrimitiveKindTrifans:
+		s = "trifans"
+	case cdom.GeometryPrimitiveKindTristrips:
+		s = "tristrips"
+	}
+	return
+}
+
+func get_SamplerKind(sk cdom.FxSamplerKind) (s string) {
+	switch sk {
+	case cdom.FxSamplerKind1D:
+		s = "sampler1D"
+	case cdom.FxSamplerKind3D:
+		s = "sampler3D"
+	case cdom.FxSamplerKindCube:
+		s = "samplerCUBE"
+	case cdom.FxSamplerKindDepth:
+		s = "samplerDEPTH"
+	case cdom.FxSamplerKindRect:
+		s = "samplerRECT"
+	default:
+		s = "sampler2D"
+	}
+	return
+}
+
+func get_ShaderStage(ss cdom.FxShaderStage) (s string) {
+	switch ss {
+	case cdom.FxShaderStageCompute:
+		s = "COMPUTE"
+	case cdom.FxShaderStageFragment:
+		s = "FRAGMENT"
+	case cdom.FxShaderStageGeometry:
+		s = "GEOMETRY"
+	case cdom.FxShaderStageTessellation:
+		s = "TESSELLATION"
+	case cdom.FxShaderStageVertex:
+		s = "VERTEX"
+	}
+	return
+}
+
+func get_TextureOpaque(to cdom.FxTextureOpaque) (s string) {
+	switch to {
+	case cdom.FxTextureOpaqueRgb0:
+		s = "RGB_ZERO"
+	case cdom.FxTextureOpaqueRgb1:
+		s = "RGB_ONE"
+	case cdom.FxTextureOpaqueA0:
+		s = "A_ZERO"
+	default:
+		s = "A_ONE"
+	}
+	return
+}
+
+func get_TransformKind(tk cdom.TransformKind) (s string) {
+	switch tk {
+	case cdom.TransformKindLookat:
+		s = "lookat"
+	case cdom.TransformKindMatrix:
+		s = "matrix"
+	case cdom.TransformKindRotate:
+		s = "rotate"
+	case cdom.TransformKindScale:
+		s = "scale"
+	case cdom.TransformKindSkew:
+		s = "skew"
+	case cdom.TransformKindTranslate:
+		s = "translate"
+	}
+	return
+}
+
+func get_WrapKind(wk cdom.FxWrapKind) (s string) {
+	switch wk {
+	case cdom.FxWrapKindBorder:
+		s = "BORDER"
+	case cdom.FxWrapKindClamp:
+		s = "CLAMP"
+	case cdom.FxWrapKindMirror:
+		s = "MIRROR"
+	case cdom.FxWrapKindMirrorOnce:
+		s = "MIRROR_ONCE"
+	default:
+		s = "WRAP"
+	}
+	return
+}
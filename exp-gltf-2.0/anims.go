@@ -0,0 +1,230 @@
+package gltfexp
+
+import (
+	"math"
+	"reflect"
+	"sort"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	One glTF animation to be resampled from an Animator.
+type animation struct {
+	name       string
+	animator   *cdomutil.Animator
+	start, end float64
+
+	//	the NodeDefs whose Transforms, and the morph weight Sources whose values, are animated
+	nodes   []*cdom.NodeDef
+	weights []*cdom.Source
+}
+
+//	Prepares one animation per AnimationClipDef in the libraries of me.reg or, if there are none, a single animation
+//	of all AnimationDefs, and records which NodeDefs they animate.
+func (me *exporter) initAnimations() (err error) {
+	var (
+		player   *cdomutil.AnimationClipPlayer
+		animator *cdomutil.Animator
+	)
+	index, owners := cdomutil.NewAnimationTargetIndex(me.reg), map[*cdom.Transform]*cdom.NodeDef{}
+	for _, sn := range me.nodes {
+		for _, t := range sn.Node.Transforms {
+			owners[t] = sn.Node
+		}
+	}
+	for _, libId := range sortedKeys(reflect.ValueOf(me.reg.AnimationClipDefLibs)) {
+		lib := me.reg.AnimationClipDefLibs[libId]
+		for _, id := range sortedKeys(reflect.ValueOf(lib.M)) {
+			clip := lib.M[id]
+			if player, err = cdomutil.NewAnimationClipPlayer(clip, me.reg); err != nil {
+				return
+			}
+			me.addAnimation(&animation{name: nameOr(clip.Name, clip.Id), animator: player.Animator, start: player.Start(), end: player.End()}, index, owners)
+		}
+	}
+	if len(me.anims) == 0 {
+		var anims []*cdom.AnimationDef
+		for _, libId := range sortedKeys(reflect.ValueOf(me.reg.AnimationDefLibs)) {
+			lib := me.reg.AnimationDefLibs[libId]
+			for _, id := range sortedKeys(reflect.ValueOf(lib.M)) {
+				anims = append(anims, lib.M[id])
+			}
+		}
+		if len(anims) > 0 {
+			if animator, err = cdomutil.NewAnimator(me.reg, anims...); err != nil {
+				return
+			}
+			anim := &animation{animator: animator}
+			anim.start, anim.end = animator.TimeRange()
+			me.addAnimation(anim, index, owners)
+		}
+	}
+	return
+}
+
+//	Adds anim to me.anims if any of its tracks animates the Transforms of any NodeDef in owners, or any Source.
+func (me *exporter) addAnimation(anim *animation, index *cdomutil.AnimationTargetIndex, owners map[*cdom.Transform]*cdom.NodeDef) {
+	nodes, weights := map[*cdom.NodeDef]bool{}, map[*cdom.Source]bool{}
+	for _, track := range anim.animator.Tracks {
+		for _, target := range index.Lookup(track.Target) {
+			if target.Channel != track.Channel {
+				continue
+			}
+			switch owner := target.Owner.(type) {
+			case *cdom.Transform:
+				if node := owners[owner]; (node != nil) && !nodes[node] {
+					nodes[node] = true
+					anim.nodes = append(anim.nodes, node)
+					me.animated[node] = true
+				}
+			case *cdom.Source:
+				if !weights[owner] {
+					weights[owner] = true
+					anim.weights = append(anim.weights, owner)
+				}
+			}
+		}
+	}
+	if (len(anim.nodes) > 0) || (len(anim.weights) > 0) {
+		me.anims = append(me.anims, anim)
+	}
+}
+
+//	Resamples all me.anims into glTF animations, restoring all animated values afterwards.
+func (me *exporter) writeAnimations() (err error) {
+	for _, anim := range me.anims {
+		var restores []func()
+		for _, track := range anim.animator.Tracks {
+			restores = append(restores, snapshot(track.Target))
+		}
+		times := sampleTimes(anim.start, anim.end, me.bag.Fps)
+		type nodeSamples struct {
+			t, s []float32
+			r    []float64
+		}
+		nodes, weights := make([]nodeSamples, len(anim.nodes)), make([][]float32, len(anim.weights))
+		for _, t := range times {
+			anim.animator.Apply(anim.start + t)
+			for i, node := range anim.nodes {
+				m := cdomutil.TransformsMatrix(node.Transforms)
+				tv, rv, sv, _ := decompose(&m)
+				if ns := &nodes[i]; len(ns.r) > 0 {
+					//	keep consecutive rotations in the same hemisphere, so that they interpolate along the shortest path
+					if prev := ns.r[len(ns.r)-4:]; (prev[0]*rv[0] + prev[1]*rv[1] + prev[2]*rv[2] + prev[3]*rv[3]) < 0 {
+						rv = [4]float64{-rv[0], -rv[1], -rv[2], -rv[3]}
+					}
+				}
+				nodes[i].t = append(nodes[i].t, float32(tv[0]), float32(tv[1]), float32(tv[2]))
+				nodes[i].r = append(nodes[i].r, rv[:]...)
+				nodes[i].s = append(nodes[i].s, float32(sv[0]), float32(sv[1]), float32(sv[2]))
+			}
+			for i, src := range anim.weights {
+				vals, _ := src.Floats()
+				for _, f := range vals {
+					weights[i] = append(weights[i], float32(f))
+				}
+			}
+		}
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+		ga, input := &gltfAnimation{Name: anim.name}, -1
+		channel := func(vals []float32, typ, path string, targets []int) {
+			if len(targets) == 0 {
+				return
+			}
+			if input < 0 {
+				secs := make([]float32, len(times))
+				for i, t := range times {
+					secs[i] = float32(t)
+				}
+				input = me.addFloats(secs, "SCALAR", 0, true)
+			}
+			sampler := len(ga.Samplers)
+			ga.Samplers = append(ga.Samplers, &gltfAnimationSampler{Input: input, Output: me.addFloats(vals, typ, 0, false), Interpolation: "LINEAR"})
+			for _, node := range targets {
+				ch := &gltfAnimationChannel{Sampler: sampler}
+				ch.Target.Node, ch.Target.Path = node, path
+				ga.Channels = append(ga.Channels, ch)
+			}
+		}
+		for i, node := range anim.nodes {
+			m := cdomutil.TransformsMatrix(node.Transforms)
+			tv, rv, sv, _ := decompose(&m)
+			ns, rot := nodes[i], make([]float32, len(nodes[i].r))
+			for j, f := range ns.r {
+				rot[j] = float32(f)
+			}
+			for _, c := range []struct {
+				vals      []float32
+				rest      []float64
+				typ, path string
+			}{{ns.t, tv[:], "VEC3", "translation"}, {rot, rv[:], "VEC4", "rotation"}, {ns.s, sv[:], "VEC3", "scale"}} {
+				if !constantSamples(c.vals, c.rest) {
+					channel(c.vals, c.typ, c.path, me.defNodes[node])
+				}
+			}
+		}
+		for i, src := range anim.weights {
+			channel(weights[i], "SCALAR", "weights", me.weightNodes[src])
+		}
+		if len(ga.Channels) > 0 {
+			me.gltf.Animations = append(me.gltf.Animations, ga)
+		}
+	}
+	return
+}
+
+//	Returns whether all elements of vals equal those of rest (or their negation, for rotation quaternions).
+func constantSamples(vals []float32, rest []float64) bool {
+	const eps = 1e-6
+	for sign := 1.0; sign >= -1; sign -= 2 {
+		same := true
+		for i := 0; same && (i < len(vals)); i++ {
+			same = math.Abs(float64(vals[i])-sign*rest[i%len(rest)]) <= eps
+		}
+		if same {
+			return true
+		}
+		if len(rest) != 4 {
+			break
+		}
+	}
+	return false
+}
+
+//	Returns the times (relative to start) at fps samples per second from start to end, both included, as per Animator.Bake().
+func sampleTimes(start, end, fps float64) (times []float64) {
+	for i, n := 0, int(math.Ceil((end-start)*fps-1e-6)); i < n; i++ {
+		times = append(times, float64(i)/fps)
+	}
+	times = append(times, math.Max(0, end-start))
+	return
+}
+
+//	Copies the current values of target (a value animated by an Animator) and returns a func restoring them in place.
+func snapshot(target interface{}) (restore func()) {
+	if t, _ := target.(*cdom.Transform); t != nil {
+		vals := append([]float64{}, t.F...)
+		return func() { copy(t.F, vals) }
+	}
+	rv := reflect.ValueOf(target).Elem()
+	saved := reflect.New(rv.Type()).Elem()
+	if rv.Kind() == reflect.Slice {
+		saved.Set(reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len()))
+		reflect.Copy(saved, rv)
+		return func() { reflect.Copy(rv, saved) }
+	}
+	saved.Set(rv)
+	return func() { rv.Set(saved) }
+}
+
+//	Returns the keys of the map m (with string keys), sorted.
+func sortedKeys(m reflect.Value) (keys []string) {
+	for _, k := range m.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return
+}
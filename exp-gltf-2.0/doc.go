@@ -0,0 +1,21 @@
+// Converts the data structures provided by the go-collada/dom package to glTF 2.0 assets,
+// either as a JSON (.gltf) document with a separate binary (.bin) buffer, or as a single binary (.glb) file.
+//
+// The node tree of the VisualSceneDef instantiated by the Document's Scene is converted node by node. Nodes keep
+// their (folded) Transforms as a matrix, or as translation, rotation and scale if they are animated or if their
+// matrix can be decomposed exactly. Geometry meshes are triangulated and compiled via cdomutil.GeometryCompile(),
+// with one glTF primitive per Material and Kind: their "POSITION", "NORMAL", "TEXCOORD" (sets 0 and 1) and
+// "COLOR" inputs become POSITION, NORMAL, TEXCOORD_n and COLOR_0 attributes (with the V texture coordinate
+// flipped). B-rep and spline geometries, cameras, lights, physics and kinematics are not converted.
+//
+// The common profile of an effect is approximated by a PBR metallic-roughness material (which is never
+// metallic): Diffuse becomes the base color, Emission the emissive color, the Shininess of Blinn and Phong
+// effects is mapped to a roughness (Lambert effects are fully rough), and Transparent with Transparency
+// to the alpha of the base color. Constant effects are exported as unlit materials (KHR_materials_unlit)
+// with their Emission as base color. The FxImageDefs of textures are referred to by the RefUrl of their
+// InitFrom, or embedded into the binary buffer if they hold raw PNG or JPEG data.
+//
+// Skin controllers become glTF skins, with their BindShapeMatrix applied to the exported vertices and at most
+// four joint influences per vertex. Morph controllers become morph targets. Animations are resampled at a
+// fixed rate (see ExportBag.Fps), into linearly interpolated translation, rotation, scale and morph weight samplers.
+package gltfexp
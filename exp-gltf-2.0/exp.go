@@ -0,0 +1,168 @@
+package gltfexp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	Provides options for exporting glTF assets.
+type ExportBag struct {
+	//	The number of samples per second at which all animations are resampled. Defaults to 30.
+	Fps float64
+
+	//	If false (the default), and the Asset of the exported Document declares an UpAxis other than "Y" or a
+	//	Unit other than meters, all root nodes are placed below an extra node that rotates and scales them
+	//	into the Y-up, meter-based coordinate system of glTF. (To convert the data itself instead, see the
+	//	Normalize options of the go-collada/imp-1.5 package.)
+	KeepAxes bool
+
+	//	If true, effects of the FxTechniqueKindConstant kind are exported as metallic-roughness materials with their
+	//	Emission as emissive color, rather than as unlit materials with the KHR_materials_unlit extension.
+	NoUnlit bool
+}
+
+//	Initializes and returns a newly created ExportBag instance.
+func NewExportBag() (me *ExportBag) {
+	me = &ExportBag{Fps: 30}
+	return
+}
+
+//	Converts the VisualSceneDef instantiated by doc.Scene (and everything it refers to in the Registry of doc, or in
+//	cdom.DefaultRegistry if nil) to a glTF 2.0 asset, using the export options specified in exportBag (or those
+//	of NewExportBag(), if nil), and returns its JSON document and its binary buffer. The JSON document refers
+//	to the buffer by binUri (such as "model.bin"): if binUri is empty, the buffer is embedded into the JSON
+//	document as a base64 data URI instead, and bin is nil. Returns an error if doc has no visual scene, or
+//	if any of its meshes, skins, morphs or animations cannot be evaluated.
+func ExportGltf(doc *cdom.Document, exportBag *ExportBag, binUri string) (gltfDoc, bin []byte, err error) {
+	var exp *exporter
+	if exp, err = newExporter(doc, exportBag); err == nil {
+		if bin = exp.bin; len(bin) > 0 {
+			buf := &gltfBuffer{ByteLength: len(bin), Uri: binUri}
+			if len(binUri) == 0 {
+				buf.Uri, bin = "data:application/octet-stream;base64,"+base64.StdEncoding.EncodeToString(bin), nil
+			}
+			exp.gltf.Buffers = []*gltfBuffer{buf}
+		}
+		gltfDoc, err = json.Marshal(&exp.gltf)
+	}
+	return
+}
+
+//	Like ExportGltf(), but returns a single binary glTF (.glb) file containing both the JSON document and the binary buffer.
+func ExportGlb(doc *cdom.Document, exportBag *ExportBag) (glb []byte, err error) {
+	var (
+		exp  *exporter
+		jdoc []byte
+	)
+	if exp, err = newExporter(doc, exportBag); err != nil {
+		return
+	}
+	if len(exp.bin) > 0 {
+		exp.gltf.Buffers = []*gltfBuffer{{ByteLength: len(exp.bin)}}
+	}
+	if jdoc, err = json.Marshal(&exp.gltf); err != nil {
+		return
+	}
+	jdoc, bin := pad4(jdoc, ' '), pad4(exp.bin, 0)
+	size := 12 + 8 + len(jdoc)
+	if len(bin) > 0 {
+		size += 8 + len(bin)
+	}
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, []uint32{glbMagic, 2, uint32(size), uint32(len(jdoc)), glbChunkJson})
+	buf.Write(jdoc)
+	if len(bin) > 0 {
+		binary.Write(&buf, binary.LittleEndian, []uint32{uint32(len(bin)), glbChunkBin})
+		buf.Write(bin)
+	}
+	glb = buf.Bytes()
+	return
+}
+
+const (
+	glbMagic     = 0x46546C67
+	glbChunkJson = 0x4E4F534A
+	glbChunkBin  = 0x004E4942
+)
+
+type exporter struct {
+	bag  *ExportBag
+	doc  *cdom.Document
+	reg  *cdom.Registry
+	gltf gltfRoot
+	bin  []byte
+
+	//	all node occurrences of the scene, and the index of the glTF node of each
+	nodes     []*cdomutil.SceneNode
+	nodeIndex map[*cdomutil.SceneNode]int
+
+	//	the glTF nodes of all occurrences of each NodeDef
+	defNodes map[*cdom.NodeDef][]int
+
+	//	the glTF nodes whose mesh has morph targets weighted by a Source
+	weightNodes map[*cdom.Source][]int
+
+	anims    []*animation
+	animated map[*cdom.NodeDef]bool
+
+	meshes    map[string]int
+	skins     map[string]int
+	materials map[string]int
+	images    map[*cdom.FxImageDef]int
+	samplers  map[gltfSampler]int
+	textures  map[[2]int]int
+	extUsed   map[string]bool
+}
+
+func newExporter(doc *cdom.Document, bag *ExportBag) (me *exporter, err error) {
+	if bag == nil {
+		bag = NewExportBag()
+	}
+	me = &exporter{bag: bag, doc: doc, reg: doc.Libs(), nodeIndex: map[*cdomutil.SceneNode]int{}, defNodes: map[*cdom.NodeDef][]int{}, weightNodes: map[*cdom.Source][]int{}, animated: map[*cdom.NodeDef]bool{}, meshes: map[string]int{}, skins: map[string]int{}, materials: map[string]int{}, images: map[*cdom.FxImageDef]int{}, samplers: map[gltfSampler]int{}, textures: map[[2]int]int{}, extUsed: map[string]bool{}}
+	me.gltf.Asset.Version, me.gltf.Asset.Generator = "2.0", "go-collada"
+	var vs *cdom.VisualSceneDef
+	if (doc.Scene != nil) && (doc.Scene.Visual != nil) {
+		vs = doc.Scene.Visual.EnsureDef(me.reg)
+	}
+	if vs == nil {
+		err = fmt.Errorf("document has no visual scene")
+	} else if me.nodes, _ = cdomutil.VisualSceneNodes(vs, me.reg); me.bag.Fps <= 0 {
+		err = fmt.Errorf("invalid fps %v", me.bag.Fps)
+	} else if err = me.initAnimations(); err == nil {
+		if err = me.initScene(vs); err == nil {
+			err = me.writeAnimations()
+		}
+	}
+	if err != nil {
+		me = nil
+	}
+	return
+}
+
+//	Returns name, or id if name is empty.
+func nameOr(name, id string) string {
+	if len(name) > 0 {
+		return name
+	}
+	return id
+}
+
+func pad4(data []byte, pad byte) []byte {
+	for (len(data) % 4) != 0 {
+		data = append(data, pad)
+	}
+	return data
+}
+
+func (me *exporter) useExtension(name string) {
+	if !me.extUsed[name] {
+		me.extUsed[name] = true
+		me.gltf.ExtensionsUsed = append(me.gltf.ExtensionsUsed, name)
+	}
+}
@@ -0,0 +1,266 @@
+package gltfexp_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"testing"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	gltfexp "github.com/metaleap/go-collada/exp-gltf-2.0"
+	collimp "github.com/metaleap/go-collada/imp-1.5"
+)
+
+const (
+	//	A triangle morphed (by weights animated from 0 to 1) towards a copy moved by 2 along Y, skinned to
+	//	a joint rotated from 0 to 90 degrees about Z, next to a node with a sheared (non-decomposable) matrix.
+	testSkinMorphDoc = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_geometries>
+<geometry id="base"><mesh>
+<source id="base-pos"><float_array id="base-pos-a" count="9">0 0 0 1 0 0 0 1 0</float_array><technique_common><accessor source="#base-pos-a" count="3" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="base-v"><input semantic="POSITION" source="#base-pos"/></vertices>
+<triangles count="1"><input semantic="VERTEX" source="#base-v" offset="0"/><p>0 1 2</p></triangles>
+</mesh></geometry>
+<geometry id="up"><mesh>
+<source id="up-pos"><float_array id="up-pos-a" count="9">0 2 0 1 2 0 0 3 0</float_array><technique_common><accessor source="#up-pos-a" count="3" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="up-v"><input semantic="POSITION" source="#up-pos"/></vertices>
+<triangles count="1"><input semantic="VERTEX" source="#up-v" offset="0"/><p>0 1 2</p></triangles>
+</mesh></geometry>
+</library_geometries>
+<library_controllers>
+<controller id="m"><morph source="#base" method="NORMALIZED">
+<source id="m-targets"><IDREF_array id="m-targets-a" count="1">up</IDREF_array><technique_common><accessor source="#m-targets-a" count="1"><param name="MORPH_TARGET" type="IDREF"/></accessor></technique_common></source>
+<source id="m-weights"><float_array id="m-weights-a" count="1">0</float_array><technique_common><accessor source="#m-weights-a" count="1"><param name="MORPH_WEIGHT" type="float"/></accessor></technique_common></source>
+<targets><input semantic="MORPH_TARGET" source="#m-targets"/><input semantic="MORPH_WEIGHT" source="#m-weights"/></targets>
+</morph></controller>
+<controller id="c"><skin source="#m">
+<bind_shape_matrix>1 0 0 10 0 1 0 0 0 0 1 0 0 0 0 1</bind_shape_matrix>
+<source id="jn"><Name_array id="jn-a" count="1">j0</Name_array><technique_common><accessor source="#jn-a" count="1"><param name="JOINT" type="name"/></accessor></technique_common></source>
+<source id="ib"><float_array id="ib-a" count="16">1 0 0 0 0 1 0 0 0 0 1 -5 0 0 0 1</float_array><technique_common><accessor source="#ib-a" count="1" stride="16"><param name="TRANSFORM" type="float4x4"/></accessor></technique_common></source>
+<source id="w"><float_array id="w-a" count="1">1</float_array><technique_common><accessor source="#w-a" count="1"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<joints><input semantic="JOINT" source="#jn"/><input semantic="INV_BIND_MATRIX" source="#ib"/></joints>
+<vertex_weights count="3"><input semantic="JOINT" source="#jn" offset="0"/><input semantic="WEIGHT" source="#w" offset="1"/><vcount>1 1 1</vcount><v>0 0 0 0 0 0</v></vertex_weights>
+</skin></controller>
+</library_controllers>
+<library_animations><animation id="a">
+<source id="a-in"><float_array id="a-in-a" count="2">0 1</float_array><technique_common><accessor source="#a-in-a" count="2"><param name="TIME" type="float"/></accessor></technique_common></source>
+<source id="a-w"><float_array id="a-w-a" count="2">0 1</float_array><technique_common><accessor source="#a-w-a" count="2"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<source id="a-r"><float_array id="a-r-a" count="2">0 90</float_array><technique_common><accessor source="#a-r-a" count="2"><param name="ANGLE" type="float"/></accessor></technique_common></source>
+<sampler id="a-ws"><input semantic="INPUT" source="#a-in"/><input semantic="OUTPUT" source="#a-w"/></sampler>
+<sampler id="a-rs"><input semantic="INPUT" source="#a-in"/><input semantic="OUTPUT" source="#a-r"/></sampler>
+<channel source="#a-ws" target="m-weights(0)"/>
+<channel source="#a-rs" target="joint0/rz.ANGLE"/>
+</animation></library_animations>
+<library_visual_scenes><visual_scene id="vs">
+<node id="joint0" sid="j0" type="JOINT"><rotate sid="rz">0 0 1 0</rotate></node>
+<node id="skinned"><instance_controller url="#c"><skeleton>#joint0</skeleton></instance_controller></node>
+<node id="sheared"><matrix>1 1 0 5 0 1 0 6 0 0 1 7 0 0 0 1</matrix></node>
+</visual_scene></library_visual_scenes>
+<scene><instance_visual_scene url="#vs"/></scene>
+</COLLADA>`
+)
+
+//	The parts of the glTF JSON document checked by the tests.
+type testGltf struct {
+	Nodes []struct {
+		Name   string
+		Matrix []float64
+		Mesh   *int
+		Skin   *int
+	}
+	Meshes []struct {
+		Primitives []struct {
+			Attributes map[string]int
+			Targets    []map[string]int
+		}
+		Weights []float64
+	}
+	Skins []struct {
+		InverseBindMatrices *int
+		Joints              []int
+	}
+	Animations []struct {
+		Channels []struct {
+			Sampler int
+			Target  struct {
+				Node int
+				Path string
+			}
+		}
+		Samplers []struct {
+			Input, Output int
+			Interpolation string
+		}
+	}
+	Accessors []struct {
+		BufferView, ComponentType, Count int
+		Type                             string
+		Min, Max                         []float64
+	}
+	BufferViews []struct {
+		Buffer, ByteOffset, ByteLength int
+	}
+	Buffers []struct {
+		ByteLength int
+		Uri        string
+	}
+}
+
+//	Returns the float values of the accessor with the specified index, failing t if they are not all within bin.
+func (me *testGltf) floats(t *testing.T, bin []byte, accessor int) (vals []float64) {
+	acc := me.Accessors[accessor]
+	n := acc.Count * map[string]int{"SCALAR": 1, "VEC2": 2, "VEC3": 3, "VEC4": 4, "MAT4": 16}[acc.Type]
+	view := me.BufferViews[acc.BufferView]
+	if (acc.ComponentType != 5126) || (view.ByteLength != 4*n) || ((view.ByteOffset % 4) != 0) || ((view.ByteOffset + view.ByteLength) > len(bin)) {
+		t.Fatalf("accessor %d: got %#v with buffer view %#v, want %d aligned floats within %d bytes", accessor, acc, view, n, len(bin))
+	}
+	for i := 0; i < n; i++ {
+		vals = append(vals, float64(math.Float32frombits(binary.LittleEndian.Uint32(bin[view.ByteOffset+4*i:]))))
+	}
+	return
+}
+
+func testNearly(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-5 {
+			return false
+		}
+	}
+	return true
+}
+
+func testImport(t *testing.T, src string) (doc *cdom.Document) {
+	bag := collimp.NewImportBag()
+	bag.Log, bag.Registry = nil, cdom.NewRegistry()
+	var err error
+	if doc, err = collimp.ImportCollada([]byte(src), bag); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	return
+}
+
+//	Splits glb into its JSON document and binary buffer, failing t on any violation of the GLB container format.
+func testSplitGlb(t *testing.T, glb []byte) (root *testGltf, bin []byte) {
+	u32 := func(off int) uint32 { return binary.LittleEndian.Uint32(glb[off:]) }
+	if (len(glb) < 20) || (u32(0) != 0x46546C67) || (u32(4) != 2) || (int(u32(8)) != len(glb)) {
+		t.Fatalf("invalid GLB header in %d bytes", len(glb))
+	}
+	jsonLen := int(u32(12))
+	if ((jsonLen % 4) != 0) || (u32(16) != 0x4E4F534A) || ((20 + jsonLen) > len(glb)) {
+		t.Fatalf("invalid JSON chunk of %d bytes", jsonLen)
+	}
+	root = &testGltf{}
+	if err := json.Unmarshal(glb[20:20+jsonLen], root); err != nil {
+		t.Fatalf("JSON chunk: %v", err)
+	}
+	if rest := glb[20+jsonLen:]; len(rest) > 0 {
+		binLen := int(binary.LittleEndian.Uint32(rest))
+		if ((binLen % 4) != 0) || (binary.LittleEndian.Uint32(rest[4:]) != 0x004E4942) || ((8 + binLen) != len(rest)) {
+			t.Fatalf("invalid BIN chunk of %d bytes", binLen)
+		}
+		bin = rest[8:]
+	}
+	if (len(root.Buffers) != 1) || (len(root.Buffers[0].Uri) > 0) || (root.Buffers[0].ByteLength > len(bin)) || ((len(bin) - root.Buffers[0].ByteLength) >= 4) {
+		t.Fatalf("got buffers %#v for a BIN chunk of %d bytes", root.Buffers, len(bin))
+	}
+	return
+}
+
+func TestExportGlbSkinMorphAnimation(t *testing.T) {
+	doc := testImport(t, testSkinMorphDoc)
+	bag := gltfexp.NewExportBag()
+	bag.Fps = 4
+	glb, err := gltfexp.ExportGlb(doc, bag)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	root, bin := testSplitGlb(t, glb)
+	nodes := map[string]int{}
+	for i, node := range root.Nodes {
+		nodes[node.Name] = i
+	}
+
+	//	the sheared matrix, column-major
+	if got, want := root.Nodes[nodes["sheared"]].Matrix, []float64{1, 0, 0, 0, 1, 1, 0, 0, 0, 0, 1, 0, 5, 6, 7, 1}; !testNearly(got, want) {
+		t.Errorf("got matrix %v, want %v", got, want)
+	}
+
+	skinned := root.Nodes[nodes["skinned"]]
+	if (skinned.Mesh == nil) || (skinned.Skin == nil) || (len(root.Meshes) != 1) || (len(root.Skins) != 1) {
+		t.Fatalf("got node %#v, want a skinned mesh", skinned)
+	}
+	skin := root.Skins[*skinned.Skin]
+	if (len(skin.Joints) != 1) || (skin.Joints[0] != nodes["joint0"]) || (skin.InverseBindMatrices == nil) {
+		t.Fatalf("got skin %#v, want joint0", skin)
+	}
+	if got, want := root.floats(t, bin, *skin.InverseBindMatrices), []float64{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, -5, 1}; !testNearly(got, want) {
+		t.Errorf("got inverse bind matrix %v, want %v", got, want)
+	}
+
+	mesh := root.Meshes[*skinned.Mesh]
+	prim := mesh.Primitives[0]
+	if (len(mesh.Primitives) != 1) || !testNearly(mesh.Weights, []float64{0}) || (len(prim.Targets) != 1) {
+		t.Fatalf("got mesh %#v, want one primitive with one morph target", mesh)
+	}
+	//	positions are transformed by the bind shape matrix, and declare their bounds
+	pos := root.floats(t, bin, prim.Attributes["POSITION"])
+	if want := []float64{10, 0, 0, 11, 0, 0, 10, 1, 0}; !testNearly(pos, want) {
+		t.Errorf("got positions %v, want %v", pos, want)
+	}
+	if acc := root.Accessors[prim.Attributes["POSITION"]]; !testNearly(acc.Min, []float64{10, 0, 0}) || !testNearly(acc.Max, []float64{11, 1, 0}) {
+		t.Errorf("got position bounds %v to %v, want [10 0 0] to [11 1 0]", acc.Min, acc.Max)
+	}
+	if got, want := root.floats(t, bin, prim.Targets[0]["POSITION"]), []float64{0, 2, 0, 0, 2, 0, 0, 2, 0}; !testNearly(got, want) {
+		t.Errorf("got morph target deltas %v, want %v", got, want)
+	}
+	if _, ok := prim.Attributes["JOINTS_0"]; !ok {
+		t.Errorf("got attributes %v, want JOINTS_0 and WEIGHTS_0", prim.Attributes)
+	} else if got := root.floats(t, bin, prim.Attributes["WEIGHTS_0"]); !testNearly(got, []float64{1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0}) {
+		t.Errorf("got joint weights %v", got)
+	}
+
+	//	both animations, resampled at 4 fps over their common input
+	if (len(root.Animations) != 1) || (len(root.Animations[0].Channels) != 2) {
+		t.Fatalf("got animations %#v, want one with a rotation and a weights channel", root.Animations)
+	}
+	anim := root.Animations[0]
+	s, h := math.Sqrt(0.5), math.Sin(math.Pi/8)
+	for _, ch := range anim.Channels {
+		sampler := anim.Samplers[ch.Sampler]
+		times := root.floats(t, bin, sampler.Input)
+		if !testNearly(times, []float64{0, 0.25, 0.5, 0.75, 1}) || !testNearly(root.Accessors[sampler.Input].Max, []float64{1}) {
+			t.Errorf("%s: got times %v", ch.Target.Path, times)
+		}
+		out := root.floats(t, bin, sampler.Output)
+		switch ch.Target.Path {
+		case "rotation":
+			if (ch.Target.Node != nodes["joint0"]) || !testNearly(out[8:12], []float64{0, 0, h, math.Cos(math.Pi / 8)}) || !testNearly(out[16:], []float64{0, 0, s, s}) {
+				t.Errorf("got rotations %v of node %d", out, ch.Target.Node)
+			}
+		case "weights":
+			if (ch.Target.Node != nodes["skinned"]) || !testNearly(out, []float64{0, 0.25, 0.5, 0.75, 1}) {
+				t.Errorf("got weights %v of node %d", out, ch.Target.Node)
+			}
+		default:
+			t.Errorf("unexpected channel path %q", ch.Target.Path)
+		}
+	}
+
+	//	the same binary buffer, and the animated values restored after resampling
+	gltf, gbin, err := gltfexp.ExportGltf(doc, bag, "model.bin")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if (len(bin) < len(gbin)) || !bytes.Equal(bin[:len(gbin)], gbin) || !bytes.Contains(gltf, []byte(`"uri":"model.bin"`)) {
+		t.Errorf("ExportGltf() and ExportGlb() disagree")
+	}
+	if rz := doc.Registry.VisualSceneDefs.M["vs"].Nodes[0].Transforms[0].F; rz[3] != 0 {
+		t.Errorf("got rotation angle %v after export, want it restored to 0", rz[3])
+	}
+}
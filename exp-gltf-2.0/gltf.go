@@ -0,0 +1,225 @@
+package gltfexp
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+//	The glTF 2.0 JSON document, as far as written by this package.
+type gltfRoot struct {
+	Asset struct {
+		Version   string `json:"version"`
+		Generator string `json:"generator,omitempty"`
+	} `json:"asset"`
+	ExtensionsUsed []string          `json:"extensionsUsed,omitempty"`
+	Scene          *int              `json:"scene,omitempty"`
+	Scenes         []*gltfScene      `json:"scenes,omitempty"`
+	Nodes          []*gltfNode       `json:"nodes,omitempty"`
+	Meshes         []*gltfMesh       `json:"meshes,omitempty"`
+	Skins          []*gltfSkin       `json:"skins,omitempty"`
+	Materials      []*gltfMaterial   `json:"materials,omitempty"`
+	Textures       []*gltfTexture    `json:"textures,omitempty"`
+	Images         []*gltfImage      `json:"images,omitempty"`
+	Samplers       []*gltfSampler    `json:"samplers,omitempty"`
+	Animations     []*gltfAnimation  `json:"animations,omitempty"`
+	Accessors      []*gltfAccessor   `json:"accessors,omitempty"`
+	BufferViews    []*gltfBufferView `json:"bufferViews,omitempty"`
+	Buffers        []*gltfBuffer     `json:"buffers,omitempty"`
+}
+
+type gltfScene struct {
+	Name  string `json:"name,omitempty"`
+	Nodes []int  `json:"nodes,omitempty"`
+}
+
+type gltfNode struct {
+	Name        string       `json:"name,omitempty"`
+	Children    []int        `json:"children,omitempty"`
+	Matrix      *[16]float64 `json:"matrix,omitempty"`
+	Translation *[3]float64  `json:"translation,omitempty"`
+	Rotation    *[4]float64  `json:"rotation,omitempty"`
+	Scale       *[3]float64  `json:"scale,omitempty"`
+	Mesh        *int         `json:"mesh,omitempty"`
+	Skin        *int         `json:"skin,omitempty"`
+}
+
+type gltfMesh struct {
+	Name       string           `json:"name,omitempty"`
+	Primitives []*gltfPrimitive `json:"primitives"`
+	Weights    []float64        `json:"weights,omitempty"`
+}
+
+type gltfPrimitive struct {
+	Attributes map[string]int   `json:"attributes"`
+	Indices    *int             `json:"indices,omitempty"`
+	Material   *int             `json:"material,omitempty"`
+	Mode       *int             `json:"mode,omitempty"`
+	Targets    []map[string]int `json:"targets,omitempty"`
+}
+
+type gltfSkin struct {
+	Name                string `json:"name,omitempty"`
+	InverseBindMatrices *int   `json:"inverseBindMatrices,omitempty"`
+	Joints              []int  `json:"joints"`
+}
+
+type gltfMaterial struct {
+	Name                 string                 `json:"name,omitempty"`
+	PbrMetallicRoughness *gltfPbr               `json:"pbrMetallicRoughness,omitempty"`
+	EmissiveFactor       *[3]float64            `json:"emissiveFactor,omitempty"`
+	EmissiveTexture      *gltfTextureInfo       `json:"emissiveTexture,omitempty"`
+	AlphaMode            string                 `json:"alphaMode,omitempty"`
+	Extensions           map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type gltfPbr struct {
+	BaseColorFactor  *[4]float64      `json:"baseColorFactor,omitempty"`
+	BaseColorTexture *gltfTextureInfo `json:"baseColorTexture,omitempty"`
+	MetallicFactor   float64          `json:"metallicFactor"`
+	RoughnessFactor  float64          `json:"roughnessFactor"`
+}
+
+type gltfTextureInfo struct {
+	Index    int `json:"index"`
+	TexCoord int `json:"texCoord,omitempty"`
+}
+
+type gltfTexture struct {
+	Sampler *int `json:"sampler,omitempty"`
+	Source  *int `json:"source,omitempty"`
+}
+
+type gltfImage struct {
+	Name       string `json:"name,omitempty"`
+	Uri        string `json:"uri,omitempty"`
+	MimeType   string `json:"mimeType,omitempty"`
+	BufferView *int   `json:"bufferView,omitempty"`
+}
+
+type gltfSampler struct {
+	MagFilter int `json:"magFilter,omitempty"`
+	MinFilter int `json:"minFilter,omitempty"`
+	WrapS     int `json:"wrapS,omitempty"`
+	WrapT     int `json:"wrapT,omitempty"`
+}
+
+type gltfAnimation struct {
+	Name     string                  `json:"name,omitempty"`
+	Channels []*gltfAnimationChannel `json:"channels"`
+	Samplers []*gltfAnimationSampler `json:"samplers"`
+}
+
+type gltfAnimationChannel struct {
+	Sampler int `json:"sampler"`
+	Target  struct {
+		Node int    `json:"node"`
+		Path string `json:"path"`
+	} `json:"target"`
+}
+
+type gltfAnimationSampler struct {
+	Input         int    `json:"input"`
+	Output        int    `json:"output"`
+	Interpolation string `json:"interpolation,omitempty"`
+}
+
+type gltfAccessor struct {
+	BufferView    int       `json:"bufferView"`
+	ComponentType int       `json:"componentType"`
+	Count         int       `json:"count"`
+	Type          string    `json:"type"`
+	Min           []float64 `json:"min,omitempty"`
+	Max           []float64 `json:"max,omitempty"`
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset,omitempty"`
+	ByteLength int `json:"byteLength"`
+	Target     int `json:"target,omitempty"`
+}
+
+type gltfBuffer struct {
+	ByteLength int    `json:"byteLength"`
+	Uri        string `json:"uri,omitempty"`
+}
+
+const (
+	gltfArrayBuffer        = 34962
+	gltfElementArrayBuffer = 34963
+
+	gltfUnsignedShort = 5123
+	gltfUnsignedInt   = 5125
+	gltfFloat         = 5126
+)
+
+var (
+	gltfTypeSizes = map[string]int{"SCALAR": 1, "VEC2": 2, "VEC3": 3, "VEC4": 4, "MAT4": 16}
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+//	Appends data to me.bin, starting at a multiple of 4 bytes, and adds a buffer view for it.
+func (me *exporter) addBufferView(data []byte, target int) (view int) {
+	me.bin = pad4(me.bin, 0)
+	view = len(me.gltf.BufferViews)
+	me.gltf.BufferViews = append(me.gltf.BufferViews, &gltfBufferView{ByteOffset: len(me.bin), ByteLength: len(data), Target: target})
+	me.bin = append(me.bin, data...)
+	return
+}
+
+//	Adds an accessor of the specified type (such as "VEC3") for vals, in a buffer view of its own.
+//	If minMax is true, the accessor declares the minimum and maximum of each component.
+func (me *exporter) addFloats(vals []float32, typ string, target int, minMax bool) (accessor int) {
+	size := gltfTypeSizes[typ]
+	data := make([]byte, 4*len(vals))
+	for i, f := range vals {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(f))
+	}
+	acc := &gltfAccessor{BufferView: me.addBufferView(data, target), ComponentType: gltfFloat, Count: len(vals) / size, Type: typ}
+	if minMax && (acc.Count > 0) {
+		acc.Min, acc.Max = make([]float64, size), make([]float64, size)
+		for i, f := range vals {
+			if c := i % size; (i < size) || (float64(f) < acc.Min[c]) {
+				acc.Min[c] = float64(f)
+			}
+			if c := i % size; (i < size) || (float64(f) > acc.Max[c]) {
+				acc.Max[c] = float64(f)
+			}
+		}
+	}
+	accessor = len(me.gltf.Accessors)
+	me.gltf.Accessors = append(me.gltf.Accessors, acc)
+	return
+}
+
+//	Adds an accessor of unsigned shorts of the specified type (such as "VEC4") for vals, in a buffer view of its own.
+func (me *exporter) addShorts(vals []uint16, typ string, target int) (accessor int) {
+	data := make([]byte, 2*len(vals))
+	for i, u := range vals {
+		binary.LittleEndian.PutUint16(data[2*i:], u)
+	}
+	accessor = len(me.gltf.Accessors)
+	me.gltf.Accessors = append(me.gltf.Accessors, &gltfAccessor{BufferView: me.addBufferView(data, target), ComponentType: gltfUnsignedShort, Count: len(vals) / gltfTypeSizes[typ], Type: typ})
+	return
+}
+
+//	Adds an accessor for the vertex indices ind, as unsigned shorts if all of vertexCount vertices can be addressed that way.
+func (me *exporter) addIndices(ind []uint32, vertexCount int) (accessor int) {
+	if vertexCount <= math.MaxUint16 {
+		shorts := make([]uint16, len(ind))
+		for i, u := range ind {
+			shorts[i] = uint16(u)
+		}
+		return me.addShorts(shorts, "SCALAR", gltfElementArrayBuffer)
+	}
+	data := make([]byte, 4*len(ind))
+	for i, u := range ind {
+		binary.LittleEndian.PutUint32(data[4*i:], u)
+	}
+	accessor = len(me.gltf.Accessors)
+	me.gltf.Accessors = append(me.gltf.Accessors, &gltfAccessor{BufferView: me.addBufferView(data, gltfElementArrayBuffer), ComponentType: gltfUnsignedInt, Count: len(ind), Type: "SCALAR"})
+	return
+}
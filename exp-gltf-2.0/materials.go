@@ -0,0 +1,318 @@
+package gltfexp
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+var (
+	imageMimeTypes = map[string]string{"PNG": "image/png", "JPG": "image/jpeg", "JPEG": "image/jpeg"}
+)
+
+//	Resolves the parameters and texture coordinate bindings of one material instance.
+type materialScope struct {
+	exp      *exporter
+	inst     *cdom.FxMaterialInst
+	effect   *cdom.FxEffectDef
+	prof     *cdom.FxProfile
+	texCoord int
+}
+
+//	Returns the index of the glTF material converted from the material bound to symbol by binding, adding it first
+//	unless it was already added for the same material instance. Returns nil if symbol is not bound to a material
+//	with a common profile. Textures use the first TEXCOORD set of bufs, unless the material instance binds their
+//	TexCoord to another set.
+func (me *exporter) material(symbol string, binding *cdom.MaterialBinding, bufs *cdomutil.GeometryBuffers) (index *int) {
+	var scope materialScope
+	if binding != nil {
+		for _, inst := range binding.TC.Materials {
+			if inst.Symbol == symbol {
+				scope.inst = inst
+				break
+			}
+		}
+	}
+	if scope.inst == nil {
+		return
+	}
+	def := scope.inst.EnsureDef(me.reg)
+	if def == nil {
+		return
+	}
+	if scope.effect = def.Effect.EnsureDef(me.reg); scope.effect != nil {
+		scope.prof = scope.effect.Common()
+	}
+	if scope.prof == nil {
+		return
+	}
+	for set := 0; set <= 1; set++ {
+		if bufs.Attribs[attribTexCoord0+set].Found {
+			scope.texCoord = set
+			break
+		}
+	}
+	cacheKey := fmt.Sprintf("%p %d", scope.inst, scope.texCoord)
+	if i, ok := me.materials[cacheKey]; ok {
+		return intPtr(i)
+	}
+	scope.exp = me
+	mat := scope.convert(nameOr(def.Name, def.Id))
+	me.materials[cacheKey] = len(me.gltf.Materials)
+	me.gltf.Materials = append(me.gltf.Materials, mat)
+	return intPtr(me.materials[cacheKey])
+}
+
+//	Approximates the common technique of me.prof by a glTF material.
+func (me *materialScope) convert(name string) (mat *gltfMaterial) {
+	tc := &me.prof.Common.Technique
+	mat = &gltfMaterial{Name: name}
+	pbr := &gltfPbr{RoughnessFactor: 1}
+	base, baseTex := [4]float64{1, 1, 1, 1}, (*gltfTextureInfo)(nil)
+	var emissive [3]float64
+	if col, ok := me.color(tc.Emission); ok {
+		emissive = [3]float64{col[0], col[1], col[2]}
+	} else if mat.EmissiveTexture = me.texture(tc.Emission); mat.EmissiveTexture != nil {
+		emissive = [3]float64{1, 1, 1}
+	}
+	if (tc.Kind == cdom.FxTechniqueKindConstant) && !me.exp.bag.NoUnlit {
+		me.exp.useExtension("KHR_materials_unlit")
+		mat.Extensions = map[string]interface{}{"KHR_materials_unlit": struct{}{}}
+		base = [4]float64{emissive[0], emissive[1], emissive[2], 1}
+		baseTex, mat.EmissiveTexture, emissive = mat.EmissiveTexture, nil, [3]float64{}
+	} else if tc.Kind == cdom.FxTechniqueKindConstant {
+		base = [4]float64{0, 0, 0, 1}
+	} else {
+		if col, ok := me.color(tc.Diffuse); ok {
+			base = [4]float64{col[0], col[1], col[2], 1}
+		} else {
+			baseTex = me.texture(tc.Diffuse)
+		}
+		if (tc.Kind == cdom.FxTechniqueKindBlinn) || (tc.Kind == cdom.FxTechniqueKindPhong) {
+			spec, hasSpec := me.color(tc.Specular)
+			if n, ok := me.float(tc.Shininess); ok && !(hasSpec && (spec[0] == 0) && (spec[1] == 0) && (spec[2] == 0)) {
+				//	maps the Blinn-Phong exponent to the GGX roughness of a similarly wide highlight
+				pbr.RoughnessFactor = math.Sqrt(2 / (math.Max(n, 0) + 2))
+			}
+		}
+	}
+	if alpha, blend := me.opacity(tc); blend || (alpha < 1) {
+		base[3], mat.AlphaMode = alpha, "BLEND"
+	}
+	for i := range base {
+		base[i] = math.Max(0, math.Min(1, base[i]))
+	}
+	if base != [4]float64{1, 1, 1, 1} {
+		pbr.BaseColorFactor = &base
+	}
+	pbr.BaseColorTexture = baseTex
+	if emissive != [3]float64{} {
+		for i := range emissive {
+			emissive[i] = math.Max(0, math.Min(1, emissive[i]))
+		}
+		mat.EmissiveFactor = &emissive
+	}
+	mat.PbrMetallicRoughness = pbr
+	return
+}
+
+//	Returns the opacity declared by the Transparent and Transparency of tc, as per its Opaque mode,
+//	and whether it is provided by a texture (then, alpha is 1).
+func (me *materialScope) opacity(tc *cdom.FxTechniqueCommon) (alpha float64, textured bool) {
+	alpha = 1
+	if tc.Transparent == nil {
+		return
+	}
+	amount, ok := me.float(tc.Transparency)
+	if !ok {
+		amount = 1
+	}
+	if col, ok := me.color(tc.Transparent); ok {
+		lum := 0.2126*col[0] + 0.7152*col[1] + 0.0722*col[2]
+		switch tc.Transparent.Opaque {
+		case cdom.FxTextureOpaqueA1:
+			alpha = col[3] * amount
+		case cdom.FxTextureOpaqueA0:
+			alpha = 1 - col[3]*amount
+		case cdom.FxTextureOpaqueRgb0:
+			alpha = 1 - lum*amount
+		case cdom.FxTextureOpaqueRgb1:
+			alpha = lum * amount
+		}
+	} else {
+		textured = tc.Transparent.Texture != nil
+	}
+	alpha = math.Max(0, math.Min(1, alpha))
+	return
+}
+
+//	Returns the value of the parameter with the specified Sid, as declared by the profile or the effect, or nil.
+func (me *materialScope) param(sid string) interface{} {
+	for _, params := range []cdom.FxParamDefs{me.prof.NewParams, me.effect.NewParams} {
+		if pd := params[sid]; pd != nil {
+			return pd.Value
+		}
+	}
+	return nil
+}
+
+//	Returns the literal (or parameter-provided) color of ct, if any.
+func (me *materialScope) color(ct *cdom.FxColorOrTexture) (col [4]float64, ok bool) {
+	if ct == nil {
+		return
+	}
+	if ok = ct.Color != nil; ok {
+		col = [4]float64{float64(ct.Color.R), float64(ct.Color.G), float64(ct.Color.B), float64(ct.Color.A)}
+	} else if len(ct.ParamRef.S) > 0 {
+		switch v := me.param(ct.ParamRef.S).(type) {
+		case cdom.Float4:
+			col, ok = [4]float64(v), true
+		case cdom.Float3:
+			col, ok = [4]float64{v[0], v[1], v[2], 1}, true
+		case *cdom.FxColor:
+			col, ok = [4]float64{float64(v.R), float64(v.G), float64(v.B), float64(v.A)}, true
+		}
+	}
+	return
+}
+
+//	Returns the literal (or parameter-provided) value of pf, if any.
+func (me *materialScope) float(pf *cdom.ParamOrSidFloat) (f float64, ok bool) {
+	if pf == nil {
+		return
+	}
+	if len(pf.Param.S) == 0 {
+		f, ok = pf.F.F, true
+	} else {
+		switch v := me.param(pf.Param.S).(type) {
+		case float64:
+			f, ok = v, true
+		case *cdom.SidFloat:
+			f, ok = v.F, true
+		}
+	}
+	return
+}
+
+//	Returns the glTF texture referred to by the Texture of ct, or nil if ct has none or if its image cannot be
+//	found or converted. The Sampler2D of the texture refers to a parameter holding an *cdom.FxSampler, or else
+//	(as some exporters write) directly to the Id of an FxImageDef.
+func (me *materialScope) texture(ct *cdom.FxColorOrTexture) (info *gltfTextureInfo) {
+	if (ct == nil) || (ct.Texture == nil) {
+		return
+	}
+	var (
+		img *cdom.FxImageDef
+		smp *cdom.FxSampler
+	)
+	if smp, _ = me.param(ct.Texture.Sampler2D.S).(*cdom.FxSampler); (smp != nil) && (smp.Image != nil) {
+		img = smp.Image.EnsureDef(me.exp.reg)
+	} else {
+		img = cdom.RefId(ct.Texture.Sampler2D.S).FxImageDef(me.exp.reg)
+	}
+	if img == nil {
+		return
+	}
+	key := [2]int{me.exp.image(img), me.exp.sampler(smp)}
+	if key[0] < 0 {
+		return
+	}
+	index, ok := me.exp.textures[key]
+	if !ok {
+		tex := &gltfTexture{Source: intPtr(key[0])}
+		if key[1] >= 0 {
+			tex.Sampler = intPtr(key[1])
+		}
+		index = len(me.exp.gltf.Textures)
+		me.exp.gltf.Textures = append(me.exp.gltf.Textures, tex)
+		me.exp.textures[key] = index
+	}
+	info = &gltfTextureInfo{Index: index, TexCoord: me.texCoord}
+	for _, vib := range me.inst.VertexInputBindings {
+		if (vib.Semantic == ct.Texture.TexCoord) && (vib.InputSemantic == "TEXCOORD") {
+			if info.TexCoord = 0; (vib.InputSet != nil) && (*vib.InputSet <= 1) {
+				info.TexCoord = int(*vib.InputSet)
+			}
+			break
+		}
+	}
+	return
+}
+
+//	Returns the index of the glTF image converted from the InitFrom of def (or else the first InitFrom of its
+//	Create2D), adding it first unless it was already added. Returns -1 if def has neither a RefUrl nor raw PNG or JPEG data.
+func (me *exporter) image(def *cdom.FxImageDef) (index int) {
+	if i, ok := me.images[def]; ok {
+		return i
+	}
+	var from *cdom.FxInitFrom
+	if def.InitFrom != nil {
+		from = &def.InitFrom.FxInitFrom
+	} else if (def.Create2D != nil) && (len(def.Create2D.InitFrom) > 0) && (def.Create2D.InitFrom[0] != nil) {
+		from = &def.Create2D.InitFrom[0].FxInitFrom
+	}
+	index = -1
+	if from != nil {
+		img := &gltfImage{Name: nameOr(def.Name, def.Id)}
+		if len(from.RefUrl) > 0 {
+			img.Uri = from.RefUrl
+		} else if mime := imageMimeTypes[strings.ToUpper(from.Raw.Format)]; (len(mime) > 0) && (len(from.Raw.Data) > 0) {
+			img.MimeType, img.BufferView = mime, intPtr(me.addBufferView(from.Raw.Data, 0))
+		} else {
+			img = nil
+		}
+		if img != nil {
+			index = len(me.gltf.Images)
+			me.gltf.Images = append(me.gltf.Images, img)
+		}
+	}
+	me.images[def] = index
+	return
+}
+
+//	Returns the index of the glTF sampler with the filtering and wrapping of smp, adding it first unless an equal
+//	one was already added. Returns -1 if smp is nil.
+func (me *exporter) sampler(smp *cdom.FxSampler) (index int) {
+	if smp == nil {
+		return -1
+	}
+	var gs gltfSampler
+	if f := smp.Filtering; f != nil {
+		gs.MagFilter, gs.MinFilter = 9729, 9729
+		if f.FilterMag == cdom.FxFilterKindNearest {
+			gs.MagFilter = 9728
+		}
+		if f.FilterMin == cdom.FxFilterKindNearest {
+			gs.MinFilter = 9728
+		}
+		switch f.FilterMip {
+		case cdom.FxFilterKindNearest:
+			gs.MinFilter += 9984 - 9728
+		case cdom.FxFilterKindLinear, cdom.FxFilterKindAnisotropic:
+			gs.MinFilter += 9986 - 9728
+		}
+	}
+	if w := smp.Wrapping; w != nil {
+		gs.WrapS, gs.WrapT = wrapMode(w.WrapS), wrapMode(w.WrapT)
+	}
+	index, ok := me.samplers[gs]
+	if !ok {
+		index = len(me.gltf.Samplers)
+		me.gltf.Samplers = append(me.gltf.Samplers, &gs)
+		me.samplers[gs] = index
+	}
+	return
+}
+
+func wrapMode(kind cdom.FxWrapKind) int {
+	switch kind {
+	case cdom.FxWrapKindRepeat, 0:
+		return 10497
+	case cdom.FxWrapKindMirror:
+		return 33648
+	}
+	return 33071
+}
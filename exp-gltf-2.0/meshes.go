@@ -0,0 +1,364 @@
+package gltfexp
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+const (
+	//	Semantics of the synthetic inputs added by withIndexInputs(), providing the index
+	//	of the position (or normal) that each corner was assembled from.
+	positionIndexSemantic = "GLTF-POSITION-INDEX"
+	normalIndexSemantic   = "GLTF-NORMAL-INDEX"
+)
+
+//	The layout compiled for every mesh, see the attrib* constants.
+var meshLayout = []cdomutil.GeometryVertexAttrib{
+	{Semantic: "POSITION", Size: 3},
+	{Semantic: "NORMAL", Size: 3},
+	{Semantic: "TEXCOORD", Size: 2},
+	{Semantic: "TEXCOORD", Set: 1, Size: 2},
+	{Semantic: "COLOR"},
+	{Semantic: positionIndexSemantic, Size: 1},
+	{Semantic: normalIndexSemantic, Size: 1},
+}
+
+const (
+	attribPosition = iota
+	attribNormal
+	attribTexCoord0
+	attribTexCoord1
+	attribColor
+	attribPositionIndex
+	attribNormalIndex
+)
+
+//	Returns the index of the glTF mesh converted from mesh with the material binding of an instance (and the skin and
+//	morph targets of a controller instance, if skin or morph are not nil), adding it first unless it was already added
+//	for the same key object (a GeometryDef or ControllerDef) and binding. Returns -1 if mesh has no primitives.
+func (me *exporter) mesh(key interface{}, name string, mesh *cdom.GeometryMesh, binding *cdom.MaterialBinding, skin *cdomutil.Skinner, morph *cdomutil.Morpher) (index int, err error) {
+	cacheKey := fmt.Sprintf("%p %s", key, bindingKey(binding))
+	if i, ok := me.meshes[cacheKey]; ok {
+		return i, nil
+	}
+	src := mesh
+	if (skin != nil) || (morph != nil) {
+		src = withIndexInputs(mesh)
+	}
+	var bufs *cdomutil.GeometryBuffers
+	if bufs, err = cdomutil.GeometryCompile(src, meshLayout...); err != nil {
+		err = fmt.Errorf("mesh '%s': %s", name, err.Error())
+		return
+	}
+	index = -1
+	if len(bufs.Groups) > 0 {
+		gm := &gltfMesh{Name: name}
+		attribs := me.meshAttribs(bufs, skin)
+		var targets []map[string]int
+		if morph != nil {
+			targets, gm.Weights = me.morphTargets(bufs, morph, skin), append([]float64{}, morph.Weights...)
+		}
+		for _, group := range bufs.Groups {
+			prim := &gltfPrimitive{Attributes: attribs, Targets: targets}
+			prim.Indices = intPtr(me.addIndices(bufs.Indices[group.IndexOffset:group.IndexOffset+group.IndexCount], bufs.VertexCount()))
+			if group.Kind == cdom.GeometryPrimitiveKindLines {
+				prim.Mode = intPtr(1)
+			}
+			prim.Material = me.material(group.Material, binding, bufs)
+			gm.Primitives = append(gm.Primitives, prim)
+		}
+		index = len(me.gltf.Meshes)
+		me.gltf.Meshes = append(me.gltf.Meshes, gm)
+	}
+	me.meshes[cacheKey] = index
+	return
+}
+
+//	Returns the Symbol and Id of each material instance of binding, sorted.
+func bindingKey(binding *cdom.MaterialBinding) string {
+	var syms []string
+	if binding != nil {
+		for _, inst := range binding.TC.Materials {
+			syms = append(syms, fmt.Sprintf("%s=%s", inst.Symbol, inst.DefRef))
+		}
+	}
+	sort.Strings(syms)
+	return strings.Join(syms, " ")
+}
+
+//	Adds the vertex attributes of bufs, transforming positions and normals by the BindShapeMatrix of skin (if any).
+func (me *exporter) meshAttribs(bufs *cdomutil.GeometryBuffers, skin *cdomutil.Skinner) (attribs map[string]int) {
+	attribs = map[string]int{}
+	n := bufs.VertexCount()
+	bindShape, bindNormal := bindShapeMatrices(skin)
+	pos, nor := make([]float32, 0, 3*n), make([]float32, 0, 3*n)
+	for v := 0; v < n; v++ {
+		p, nv := transformVec(&bindShape, vertexVec3(bufs, v, attribPosition), 1), transformVec(&bindNormal, vertexVec3(bufs, v, attribNormal), 0)
+		pos, nor = append(pos, float32(p[0]), float32(p[1]), float32(p[2])), append(nor, unitVec(nv)...)
+	}
+	attribs["POSITION"] = me.addFloats(pos, "VEC3", gltfArrayBuffer, true)
+	if bufs.Attribs[attribNormal].Found {
+		attribs["NORMAL"] = me.addFloats(nor, "VEC3", gltfArrayBuffer, false)
+	}
+	//	TEXCOORD_n is Collada set n: so if only set 1 is found, TEXCOORD_0 is added (holding zeros) as well
+	for set, last := 0, lastTexCoordSet(bufs); set <= last; set++ {
+		uvs := make([]float32, 0, 2*n)
+		for v := 0; v < n; v++ {
+			uv := vertexAttrib(bufs, v, attribTexCoord0+set)
+			uvs = append(uvs, uv[0], 1-uv[1])
+		}
+		attribs[fmt.Sprintf("TEXCOORD_%d", set)] = me.addFloats(uvs, "VEC2", gltfArrayBuffer, false)
+	}
+	if ba := bufs.Attribs[attribColor]; ba.Found && ((ba.Size == 3) || (ba.Size == 4)) {
+		cols := make([]float32, 0, ba.Size*n)
+		for v := 0; v < n; v++ {
+			cols = append(cols, vertexAttrib(bufs, v, attribColor)...)
+		}
+		attribs["COLOR_0"] = me.addFloats(cols, fmt.Sprintf("VEC%d", ba.Size), gltfArrayBuffer, false)
+	}
+	if (skin != nil) && (len(skin.Joints) > 0) {
+		joints, weights := make([]uint16, 0, 4*n), make([]float32, 0, 4*n)
+		for v := 0; v < n; v++ {
+			js, ws := vertexInfluences(skin, int(vertexAttrib(bufs, v, attribPositionIndex)[0]))
+			for i := range js {
+				joints, weights = append(joints, uint16(js[i])), append(weights, float32(ws[i]))
+			}
+		}
+		attribs["JOINTS_0"] = me.addShorts(joints, "VEC4", gltfArrayBuffer)
+		attribs["WEIGHTS_0"] = me.addFloats(weights, "VEC4", gltfArrayBuffer, false)
+	}
+	return
+}
+
+//	Returns the last TEXCOORD set of meshLayout that bufs provides, or -1 if none.
+func lastTexCoordSet(bufs *cdomutil.GeometryBuffers) (set int) {
+	for set = 1; set >= 0; set-- {
+		if bufs.Attribs[attribTexCoord0+set].Found {
+			break
+		}
+	}
+	return
+}
+
+//	Returns the four strongest joint influences of vertex v of skin, with their weights normalized.
+//	Influences of the bind shape are dropped. A vertex without any influence is bound to the first joint.
+func vertexInfluences(skin *cdomutil.Skinner, v int) (joints [4]int, weights [4]float64) {
+	js, ws := skin.Influences(v)
+	//	insertion sort by descending weight, keeping the order of equal weights
+	order := make([]int, 0, len(js))
+	for i, j := range js {
+		if (j >= 0) && (ws[i] > 0) {
+			k := len(order)
+			for order = append(order, i); (k > 0) && (ws[order[k-1]] < ws[i]); k-- {
+				order[k] = order[k-1]
+			}
+			order[k] = i
+		}
+	}
+	var sum float64
+	for i := 0; (i < 4) && (i < len(order)); i++ {
+		joints[i], weights[i] = js[order[i]], ws[order[i]]
+		sum += weights[i]
+	}
+	if sum > 0 {
+		for i := range weights {
+			weights[i] /= sum
+		}
+	} else {
+		weights[0] = 1
+	}
+	return
+}
+
+//	Returns one glTF morph target per target of morph, holding the differences of the positions (and normals)
+//	of each vertex of bufs between the target and morph.Base, as transformed by the BindShapeMatrix of skin (if any).
+func (me *exporter) morphTargets(bufs *cdomutil.GeometryBuffers, morph *cdomutil.Morpher, skin *cdomutil.Skinner) (targets []map[string]int) {
+	n := bufs.VertexCount()
+	bindShape, bindNormal := bindShapeMatrices(skin)
+	read := func(mesh *cdom.GeometryMesh, semantic string) (vals []cdom.Float3) {
+		if src := meshSource(mesh, semantic); src != nil {
+			vals, _ = src.Float3s()
+		}
+		return
+	}
+	basePos, baseNor := read(morph.Base, "POSITION"), read(morph.Base, "NORMAL")
+	for _, target := range morph.Targets {
+		tpos, tnor := read(target, "POSITION"), read(target, "NORMAL")
+		deltas := func(base, vals []cdom.Float3, attrib int, m *cdom.Float4x4, normalize bool) (floats []float32) {
+			floats = make([]float32, 0, 3*n)
+			for v := 0; v < n; v++ {
+				var d [3]float64
+				if i := int(vertexAttrib(bufs, v, attrib)[0]); (i < len(base)) && (i < len(vals)) {
+					if b, t := transformVec(m, base[i], 0), transformVec(m, vals[i], 0); morph.Morph.Relative {
+						d = t
+					} else if normalize {
+						d = vsub(unitVec3(t), unitVec3(b))
+					} else {
+						d = vsub(t, b)
+					}
+				}
+				floats = append(floats, float32(d[0]), float32(d[1]), float32(d[2]))
+			}
+			return
+		}
+		attribs := map[string]int{"POSITION": me.addFloats(deltas(basePos, tpos, attribPositionIndex, &bindShape, false), "VEC3", gltfArrayBuffer, true)}
+		if bufs.Attribs[attribNormal].Found && (len(baseNor) > 0) {
+			attribs["NORMAL"] = me.addFloats(deltas(baseNor, tnor, attribNormalIndex, &bindNormal, true), "VEC3", gltfArrayBuffer, false)
+		}
+		targets = append(targets, attribs)
+	}
+	return
+}
+
+//	Returns the Source referred to by the first Input of mesh.Vertices with the specified semantic, or else by
+//	the first such InputShared of set 0 of mesh.Primitives, or nil if none.
+func meshSource(mesh *cdom.GeometryMesh, semantic string) *cdom.Source {
+	if mesh.Vertices != nil {
+		for _, in := range mesh.Vertices.Inputs {
+			if src := mesh.Sources[in.Source.S()]; (in.Semantic == semantic) && (src != nil) {
+				return src
+			}
+		}
+	}
+	for _, prim := range mesh.Primitives {
+		for _, in := range prim.Inputs {
+			if src := mesh.Sources[in.Source.S()]; (in.Semantic == semantic) && ((in.Set == nil) || (*in.Set == 0)) && (src != nil) {
+				return src
+			}
+		}
+	}
+	return nil
+}
+
+//	Returns a shallow copy of mesh with an additional Source and Input for each of its "POSITION" and "NORMAL"
+//	Sources (as per meshSource()), so that GeometryCompile() reports the index of the position and normal of
+//	each vertex: with the positionIndexSemantic (or normalIndexSemantic) next to the original Input, the Source
+//	of which holds the numbers from 0 to the number of elements of the original Source.
+func withIndexInputs(mesh *cdom.GeometryMesh) (res *cdom.GeometryMesh) {
+	res = &cdom.GeometryMesh{}
+	*res = *mesh
+	res.Sources = cdom.Sources{}
+	for id, src := range mesh.Sources {
+		res.Sources[id] = src
+	}
+	if mesh.Vertices != nil {
+		res.Vertices = &cdom.GeometryVertices{}
+		*res.Vertices = *mesh.Vertices
+		res.Vertices.Inputs = append([]*cdom.Input{}, mesh.Vertices.Inputs...)
+	}
+	res.Primitives = make([]*cdom.GeometryPrimitives, len(mesh.Primitives))
+	for i, prim := range mesh.Primitives {
+		cp := *prim
+		cp.Inputs = append([]*cdom.InputShared{}, prim.Inputs...)
+		res.Primitives[i] = &cp
+	}
+	for _, sem := range [][2]string{{"POSITION", positionIndexSemantic}, {"NORMAL", normalIndexSemantic}} {
+		src := meshSource(mesh, sem[0])
+		if src == nil {
+			continue
+		}
+		indices := make([]float64, src.Count())
+		for i := range indices {
+			indices[i] = float64(i)
+		}
+		idx := cdomutil.NewSourceFloats(src.Id+"-"+sem[1], indices, "I")
+		res.Sources[idx.Id] = idx
+		if res.Vertices != nil {
+			for _, in := range mesh.Vertices.Inputs {
+				if in.Source.S() == src.Id {
+					res.Vertices.Inputs = append(res.Vertices.Inputs, &cdom.Input{Semantic: sem[1], Source: cdom.RefId(idx.Id)})
+					break
+				}
+			}
+		}
+		for _, prim := range res.Primitives {
+			for _, in := range prim.Inputs {
+				if (in.Semantic == sem[0]) && (in.Source.S() == src.Id) {
+					prim.Inputs = append(prim.Inputs, &cdom.InputShared{Input: cdom.Input{Semantic: sem[1], Source: cdom.RefId(idx.Id)}, Offset: in.Offset, Set: in.Set})
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+//	Returns the index of the glTF skin for the joints of skinner, adding it first unless it was already added for def.
+func (me *exporter) skin(def *cdom.ControllerDef, skinner *cdomutil.Skinner) (index int) {
+	joints := make([]int, len(skinner.Joints))
+	for j, sn := range skinner.Joints {
+		joints[j] = me.nodeIndex[sn]
+	}
+	cacheKey := fmt.Sprintf("%p %v", def, joints)
+	if i, ok := me.skins[cacheKey]; ok {
+		return i
+	}
+	gs := &gltfSkin{Name: nameOr(def.Name, def.Id), Joints: joints}
+	if len(joints) > 0 {
+		ibms := make([]float32, 0, 16*len(joints))
+		for j := range skinner.InvBindMatrices {
+			for _, f := range colMajor(&skinner.InvBindMatrices[j]) {
+				ibms = append(ibms, float32(f))
+			}
+		}
+		gs.InverseBindMatrices = intPtr(me.addFloats(ibms, "MAT4", 0, false))
+	}
+	index = len(me.gltf.Skins)
+	me.gltf.Skins = append(me.gltf.Skins, gs)
+	me.skins[cacheKey] = index
+	return
+}
+
+//	Returns the BindShapeMatrix of the Skin of skinner and its normal matrix, or identity matrices if skinner is nil.
+func bindShapeMatrices(skinner *cdomutil.Skinner) (bindShape, bindNormal cdom.Float4x4) {
+	if bindShape = cdomutil.Float4x4Identity(); skinner != nil {
+		bindShape = cdom.Float4x4(skinner.Skin.BindShapeMatrix)
+	}
+	bindNormal = cdomutil.Float4x4NormalMatrix(&bindShape)
+	return
+}
+
+func vertexAttrib(bufs *cdomutil.GeometryBuffers, v, attrib int) []float32 {
+	ba := bufs.Attribs[attrib]
+	off := v*bufs.VertexSize + ba.Offset
+	return bufs.Vertices[off : off+ba.Size]
+}
+
+func vertexVec3(bufs *cdomutil.GeometryBuffers, v, attrib int) (vec cdom.Float3) {
+	for i, f := range vertexAttrib(bufs, v, attrib) {
+		vec[i] = float64(f)
+	}
+	return
+}
+
+//	Returns m * vec, with vec as a point if w is 1, or as a direction if w is 0.
+func transformVec(m *cdom.Float4x4, vec cdom.Float3, w float64) (res [3]float64) {
+	for r := 0; r < 3; r++ {
+		res[r] = m[r*4]*vec[0] + m[r*4+1]*vec[1] + m[r*4+2]*vec[2] + m[r*4+3]*w
+	}
+	return
+}
+
+func unitVec3(v [3]float64) [3]float64 {
+	if l := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2]); l > 0 {
+		return [3]float64{v[0] / l, v[1] / l, v[2] / l}
+	}
+	return v
+}
+
+//	Returns v normalized, or (0, 0, 1) if v has no length.
+func unitVec(v [3]float64) []float32 {
+	if v = unitVec3(v); v == [3]float64{} {
+		v[2] = 1
+	}
+	return []float32{float32(v[0]), float32(v[1]), float32(v[2])}
+}
+
+func vsub(a, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
@@ -0,0 +1,226 @@
+package gltfexp
+
+import (
+	"math"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	cdomutil "github.com/metaleap/go-collada/dom/util"
+)
+
+//	Adds one glTF node per occurrence in me.nodes (below the node returned by axesNode(), if any) to a
+//	new glTF scene, then converts the geometry and controller instances of all occurrences.
+func (me *exporter) initScene(vs *cdom.VisualSceneDef) (err error) {
+	scene := &gltfScene{Name: nameOr(vs.Name, vs.Id)}
+	roots := &scene.Nodes
+	if axes := me.axesNode(); axes != nil {
+		scene.Nodes, roots = []int{me.addNode(axes)}, &axes.Children
+	}
+	for _, sn := range me.nodes {
+		node := &gltfNode{Name: nameOr(sn.Node.Name, sn.Node.Id)}
+		if len(node.Name) == 0 {
+			node.Name = sn.Node.Sid
+		}
+		setNodeTransform(node, &sn.Local, me.animated[sn.Node])
+		index := me.addNode(node)
+		me.nodeIndex[sn], me.defNodes[sn.Node] = index, append(me.defNodes[sn.Node], index)
+		if sn.Parent != nil {
+			parent := me.gltf.Nodes[me.nodeIndex[sn.Parent]]
+			parent.Children = append(parent.Children, index)
+		} else {
+			*roots = append(*roots, index)
+		}
+	}
+	for _, sn := range me.nodes {
+		if err = me.initInsts(sn); err != nil {
+			return
+		}
+	}
+	me.gltf.Scene, me.gltf.Scenes = intPtr(0), []*gltfScene{scene}
+	return
+}
+
+func (me *exporter) addNode(node *gltfNode) (index int) {
+	index = len(me.gltf.Nodes)
+	me.gltf.Nodes = append(me.gltf.Nodes, node)
+	return
+}
+
+//	Returns a node that converts the UpAxis and Unit of me.doc.Asset to those of glTF,
+//	or nil if they already agree or if me.bag.KeepAxes is true.
+func (me *exporter) axesNode() (node *gltfNode) {
+	if me.bag.KeepAxes || (me.doc.Asset == nil) {
+		return
+	}
+	var rot *[4]float64
+	switch s := math.Sqrt(0.5); me.doc.Asset.UpAxis {
+	case "X":
+		rot = &[4]float64{0, 0, s, s}
+	case "Z":
+		rot = &[4]float64{-s, 0, 0, s}
+	}
+	var scale *[3]float64
+	if m := me.doc.Asset.Unit.Meter; (m > 0) && (m != 1) {
+		scale = &[3]float64{m, m, m}
+	}
+	if (rot != nil) || (scale != nil) {
+		node = &gltfNode{Name: "axes", Rotation: rot, Scale: scale}
+	}
+	return
+}
+
+//	Converts the geometry and controller instances of sn to glTF meshes (and skins). A single mesh is attached
+//	to the glTF node of sn itself, several meshes are attached to one new child node each.
+func (me *exporter) initInsts(sn *cdomutil.SceneNode) (err error) {
+	type nodeMesh struct {
+		name    string
+		mesh    int
+		skin    *int
+		weights *cdom.Source
+	}
+	var meshes []nodeMesh
+	for _, inst := range sn.Node.Insts.Geometry {
+		if geo := inst.EnsureDef(me.reg); (geo != nil) && (geo.Mesh != nil) {
+			nm := nodeMesh{name: nameOr(inst.Name, geo.Id)}
+			if nm.mesh, err = me.mesh(geo, nameOr(geo.Name, geo.Id), geo.Mesh, inst.MaterialBinding, nil, nil); err != nil {
+				return
+			} else if nm.mesh >= 0 {
+				meshes = append(meshes, nm)
+			}
+		}
+	}
+	for _, inst := range sn.Node.Insts.Controller {
+		def := inst.EnsureDef(me.reg)
+		if def == nil {
+			continue
+		}
+		var (
+			skinner *cdomutil.Skinner
+			morpher *cdomutil.Morpher
+		)
+		nm := nodeMesh{name: nameOr(inst.Name, def.Id)}
+		if def.Skin != nil {
+			if skinner, err = cdomutil.NewSkinner(inst, me.nodes, me.reg); err == nil {
+				morpher = skinner.Morpher
+				nm.skin = intPtr(me.skin(def, skinner))
+			}
+		} else if def.Morph != nil {
+			morpher, err = cdomutil.NewMorpher(def, me.reg)
+		}
+		if err != nil {
+			return
+		}
+		var mesh *cdom.GeometryMesh
+		if morpher != nil {
+			mesh, nm.weights = morpher.Base, morpher.WeightSource
+		} else if skinner != nil {
+			mesh = skinner.Mesh
+		} else {
+			continue
+		}
+		if nm.mesh, err = me.mesh(def, nameOr(def.Name, def.Id), mesh, inst.BindMaterial, skinner, morpher); err != nil {
+			return
+		} else if nm.mesh >= 0 {
+			meshes = append(meshes, nm)
+		}
+	}
+	for _, nm := range meshes {
+		index := me.nodeIndex[sn]
+		if len(meshes) > 1 {
+			index = me.addNode(&gltfNode{Name: nm.name})
+			me.gltf.Nodes[me.nodeIndex[sn]].Children = append(me.gltf.Nodes[me.nodeIndex[sn]].Children, index)
+		}
+		me.gltf.Nodes[index].Mesh, me.gltf.Nodes[index].Skin = intPtr(nm.mesh), nm.skin
+		if nm.weights != nil {
+			me.weightNodes[nm.weights] = append(me.weightNodes[nm.weights], index)
+		}
+	}
+	return
+}
+
+//	Sets the transform of node to m: as translation, rotation and scale if trs is true or if m can be decomposed
+//	exactly, otherwise as matrix. Omits the components that equal those of the identity transform.
+func setNodeTransform(node *gltfNode, m *cdom.Float4x4, trs bool) {
+	t, r, s, ok := decompose(m)
+	if trs || ok {
+		if t != [3]float64{0, 0, 0} {
+			node.Translation = &t
+		}
+		if r != [4]float64{0, 0, 0, 1} {
+			node.Rotation = &r
+		}
+		if s != [3]float64{1, 1, 1} {
+			node.Scale = &s
+		}
+	} else if *m != cdomutil.Float4x4Identity() {
+		node.Matrix = colMajor(m)
+	}
+}
+
+//	Returns the column-major form of the row-major m, as used by glTF.
+func colMajor(m *cdom.Float4x4) *[16]float64 {
+	t := [16]float64(cdomutil.Float4x4Transpose(m))
+	return &t
+}
+
+//	Decomposes m into a translation, a rotation quaternion (X, Y, Z, W) and a scale, such that m equals their
+//	product T * R * S. ok is false if m has a projective part, shears or is singular: then, t, r and s only
+//	approximate m (r and s are then those of the columns of the upper-left 3x3 of m).
+func decompose(m *cdom.Float4x4) (t [3]float64, r [4]float64, s [3]float64, ok bool) {
+	t, r = [3]float64{m[3], m[7], m[11]}, [4]float64{0, 0, 0, 1}
+	var rot [3][3]float64
+	for c := 0; c < 3; c++ {
+		s[c] = math.Sqrt(m[c]*m[c] + m[4+c]*m[4+c] + m[8+c]*m[8+c])
+	}
+	if det := m[0]*(m[5]*m[10]-m[6]*m[9]) - m[1]*(m[4]*m[10]-m[6]*m[8]) + m[2]*(m[4]*m[9]-m[5]*m[8]); det < 0 {
+		s[0] = -s[0]
+	}
+	if (s[0] == 0) || (s[1] == 0) || (s[2] == 0) {
+		s = [3]float64{1, 1, 1}
+		return
+	}
+	for row := 0; row < 3; row++ {
+		for c := 0; c < 3; c++ {
+			rot[row][c] = m[row*4+c] / s[c]
+		}
+	}
+	r = quaternion(&rot)
+	//	verify by recomposing
+	var q [3][3]float64
+	x, y, z, w := r[0], r[1], r[2], r[3]
+	q[0] = [3]float64{1 - 2*(y*y+z*z), 2 * (x*y - z*w), 2 * (x*z + y*w)}
+	q[1] = [3]float64{2 * (x*y + z*w), 1 - 2*(x*x+z*z), 2 * (y*z - x*w)}
+	q[2] = [3]float64{2 * (x*z - y*w), 2 * (y*z + x*w), 1 - 2*(x*x+y*y)}
+	eps := 1e-6 * math.Max(1, math.Max(math.Abs(s[0]), math.Max(math.Abs(s[1]), math.Abs(s[2]))))
+	ok = (m[12] == 0) && (m[13] == 0) && (m[14] == 0) && (m[15] == 1)
+	for row := 0; ok && (row < 3); row++ {
+		for c := 0; ok && (c < 3); c++ {
+			ok = math.Abs(q[row][c]*s[c]-m[row*4+c]) <= eps
+		}
+	}
+	return
+}
+
+//	Returns the unit quaternion (X, Y, Z, W) of the rotation matrix m (or of the rotation closest to it, if m is not orthonormal).
+func quaternion(m *[3][3]float64) (q [4]float64) {
+	if tr := m[0][0] + m[1][1] + m[2][2]; tr > 0 {
+		f := 0.5 / math.Sqrt(tr+1)
+		q = [4]float64{(m[2][1] - m[1][2]) * f, (m[0][2] - m[2][0]) * f, (m[1][0] - m[0][1]) * f, 0.25 / f}
+	} else if (m[0][0] > m[1][1]) && (m[0][0] > m[2][2]) {
+		f := 2 * math.Sqrt(1+m[0][0]-m[1][1]-m[2][2])
+		q = [4]float64{0.25 * f, (m[0][1] + m[1][0]) / f, (m[0][2] + m[2][0]) / f, (m[2][1] - m[1][2]) / f}
+	} else if m[1][1] > m[2][2] {
+		f := 2 * math.Sqrt(1+m[1][1]-m[0][0]-m[2][2])
+		q = [4]float64{(m[0][1] + m[1][0]) / f, 0.25 * f, (m[1][2] + m[2][1]) / f, (m[0][2] - m[2][0]) / f}
+	} else {
+		f := 2 * math.Sqrt(1+m[2][2]-m[0][0]-m[1][1])
+		q = [4]float64{(m[0][2] + m[2][0]) / f, (m[1][2] + m[2][1]) / f, 0.25 * f, (m[1][0] - m[0][1]) / f}
+	}
+	if l := math.Sqrt(q[0]*q[0] + q[1]*q[1] + q[2]*q[2] + q[3]*q[3]); (l > 0) && !math.IsNaN(l) {
+		for i := range q {
+			q[i] /= l
+		}
+	} else {
+		q = [4]float64{0, 0, 0, 1}
+	}
+	return
+}
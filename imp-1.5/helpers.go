@@ -1,7 +1,9 @@
 package collimp
 
 import (
+	"strconv"
 	"strings"
+	"unicode"
 
 	xmlx "github.com/go-forks/go-pkg-xmlx"
 
@@ -16,8 +18,8 @@ const (
 	xmlns = "http://www.collada.org/2008/03/COLLADASchema"
 )
 
-func arr_Bools(xn *xmlx.Node, l int, s func(int, bool)) {
-	for i, b := range list_Bools(xn) {
+func (me *importState) arr_Bools(xn *xmlx.Node, l int, s func(int, bool)) {
+	for i, b := range me.list_Bools(xn) {
 		if i >= l {
 			break
 		}
@@ -25,8 +27,8 @@ func arr_Bools(xn *xmlx.Node, l int, s func(int, bool)) {
 	}
 }
 
-func arr_Floats(xn *xmlx.Node, l int, s func(int, float64)) {
-	for i, f := range list_Floats(xn) {
+func (me *importState) arr_Floats(xn *xmlx.Node, l int, s func(int, float64)) {
+	for i, f := range me.list_Floats(xn) {
 		if i >= l {
 			break
 		}
@@ -34,8 +36,8 @@ func arr_Floats(xn *xmlx.Node, l int, s func(int, float64)) {
 	}
 }
 
-func arr_Ints(xn *xmlx.Node, l int, s func(int, int64)) {
-	for i, n := range list_Ints(xn) {
+func (me *importState) arr_Ints(xn *xmlx.Node, l int, s func(int, int64)) {
+	for i, n := range me.list_Ints(xn) {
 		if i >= l {
 			break
 		}
@@ -43,8 +45,8 @@ func arr_Ints(xn *xmlx.Node, l int, s func(int, int64)) {
 	}
 }
 
-func arr_Uints(xn *xmlx.Node, l int, s func(int, uint64)) {
-	for i, n := range list_Uints(xn) {
+func (me *importState) arr_Uints(xn *xmlx.Node, l int, s func(int, uint64)) {
+	for i, n := range me.list_Uints(xn) {
 		if i >= l {
 			break
 		}
@@ -52,6 +54,13 @@ func arr_Uints(xn *xmlx.Node, l int, s func(int, uint64)) {
 	}
 }
 
+func (me *importState) arraySizeOk(xn *xmlx.Node, n uint64) (ok bool) {
+	if ok = (me.bag.MaxArraySize <= 0) || (n <= uint64(me.bag.MaxArraySize)); !ok {
+		me.diagf(ImportDiagError, xn, "%d values exceed the maximum array size of %d", n, me.bag.MaxArraySize)
+	}
+	return
+}
+
 func get_CubeFace(xn *xmlx.Node) (cf cdom.FxCubeFace) {
 	switch strings.ToUpper(xas(xn, "face")) {
 	case "NEGATIVE_Y":
@@ -70,8 +79,8 @@ func get_CubeFace(xn *xmlx.Node) (cf cdom.FxCubeFace) {
 	return
 }
 
-func get_LightColor(xn *xmlx.Node, oc *cdom.Float3) {
-	if c := obj_Float3(xn, "color"); c != nil {
+func (me *importState) get_LightColor(xn *xmlx.Node, oc *cdom.Float3) {
+	if c := me.obj_Float3(xn, "color"); c != nil {
 		*oc = *c
 	}
 }
@@ -88,10 +97,10 @@ func get_ParamRef(xn *xmlx.Node, name string) (v string) {
 	return
 }
 
-func get_Transforms(xn *xmlx.Node) (ts []*cdom.Transform) {
+func (me *importState) get_Transforms(xn *xmlx.Node) (ts []*cdom.Transform) {
 	for _, cn := range xn.Children {
 		if cn.Type == xmlx.NT_ELEMENT {
-			if t := obj_Transform(cn, ""); (t != nil) && (t.Kind > 0) {
+			if t := me.obj_Transform(cn, ""); (t != nil) && (t.Kind > 0) {
 				ts = append(ts, t)
 			}
 		}
@@ -99,101 +108,128 @@ func get_Transforms(xn *xmlx.Node) (ts []*cdom.Transform) {
 	return
 }
 
-func has_Asset(xn *xmlx.Node, obj *cdom.HasAsset) {
-	obj.Asset = obj_Asset(xn, "asset")
+func (me *importState) has_Asset(xn *xmlx.Node, obj *cdom.HasAsset) {
+	if !me.bag.DropAssets {
+		obj.Asset = me.obj_Asset(xn, "asset")
+	}
 }
 
-func has_Extras(xn *xmlx.Node, obj *cdom.HasExtras) {
-	obj.Extras = objs_Extra(xn, "extra")
+func (me *importState) has_Extras(xn *xmlx.Node, obj *cdom.HasExtras) {
+	if !me.bag.IgnoreExtras {
+		obj.Extras = me.objs_Extra(xn, "extra")
+	}
 }
 
-func has_FxParamDefs(xn *xmlx.Node, obj *cdom.HasFxParamDefs) {
-	for _, np := range objs_FxParamDef(xn, "newparam") {
+func (me *importState) has_FxParamDefs(xn *xmlx.Node, obj *cdom.HasFxParamDefs) {
+	for _, np := range me.objs_FxParamDef(xn, "newparam") {
 		if np != nil {
 			obj.NewParams[np.Sid] = np
 		}
 	}
 }
 
-func has_Id(xn *xmlx.Node, obj *cdom.HasId) {
-	obj.Id = xas(xn, "id")
+func (me *importState) has_Id(xn *xmlx.Node, obj *cdom.HasId) {
+	obj.Id = me.xid(xn, xas(xn, "id"))
 }
 
-func has_Inputs(xn *xmlx.Node, obj *cdom.HasInputs) {
-	obj.Inputs = objs_Input(xn, "input")
+func (me *importState) has_Inputs(xn *xmlx.Node, obj *cdom.HasInputs) {
+	obj.Inputs = me.objs_Input(xn, "input")
 }
 
-func has_Name(xn *xmlx.Node, obj *cdom.HasName) {
+func (me *importState) has_Name(xn *xmlx.Node, obj *cdom.HasName) {
 	obj.Name = xas(xn, "name")
 }
 
-func has_ParamDefs(xn *xmlx.Node, obj *cdom.HasParamDefs) {
-	for _, np := range objs_ParamDef(xn, "newparam") {
+func (me *importState) has_ParamDefs(xn *xmlx.Node, obj *cdom.HasParamDefs) {
+	for _, np := range me.objs_ParamDef(xn, "newparam") {
 		if np != nil {
 			obj.NewParams[np.Sid] = np
 		}
 	}
 }
 
-func has_ParamInsts(xn *xmlx.Node, obj *cdom.HasParamInsts) {
-	for _, sp := range objs_ParamInst(xn, "setparam") {
+func (me *importState) has_ParamInsts(xn *xmlx.Node, obj *cdom.HasParamInsts) {
+	for _, sp := range me.objs_ParamInst(xn, "setparam") {
 		if sp != nil {
 			obj.SetParams[sp.Ref.S] = sp
 		}
 	}
 }
 
-func has_Sid(xn *xmlx.Node, obj *cdom.HasSid) {
+func (me *importState) has_Sid(xn *xmlx.Node, obj *cdom.HasSid) {
 	obj.Sid = xas(xn, "sid")
 }
 
-func has_Sources(xn *xmlx.Node, obj *cdom.HasSources) {
-	for _, src := range objs_Source(xn, "source") {
+func (me *importState) has_Sources(xn *xmlx.Node, obj *cdom.HasSources) {
+	for _, src := range me.objs_Source(xn, "source") {
 		if src != nil {
 			obj.Sources[src.Id] = src
 		}
 	}
 }
 
-func has_Techniques(xn *xmlx.Node, obj *cdom.HasTechniques) {
-	obj.Techniques = objs_Technique(xn, "technique")
+func (me *importState) has_Techniques(xn *xmlx.Node, obj *cdom.HasTechniques) {
+	if !me.bag.IgnoreTechniques {
+		obj.Techniques = me.objs_Technique(xn, "technique")
+	}
 }
 
-func list_Bools(xn *xmlx.Node) (sl []bool) {
-	var v xsdt.Boolean
-	vals := xsdt.ListValues(xn.Value)
+func (me *importState) list_Bools(xn *xmlx.Node) (sl []bool) {
+	var (
+		err error
+		bad []int
+	)
+	vals := me.listValues(xn)
 	sl = make([]bool, len(vals))
 	for i, s := range vals {
-		v.Set(s)
-		sl[i] = v.B()
+		if sl[i], err = strconv.ParseBool(s); err != nil {
+			sl[i], bad = false, append(bad, i)
+		}
 	}
+	me.diagMalformed(xn, vals, bad)
 	return
 }
 
-func list_Floats(xn *xmlx.Node) (sl []float64) {
-	var v xsdt.Double
-	vals := xsdt.ListValues(xn.Value)
+func (me *importState) list_Floats(xn *xmlx.Node) (sl []float64) {
+	var (
+		err error
+		bad []int
+	)
+	if ls := me.lists[xn]; ls != nil {
+		return me.streamed(xn, ls).floats
+	}
+	vals := me.listValues(xn)
 	sl = make([]float64, len(vals))
 	for i, s := range vals {
-		v.Set(s)
-		sl[i] = v.N()
+		if sl[i], err = strconv.ParseFloat(s, 64); err != nil {
+			sl[i], bad = 0, append(bad, i)
+		}
 	}
+	me.diagMalformed(xn, vals, bad)
 	return
 }
 
-func list_Ints(xn *xmlx.Node) (sl []int64) {
-	var v xsdt.Long
-	vals := xsdt.ListValues(xn.Value)
+func (me *importState) list_Ints(xn *xmlx.Node) (sl []int64) {
+	var (
+		err error
+		bad []int
+	)
+	if ls := me.lists[xn]; ls != nil {
+		return me.streamed(xn, ls).ints
+	}
+	vals := me.listValues(xn)
 	sl = make([]int64, len(vals))
 	for i, s := range vals {
-		v.Set(s)
-		sl[i] = v.N()
+		if sl[i], err = strconv.ParseInt(s, 10, 64); err != nil {
+			sl[i], bad = 0, append(bad, i)
+		}
 	}
+	me.diagMalformed(xn, vals, bad)
 	return
 }
 
-func list_Rgba32(xn *xmlx.Node, obj *ugfx.Rgba32) {
-	if f := list_Floats(xn); len(f) > 0 {
+func (me *importState) list_Rgba32(xn *xmlx.Node, obj *ugfx.Rgba32) {
+	if f := me.list_Floats(xn); len(f) > 0 {
 		if obj.R = float32(f[0]); len(f) > 1 {
 			if obj.G = float32(f[1]); len(f) > 2 {
 				if obj.B = float32(f[2]); len(f) > 3 {
@@ -204,8 +240,26 @@ func list_Rgba32(xn *xmlx.Node, obj *ugfx.Rgba32) {
 	}
 }
 
-func list_Strings(xn *xmlx.Node) []string {
-	return xsdt.ListValues(xn.Value)
+func (me *importState) list_Strings(xn *xmlx.Node) []string {
+	return me.listValues(xn)
+}
+
+func (me *importState) listValues(xn *xmlx.Node) (vals []string) {
+	if max := me.bag.MaxArraySize; max > 0 {
+		n, inVal := 0, false
+		for _, r := range xn.Value {
+			if unicode.IsSpace(r) {
+				inVal = false
+			} else if !inVal {
+				inVal, n = true, n+1
+			}
+		}
+		if !me.arraySizeOk(xn, uint64(n)) {
+			return
+		}
+	}
+	vals = xsdt.ListValues(xn.Value)
+	return
 }
 
 func list_StringsN(xn *xmlx.Node, name string) (sl []string) {
@@ -217,48 +271,56 @@ func list_StringsN(xn *xmlx.Node, name string) (sl []string) {
 	return
 }
 
-func list_Uints(xn *xmlx.Node) (sl []uint64) {
-	var v xsdt.UnsignedLong
-	vals := xsdt.ListValues(xn.Value)
+func (me *importState) list_Uints(xn *xmlx.Node) (sl []uint64) {
+	var (
+		err error
+		bad []int
+	)
+	if ls := me.lists[xn]; ls != nil {
+		return me.streamed(xn, ls).uints
+	}
+	vals := me.listValues(xn)
 	sl = make([]uint64, len(vals))
 	for i, s := range vals {
-		v.Set(s)
-		sl[i] = v.N()
+		if sl[i], err = strconv.ParseUint(s, 10, 64); err != nil {
+			sl[i], bad = 0, append(bad, i)
+		}
 	}
+	me.diagMalformed(xn, vals, bad)
 	return
 }
 
-func listcn_Bools(xn *xmlx.Node, name string) (sl []bool) {
+func (me *importState) listcn_Bools(xn *xmlx.Node, name string) (sl []bool) {
 	if cn := xcn(xn, name); cn != nil {
-		sl = list_Bools(cn)
+		sl = me.list_Bools(cn)
 	}
 	return
 }
 
-func listcn_Floats(xn *xmlx.Node, name string) (sl []float64) {
+func (me *importState) listcn_Floats(xn *xmlx.Node, name string) (sl []float64) {
 	if cn := xcn(xn, name); cn != nil {
-		sl = list_Floats(cn)
+		sl = me.list_Floats(cn)
 	}
 	return
 }
 
-func listcn_Ints(xn *xmlx.Node, name string) (sl []int64) {
+func (me *importState) listcn_Ints(xn *xmlx.Node, name string) (sl []int64) {
 	if cn := xcn(xn, name); cn != nil {
-		sl = list_Ints(cn)
+		sl = me.list_Ints(cn)
 	}
 	return
 }
 
-func listcn_Strings(xn *xmlx.Node, name string) (sl []string) {
+func (me *importState) listcn_Strings(xn *xmlx.Node, name string) (sl []string) {
 	if cn := xcn(xn, name); cn != nil {
-		sl = list_Strings(cn)
+		sl = me.list_Strings(cn)
 	}
 	return
 }
 
-func listcn_Uints(xn *xmlx.Node, name string) (sl []uint64) {
+func (me *importState) listcn_Uints(xn *xmlx.Node, name string) (sl []uint64) {
 	if cn := xcn(xn, name); cn != nil {
-		sl = list_Uints(cn)
+		sl = me.list_Uints(cn)
 	}
 	return
 }
@@ -267,15 +329,18 @@ func node_TechCommon(xn *xmlx.Node) *xmlx.Node {
 	return xcn(xn, "technique_common")
 }
 
-func setIdRef(ref *cdom.RefId, id string) {
+func (me *importState) setIdRef(xn *xmlx.Node, ref *cdom.RefId, id string) {
 	for strings.HasPrefix(id, "#") {
 		id = id[1:]
 	}
-	ref.SetIdRef(id)
+	if len(id) > 0 {
+		me.refs = append(me.refs, importRef{xn: xn, id: id, ref: ref})
+	}
+	ref.SetIdRef(me.xid(xn, id))
 }
 
-func setInstDefRef(xn *xmlx.Node, inst *cdom.BaseInst) {
-	setIdRef(&inst.DefRef, xas1(xn, "url", "body", "constraint", "target"))
+func (me *importState) setInstDefRef(xn *xmlx.Node, inst *cdom.BaseInst) {
+	me.setIdRef(xn, &inst.DefRef, xas1(xn, "url", "body", "constraint", "target"))
 }
 
 func xab(xn *xmlx.Node, name string) bool {
@@ -285,6 +350,7 @@ func xab(xn *xmlx.Node, name string) bool {
 func xabp(xn *xmlx.Node, name string) (b *bool) {
 	for _, att := range xn.Attributes {
 		if att.Name.Local == name {
+			b = new(bool)
 			*b = xab(xn, name)
 			break
 		}
@@ -292,17 +358,19 @@ func xabp(xn *xmlx.Node, name string) (b *bool) {
 	return
 }
 
-func xaf64(xn *xmlx.Node, name string) (v float64) {
-	if v = xn.F64(xmlns, name); v == 0 {
-		if v = xn.F64("", name); v == 0 {
-			v = xn.F64("*", name)
+func (me *importState) xaf64(xn *xmlx.Node, name string) (v float64) {
+	var err error
+	if s := xas(xn, name); len(s) > 0 {
+		if v, err = strconv.ParseFloat(s, 64); err != nil {
+			v = 0
+			me.diagf(ImportDiagWarning, xn, "malformed float attribute %s=\"%s\"", name, s)
 		}
 	}
 	return
 }
 
-func xaf64d(xn *xmlx.Node, name string, def float64) (v float64) {
-	if v = xaf64(xn, name); v == 0 {
+func (me *importState) xaf64d(xn *xmlx.Node, name string, def float64) (v float64) {
+	if v = me.xaf64(xn, name); v == 0 {
 		v = def
 	}
 	return
@@ -333,20 +401,22 @@ func xasd(xn *xmlx.Node, name string, def string) (v string) {
 	return
 }
 
-func xau64(xn *xmlx.Node, name string) (v uint64) {
-	if v = xn.Au64(xmlns, name); v == 0 {
-		if v = xn.Au64("", name); v == 0 {
-			v = xn.Au64("*", name)
+func (me *importState) xau64(xn *xmlx.Node, name string) (v uint64) {
+	var err error
+	if s := xas(xn, name); len(s) > 0 {
+		if v, err = strconv.ParseUint(s, 10, 64); err != nil {
+			v = 0
+			me.diagf(ImportDiagWarning, xn, "malformed unsigned integer attribute %s=\"%s\"", name, s)
 		}
 	}
 	return
 }
 
-func xau64p(xn *xmlx.Node, name string) (p *uint64) {
+func (me *importState) xau64p(xn *xmlx.Node, name string) (p *uint64) {
 	for _, att := range xn.Attributes {
 		if att.Name.Local == name {
 			p = new(uint64)
-			*p = xau64(xn, name)
+			*p = me.xau64(xn, name)
 			break
 		}
 	}
@@ -360,6 +430,7 @@ func xb(xn *xmlx.Node, name string) bool {
 func xbp(xn *xmlx.Node, name string) (v *bool) {
 	for _, cn := range xn.Children {
 		if cn.Name.Local == name {
+			v = new(bool)
 			*v = xb(xn, name)
 			return
 		}
@@ -418,6 +489,7 @@ func xf64(xn *xmlx.Node, name string) (v float64) {
 func xf64p(xn *xmlx.Node, name string) (v *float64) {
 	for _, cn := range xn.Children {
 		if cn.Name.Local == name {
+			v = new(float64)
 			*v = xf64(xn, name)
 			return
 		}
@@ -437,6 +509,7 @@ func xi64(xn *xmlx.Node, name string) (v int64) {
 func xi64p(xn *xmlx.Node, name string) (v *int64) {
 	for _, cn := range xn.Children {
 		if cn.Name.Local == name {
+			v = new(int64)
 			*v = xi64(xn, name)
 			return
 		}
@@ -444,11 +517,16 @@ func xi64p(xn *xmlx.Node, name string) (v *int64) {
 	return
 }
 
-func xm4(xn *xmlx.Node, name string) (mat *unum.Mat4) {
+func (me *importState) xm4(xn *xmlx.Node, name string) (mat *unum.Mat4) {
 	mat = unum.NewMat4Identity()
-	arr_Floats(xn, 16, func(i int, f float64) {
-		mat[i] = f
-	})
+	if len(name) > 0 {
+		xn = xcn(xn, name)
+	}
+	if xn != nil {
+		me.arr_Floats(xn, 16, func(i int, f float64) {
+			mat[i] = f
+		})
+	}
 	return
 }
 
@@ -488,107 +566,108 @@ func xu64(xn *xmlx.Node, name string) (v uint64) {
 
 func xu64p(xn *xmlx.Node, name string) (u *uint64) {
 	if cn := xcn(xn, name); cn != nil {
+		u = new(uint64)
 		*u = xu64(cn, "")
 	}
 	return
 }
 
-func xv(xn *xmlx.Node) (val interface{}) {
+func (me *importState) xv(xn *xmlx.Node) (val interface{}) {
 	if xn != nil {
 		if strings.HasPrefix(xn.Name.Local, "sampler") {
-			val = obj_FxSampler(xn, "")
+			val = me.obj_FxSampler(xn, "")
 		} else {
 			switch xn.Name.Local {
 			case "array":
-				if l := xau64(xn, "length"); (l > 0) && (len(xn.Children) > 0) {
+				if l := me.xau64(xn, "length"); (l > 0) && (len(xn.Children) > 0) && me.arraySizeOk(xn, l) {
 					sl := make([]interface{}, l)
 					for i := 0; i < int(l); i++ {
-						sl[i] = xv(xn.Children[umisc.IfI(i >= len(xn.Children), 0, i)])
+						sl[i] = me.xv(xn.Children[umisc.IfI(i >= len(xn.Children), 0, i)])
 					}
 					val = sl
 				}
 			case "bool":
 				val = xb(xn, "")
 			case "bool2":
-				if v := obj_Bool2(xn, ""); v != nil {
+				if v := me.obj_Bool2(xn, ""); v != nil {
 					val = *v
 				}
 			case "bool3":
-				if v := obj_Bool3(xn, ""); v != nil {
+				if v := me.obj_Bool3(xn, ""); v != nil {
 					val = *v
 				}
 			case "bool4":
-				if v := obj_Bool4(xn, ""); v != nil {
+				if v := me.obj_Bool4(xn, ""); v != nil {
 					val = *v
 				}
 			case "float":
 				val = xf64(xn, "")
 			case "float2", "float2x1":
-				if v := obj_Float2(xn, ""); v != nil {
+				if v := me.obj_Float2(xn, ""); v != nil {
 					val = *v
 				}
 			case "float3", "float3x1":
-				if v := obj_Float3(xn, ""); v != nil {
+				if v := me.obj_Float3(xn, ""); v != nil {
 					val = *v
 				}
 			case "float4", "float4x1":
-				if v := obj_Float4(xn, ""); v != nil {
+				if v := me.obj_Float4(xn, ""); v != nil {
 					val = *v
 				}
 			case "float2x2":
-				if v := obj_Float2x2(xn, ""); v != nil {
+				if v := me.obj_Float2x2(xn, ""); v != nil {
 					val = *v
 				}
 			case "float2x3":
-				if v := obj_Float2x3(xn, ""); v != nil {
+				if v := me.obj_Float2x3(xn, ""); v != nil {
 					val = *v
 				}
 			case "float2x4":
-				if v := obj_Float2x4(xn, ""); v != nil {
+				if v := me.obj_Float2x4(xn, ""); v != nil {
 					val = *v
 				}
 			case "float3x2":
-				if v := obj_Float3x2(xn, ""); v != nil {
+				if v := me.obj_Float3x2(xn, ""); v != nil {
 					val = *v
 				}
 			case "float3x3":
-				if v := obj_Float3x3(xn, ""); v != nil {
+				if v := me.obj_Float3x3(xn, ""); v != nil {
 					val = *v
 				}
 			case "float3x4":
-				if v := obj_Float3x4(xn, ""); v != nil {
+				if v := me.obj_Float3x4(xn, ""); v != nil {
 					val = *v
 				}
 			case "float4x2":
-				if v := obj_Float4x2(xn, ""); v != nil {
+				if v := me.obj_Float4x2(xn, ""); v != nil {
 					val = *v
 				}
 			case "float4x3":
-				if v := obj_Float4x3(xn, ""); v != nil {
+				if v := me.obj_Float4x3(xn, ""); v != nil {
 					val = *v
 				}
 			case "float4x4":
-				if v := obj_Float4x4(xn, ""); v != nil {
+				if v := me.obj_Float4x4(xn, ""); v != nil {
 					val = *v
 				}
 			case "int":
 				val = xi64(xn, "")
 			case "int2":
-				if v := obj_Int2(xn, ""); v != nil {
+				if v := me.obj_Int2(xn, ""); v != nil {
 					val = *v
 				}
 			case "int3":
-				if v := obj_Int3(xn, ""); v != nil {
+				if v := me.obj_Int3(xn, ""); v != nil {
 					val = *v
 				}
 			case "int4":
-				if v := obj_Int4(xn, ""); v != nil {
+				if v := me.obj_Int4(xn, ""); v != nil {
 					val = *v
 				}
 			case "sampler_image":
-				val = obj_FxSamplerImage(xn, "")
+				val = me.obj_FxSamplerImage(xn, "")
 			case "sampler_states":
-				val = obj_FxSamplerStates(xn, "")
+				val = me.obj_FxSamplerStates(xn, "")
 			case "SIDREF", "sidref":
 				val = cdom.NewRefSid(xn.Value)
 			default:
@@ -599,9 +678,14 @@ func xv(xn *xmlx.Node) (val interface{}) {
 	return
 }
 
-func xv3(xn *xmlx.Node, name string) *unum.Vec3 {
+func (me *importState) xv3(xn *xmlx.Node, name string) *unum.Vec3 {
 	var f3 [3]float64
-	arr_Floats(xn, 3, func(i int, f float64) {
+	if len(name) > 0 {
+		if xn = xcn(xn, name); xn == nil {
+			return nil
+		}
+	}
+	me.arr_Floats(xn, 3, func(i int, f float64) {
 		f3[i] = f
 	})
 	return &unum.Vec3{f3[0], f3[1], f3[2]}
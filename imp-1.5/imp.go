@@ -1,41 +1,201 @@
 package collimp
 
 import (
+	"io"
+
 	xmlx "github.com/go-forks/go-pkg-xmlx"
 
 	c141 "github.com/metaleap/go-collada/conv-1.4.1-to-1.5"
 	cdom "github.com/metaleap/go-collada/dom"
 )
 
-var (
-	bag   *ImportBag
-	state *importState
-)
-
 //	Provides options for importing Collada documents.
 type ImportBag struct {
+	//	The options used for bootstrapping Collada 1.4.1 documents to version 1.5 in-memory.
+	//	If nil, c141.NewOptions() with Force and Strict set to false is used.
+	//	In either case, its Log field is ignored in favor of the Log field below.
+	ConvOptions *c141.Options
+
+	//	If true, no <asset> metadata is imported: all Asset fields remain nil.
+	DropAssets bool
+
+	//	If true, no <extra> elements are imported: all Extras fields remain empty.
+	IgnoreExtras bool
+
+	//	If true, no profile-specific <technique> elements are imported: all Techniques fields remain empty.
+	IgnoreTechniques bool
+
+	//	The logging function used for the import, including the conversion of Collada 1.4.1 documents,
+	//	and called with every ImportDiag as it is collected. Set this to nil to disable logging.
+	//	Defaults to c141.Log, which simply does a log.Printf(format, fmtArgs...)
+	Log func(format string, fmtArgs ...interface{})
+
+	//	If greater than 0, any data array with more values than this fails the import with an ImportDiagError,
+	//	before the array gets allocated.
+	MaxArraySize int
+
+	//	Converts all spatial data into a common unit of distance and up-axis during the import,
+	//	honoring the <unit> and <up_axis> of the nearest <asset> of every element, including nested
+	//	<asset> overrides in individual nodes, geometries and other resources.
+	//	Converted are: mesh and spline positions, normals and tangents, all transforms, skin bind matrices,
+	//	camera clipping planes and orthographic magnifications, light attenuation, physics shapes,
+	//	rigid body velocities and inertia, rigid constraint limits, gravity, and kinematics joint axes and limits.
+	//	Animation outputs (and tangents) are converted along with the transforms they target: channels targeting a
+	//	single value of a transform, such as "node/tr.Z", are re-targeted to wherever that value moves. Channels
+	//	targeting any other converted value are reported as ImportDiagWarning, their outputs being left as-is.
+	//	Not converted are: <brep> geometries, and any values supplied via <param> references.
+	//	Afterwards, the Unit and UpAxis of all imported Assets state the conversion target.
+	//	Cameras and lights of nodes whose up-axis changes are moved into a new child node that re-orients them.
+	Normalize struct {
+		//	If greater than 0, the target unit of distance in meters: 1.0 for meter, 0.01 for centimeter etc.
+		UnitMeter float64
+
+		//	The Asset.Unit.Name for UnitMeter, such as "meter" or "centimeter".
+		UnitName string
+
+		//	If "X", "Y" or "Z", the target up-axis.
+		UpAxis string
+	}
+
+	//	The Registry into whose libraries all imported resource definitions are added.
+	//	If nil, this is cdom.DefaultRegistry, so that imported definitions land in the global AllFooDefLibs libraries.
+	//	To keep the definitions of every imported Document apart, set this to a new cdom.NewRegistry() per import.
+	Registry *cdom.Registry
+
+	//	Opens external documents, so that references into them (such as url="parts/wheel.dae#wheel-geom")
+	//	can be followed. Every external document is imported only once per import, into the same Registry,
+	//	and all references into it are then changed to the plain Ids of its resource definitions
+	//	(such as "wheel-geom"). Any Id of an external document that is already used by a previously imported
+	//	document is renamed by appending "-2" (or "-3" etc.), along with all references to it, and reported
+	//	as an ImportDiagWarning.
+	//	The docPath passed is relative to the path of the document being imported (if known) and uses
+	//	forward slashes. See also FSResolver().
+	//	If nil, external references are not followed, unless ImportFile() is used.
+	Resolver func(docPath string) (io.ReadCloser, error)
+
+	//	If true, no <brep> geometries are imported: all GeometryDef.Brep fields remain nil.
+	SkipBreps bool
+
+	//	Library kinds not to be imported at all, keyed by the name of their library element sans
+	//	the "library_" prefix: for example, "physics_models" or "kinematics_scenes".
+	SkipLibs map[string]bool
+
+	//	If true, any ImportDiagWarning fails the import just like an ImportDiagError.
+	WarningsFail bool
 }
 
 //	Initializes and returns a newly created ImportBag instance.
 func NewImportBag() (me *ImportBag) {
-	me = &ImportBag{}
+	me = &ImportBag{ConvOptions: newConvOptions(), Log: c141.Log, SkipLibs: map[string]bool{}}
 	return
 }
 
+//	Adds the specified library kinds to me.SkipLibs: for example,
+//	SkipLibKinds("physics_materials", "physics_models", "physics_scenes", "force_fields").
+func (me *ImportBag) SkipLibKinds(libKinds ...string) {
+	if me.SkipLibs == nil {
+		me.SkipLibs = map[string]bool{}
+	}
+	for _, lk := range libKinds {
+		me.SkipLibs[lk] = true
+	}
+}
+
+func newConvOptions() (opt *c141.Options) {
+	opt = c141.NewOptions()
+	opt.Force, opt.Strict = false, false
+	return
+}
+
+type importRef struct {
+	xn   *xmlx.Node
+	path string
+	line int
+	id   string
+	ref  *cdom.RefId
+}
+
 type importState struct {
-	doc                  *xmlx.Document
-	curAssetUnitInMeters float64
+	bag          *ImportBag
+	doc          *xmlx.Document
+	docPath      string
+	docs         map[string]*importDoc
+	reg          *cdom.Registry
+	report       *ImportReport
+	refs         []importRef
+	resolve      func(string) (io.ReadCloser, error)
+	taken        map[string]bool
+	renamed      map[string]string
+	normalize    bool
+	scopes       map[*xmlx.Node]*normScope
+	normed       map[interface{}]*normScope
+	normChannels []normChannel
+	lists        map[*xmlx.Node]*streamList
+	lines        map[*xmlx.Node]int
+}
+
+func newImportState(importBag *ImportBag) (me *importState) {
+	me = &importState{bag: importBag, reg: importBag.Registry, report: newImportReport(importBag), resolve: importBag.Resolver, docs: map[string]*importDoc{}, scopes: map[*xmlx.Node]*normScope{}}
+	me.normalize = (importBag.Normalize.UnitMeter > 0) || (len(importBag.Normalize.UpAxis) > 0)
+	if me.reg == nil {
+		me.reg = cdom.DefaultRegistry
+	}
+	return
+}
+
+func (me *importState) convOptions() (convOpt c141.Options) {
+	if me.bag.ConvOptions != nil {
+		convOpt = *me.bag.ConvOptions
+	} else {
+		convOpt = *newConvOptions()
+	}
+	convOpt.Log = me.bag.Log
+	return
 }
 
 //	Imports the specified Collada document, using the import options specified in importBag.
+//	Every call carries its own import state, so ImportCollada() may be called concurrently
+//	from any number of goroutines, as long as they do not share the same importBag.Registry
+//	(which they do if it is nil, since that denotes cdom.DefaultRegistry).
+//	If the import failed, err is the *ImportReport describing why.
 func ImportCollada(colladaDoc []byte, importBag *ImportBag) (doc *cdom.Document, err error) {
-	bag = importBag
-	state = &importState{curAssetUnitInMeters: 1}
-	c141.Force, c141.Strict = false, false
-	if state.doc, err = c141.ConvertDoc(colladaDoc); err == nil {
-		xn := state.doc.Root.Children[0]
-		doc = obj_Document(xn, "")
-		libs_All(xn)
+	doc, _, err = ImportColladaReport(colladaDoc, importBag)
+	return
+}
+
+//	Like ImportCollada(), but also returns the diagnostics collected during the import in report.
+//	If report.Failed(), doc is nil and err is report.
+func ImportColladaReport(colladaDoc []byte, importBag *ImportBag) (doc *cdom.Document, report *ImportReport, err error) {
+	if importBag == nil {
+		importBag = NewImportBag()
+	}
+	me := newImportState(importBag)
+	return me.result(me.importBytes(colladaDoc))
+}
+
+func (me *importState) importBytes(colladaDoc []byte) (doc *cdom.Document) {
+	var err error
+	convOpt := me.convOptions()
+	if me.doc, err = convOpt.ConvertDoc(colladaDoc); err != nil {
+		me.diagErr(err)
+	} else if xn := xcn(me.doc.Root, "COLLADA"); xn == nil {
+		me.diagf(ImportDiagError, nil, "no <COLLADA> root element")
+	} else {
+		doc = me.obj_Document(xn, "")
+		doc.Registry = me.reg
+		me.libs_All(xn)
+		me.norm_Animations()
+		me.check_Elements(xn)
+		ids := map[string]bool{}
+		collectIds(me.doc.Root, ids)
+		me.check_Refs(ids)
 	}
 	return
 }
+
+func (me *importState) result(doc *cdom.Document) (*cdom.Document, *ImportReport, error) {
+	if me.report.Failed() {
+		return nil, me.report, me.report
+	}
+	return doc, me.report, nil
+}
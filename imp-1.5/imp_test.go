@@ -0,0 +1,383 @@
+package collimp_test
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	collimp "github.com/metaleap/go-collada/imp-1.5"
+)
+
+const testSkinDoc = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="3">0 0 0</float_array><technique_common><accessor source="#pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+<library_controllers><controller id="c"><skin source="#g">
+<source id="jn"><Name_array id="jn-a" count="1">j0</Name_array><technique_common><accessor source="#jn-a" count="1"><param name="JOINT" type="name"/></accessor></technique_common></source>
+<source id="ib"><float_array id="ib-a" count="16">1 0 0 0 0 1 0 0 0 0 1 0 0 0 0 1</float_array><technique_common><accessor source="#ib-a" count="1" stride="16"><param name="TRANSFORM" type="float4x4"/></accessor></technique_common></source>
+<source id="w"><float_array id="w-a" count="2">0.75 0.25</float_array><technique_common><accessor source="#w-a" count="2"><param name="WEIGHT" type="float"/></accessor></technique_common></source>
+<joints><input semantic="JOINT" source="#jn"/><input semantic="INV_BIND_MATRIX" source="#ib"/></joints>
+<vertex_weights count="1"><input semantic="JOINT" source="#jn" offset="0"/><input semantic="WEIGHT" source="#w" offset="1"/><vcount>2</vcount><v>0 0 -1 1</v></vertex_weights>
+</skin></controller></library_controllers>
+</COLLADA>`
+
+//	Imports src both in-memory and streamed, failing t on any import error or diagnostic.
+func importBoth(t *testing.T, src string) (docs [2]*cdom.Document) {
+	for i := range docs {
+		var (
+			report *collimp.ImportReport
+			err    error
+		)
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry = nil, cdom.NewRegistry()
+		if i == 0 {
+			docs[i], report, err = collimp.ImportColladaReport([]byte(src), bag)
+		} else {
+			docs[i], report, err = collimp.ImportColladaStream(bytes.NewReader([]byte(src)), bag)
+		}
+		if err != nil {
+			t.Fatalf("import %d: %v", i, err)
+		} else if len(report.Diags) > 0 {
+			t.Errorf("import %d: unexpected diagnostics: %v", i, report)
+		}
+	}
+	return
+}
+
+func TestImportVertexWeightsBindShape(t *testing.T) {
+	for i, doc := range importBoth(t, testSkinDoc) {
+		skin := doc.Registry.ControllerDefs.M["c"].Skin
+		if want := []uint64{0, 0, math.MaxUint64, 1}; !reflect.DeepEqual(skin.VertexWeights.Indices, want) {
+			t.Errorf("import %d: got <v> %v, want %v", i, skin.VertexWeights.Indices, want)
+		}
+	}
+}
+
+func TestImportExternalIdCollisions(t *testing.T) {
+	const head = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+`
+	fsys := fstest.MapFS{
+		"main.dae": {Data: []byte(head + `<library_nodes><node id="root"><instance_node url="a.dae#shininess"/><instance_node url="b.dae#shininess"/></node></library_nodes>
+</COLLADA>`)},
+		"a.dae": {Data: []byte(head + `<library_nodes><node id="shininess"/></library_nodes>
+</COLLADA>`)},
+		"b.dae": {Data: []byte(head + `<library_animations><animation id="anim">
+<source id="in"><float_array id="in-a" count="1">0</float_array><technique_common><accessor source="#in-a" count="1"><param name="TIME" type="float"/></accessor></technique_common></source>
+<sampler id="s"><input semantic="INPUT" source="#in"/><input semantic="OUTPUT" source="#in"/></sampler>
+<channel source="#s" target="shininess/t.X"/></animation></library_animations>
+<library_effects><effect id="fx"><profile_COMMON><technique sid="common"><phong/></technique></profile_COMMON></effect></library_effects>
+<library_materials><material id="mat"><instance_effect url="#fx"><setparam ref="shininess"><float>5</float></setparam></instance_effect></material></library_materials>
+<library_nodes><node id="shininess"><translate sid="t">1 2 3</translate></node></library_nodes>
+</COLLADA>`)},
+	}
+	for _, stream := range []bool{false, true} {
+		var (
+			doc    *cdom.Document
+			report *collimp.ImportReport
+			err    error
+		)
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry, bag.Resolver = nil, cdom.NewRegistry(), collimp.FSResolver(fsys)
+		if stream {
+			doc, report, err = collimp.ImportReader(bytes.NewReader(fsys["main.dae"].Data), "main.dae", bag)
+		} else {
+			doc, report, err = collimp.ImportColladaReport(fsys["main.dae"].Data, bag)
+		}
+		if err != nil {
+			t.Fatalf("stream %v: import: %v", stream, err)
+		}
+		if (len(report.Diags) != 1) || !strings.Contains(report.Diags[0].Msg, "renamed to 'shininess-2'") {
+			t.Errorf("stream %v: got diagnostics %v, want one about renaming 'shininess'", stream, report)
+		}
+		reg := doc.Registry
+		if target := reg.AnimationDefs.M["anim"].Channels[0].Target.S; target != "shininess-2/t.X" {
+			t.Errorf("stream %v: got channel target %q, want %q", stream, target, "shininess-2/t.X")
+		}
+		for _, sp := range reg.FxMaterialDefs.M["mat"].Effect.SetParams {
+			if sp.Ref.S != "shininess" {
+				t.Errorf("stream %v: got setparam ref %q, want %q", stream, sp.Ref.S, "shininess")
+			}
+		}
+		if n := len(reg.FxMaterialDefs.M["mat"].Effect.SetParams); n != 1 {
+			t.Errorf("stream %v: got %d setparams, want 1", stream, n)
+		}
+	}
+}
+
+func TestImportStreamText(t *testing.T) {
+	docs := importBoth(t, `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><contributor><author>  Jane <!--middle name--> Doe </author></contributor><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified>
+<title>foo <!--x--> bar</title><subject><?pi?>foo<!--x-->bar</subject></asset>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="6">0 1 <!--x--> 2
+3<!--y--> 4 5</float_array><technique_common><accessor source="#pos-a" count="2" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+</COLLADA>`)
+	for i, doc := range docs {
+		asset := doc.Asset
+		if (asset.Title != "foo  bar") || (asset.Subject != "foobar") || (len(asset.Contributors) != 1) || (asset.Contributors[0].Author != "Jane  Doe") {
+			t.Errorf("import %d: got title %q, subject %q, contributors %#v", i, asset.Title, asset.Subject, asset.Contributors)
+		}
+	}
+	if !reflect.DeepEqual(docs[0].Asset, docs[1].Asset) {
+		t.Errorf("got asset %#v streamed, want %#v", docs[1].Asset, docs[0].Asset)
+	}
+	src0, src1 := docs[0].Registry.GeometryDefs.M["g"].Mesh.Sources["pos"], docs[1].Registry.GeometryDefs.M["g"].Mesh.Sources["pos"]
+	if want := []float64{0, 1, 2, 3, 4, 5}; !reflect.DeepEqual(src0.Array.Floats, want) || !reflect.DeepEqual(src1.Array.Floats, want) {
+		t.Errorf("got floats %v and %v streamed, want %v", src0.Array.Floats, src1.Array.Floats, want)
+	}
+}
+
+func TestImportDiagLines(t *testing.T) {
+	const src = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_nodes><node id="n">
+<instance_geometry url="#missing"/>
+<bogus/>
+</node></library_nodes>
+</COLLADA>`
+	wants := map[string]int{"unresolvable reference 'missing'": 5, "unknown element <bogus>": 6}
+	for _, stream := range []bool{false, true} {
+		var (
+			report *collimp.ImportReport
+			err    error
+		)
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry = nil, cdom.NewRegistry()
+		if stream {
+			_, report, err = collimp.ImportColladaStream(bytes.NewReader([]byte(src)), bag)
+		} else {
+			_, report, err = collimp.ImportColladaReport([]byte(src), bag)
+		}
+		if err != nil {
+			t.Fatalf("stream %v: import: %v", stream, err)
+		}
+		for msg, line := range wants {
+			if !stream {
+				line = 0
+			}
+			found := false
+			for _, diag := range report.Diags {
+				if strings.Contains(diag.Msg, msg) {
+					if found = true; diag.Line != line {
+						t.Errorf("stream %v: got line %d for %q, want %d", stream, diag.Line, diag.Msg, line)
+					}
+				}
+			}
+			if !found {
+				t.Errorf("stream %v: got diagnostics %v, want one about %q", stream, report, msg)
+			}
+		}
+	}
+}
+
+func TestImportConcurrent(t *testing.T) {
+	const n = 16
+	var (
+		wg   sync.WaitGroup
+		docs [n]*cdom.Document
+		errs [n]error
+		logs [n]string
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			version := "1.5.0"
+			if (i % 2) == 1 {
+				version = "1.4.1"
+			}
+			src := fmt.Sprintf(`<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="%s">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_nodes><node id="n"><translate sid="t">%d 0 0</translate><instance_node url="#missing-%d"/></node></library_nodes>
+</COLLADA>`, version, i, i)
+			bag := collimp.NewImportBag()
+			bag.Registry = cdom.NewRegistry()
+			bag.Log = func(format string, fmtArgs ...interface{}) { logs[i] += fmt.Sprintf(format, fmtArgs...) + "\n" }
+			var report *collimp.ImportReport
+			if (i % 4) < 2 {
+				docs[i], report, errs[i] = collimp.ImportColladaReport([]byte(src), bag)
+			} else {
+				docs[i], report, errs[i] = collimp.ImportColladaStream(bytes.NewReader([]byte(src)), bag)
+			}
+			if (errs[i] == nil) && ((len(report.Diags) != 1) || !strings.Contains(report.Diags[0].Msg, fmt.Sprintf("'missing-%d'", i))) {
+				errs[i] = fmt.Errorf("got diagnostics %v, want one about 'missing-%d'", report, i)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for i, doc := range docs {
+		if errs[i] != nil {
+			t.Errorf("import %d: %v", i, errs[i])
+			continue
+		}
+		if !strings.Contains(logs[i], fmt.Sprintf("'missing-%d'", i)) {
+			t.Errorf("import %d: got log %q, want it to mention 'missing-%d'", i, logs[i], i)
+		}
+		node := doc.Registry.NodeDefs.M["n"]
+		if (node == nil) || (len(node.Transforms) != 1) || (node.Transforms[0].F[0] != float64(i)) {
+			t.Errorf("import %d: got node %#v, want one translated by %d", i, node, i)
+		}
+	}
+	if cdom.DefaultRegistry.NodeDefs.M["n"] != nil {
+		t.Errorf("got node 'n' in the DefaultRegistry, want none")
+	}
+}
+
+func TestImportBagOptions(t *testing.T) {
+	const src = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified></asset>
+<library_cameras><camera id="cam"><optics><technique_common><perspective><yfov>45</yfov><znear>1</znear><zfar>10</zfar></perspective></technique_common></optics></camera></library_cameras>
+<library_geometries><geometry id="g"><asset><title>geom</title></asset><mesh>
+<source id="pos"><float_array id="pos-a" count="3">0 0 0</float_array><technique_common><accessor source="#pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh><extra><technique profile="Y"/></extra></geometry></library_geometries>
+<library_lights><light id="l"><technique_common><point><color>1 1 1</color></point></technique_common><technique profile="X"><foo/></technique></light></library_lights>
+</COLLADA>`
+	for _, test := range []struct {
+		name  string
+		opt   func(*collimp.ImportBag)
+		fails bool
+		check func(*cdom.Registry) bool
+	}{
+		{"defaults", func(*collimp.ImportBag) {}, false, func(reg *cdom.Registry) bool {
+			geom, light := reg.GeometryDefs.M["g"], reg.LightDefs.M["l"]
+			return (reg.CameraDefs.M["cam"] != nil) && (geom.Asset != nil) && (geom.Asset.Title == "geom") && (len(geom.Extras) == 1) && (len(light.Techniques) == 1)
+		}},
+		{"SkipLibs", func(bag *collimp.ImportBag) { bag.SkipLibKinds("cameras") }, false, func(reg *cdom.Registry) bool {
+			return (reg.CameraDefs.M["cam"] == nil) && (reg.GeometryDefs.M["g"] != nil)
+		}},
+		{"DropAssets", func(bag *collimp.ImportBag) { bag.DropAssets = true }, false, func(reg *cdom.Registry) bool {
+			return reg.GeometryDefs.M["g"].Asset == nil
+		}},
+		{"IgnoreExtras", func(bag *collimp.ImportBag) { bag.IgnoreExtras = true }, false, func(reg *cdom.Registry) bool {
+			return (len(reg.GeometryDefs.M["g"].Extras) == 0) && (len(reg.LightDefs.M["l"].Techniques) == 1)
+		}},
+		{"IgnoreTechniques", func(bag *collimp.ImportBag) { bag.IgnoreTechniques = true }, false, func(reg *cdom.Registry) bool {
+			return (len(reg.LightDefs.M["l"].Techniques) == 0) && (len(reg.GeometryDefs.M["g"].Extras) == 1)
+		}},
+		{"MaxArraySize", func(bag *collimp.ImportBag) { bag.MaxArraySize = 2 }, true, nil},
+	} {
+		for _, stream := range []bool{false, true} {
+			var (
+				doc    *cdom.Document
+				report *collimp.ImportReport
+				err    error
+			)
+			bag := collimp.NewImportBag()
+			bag.Log, bag.Registry = nil, cdom.NewRegistry()
+			test.opt(bag)
+			if stream {
+				doc, report, err = collimp.ImportColladaStream(bytes.NewReader([]byte(src)), bag)
+			} else {
+				doc, report, err = collimp.ImportColladaReport([]byte(src), bag)
+			}
+			if test.fails {
+				if (err == nil) || !strings.Contains(report.Error(), "maximum array size") {
+					t.Errorf("%s, stream %v: got error %v, want one about the maximum array size", test.name, stream, err)
+				}
+			} else if err != nil {
+				t.Errorf("%s, stream %v: import: %v", test.name, stream, err)
+			} else if !test.check(doc.Registry) {
+				t.Errorf("%s, stream %v: option not honored", test.name, stream)
+			}
+		}
+	}
+}
+
+func TestImportNormalizeRotation(t *testing.T) {
+	const src = `<?xml version="1.0"?>
+<COLLADA xmlns="http://www.collada.org/2008/03/COLLADASchema" version="1.5.0">
+<asset><created>2020-01-01T00:00:00Z</created><modified>2020-01-01T00:00:00Z</modified><unit meter="0.01" name="centimeter"/><up_axis>Z_UP</up_axis></asset>
+<library_animations><animation id="anim">
+<source id="in"><float_array id="in-a" count="2">0 1</float_array><technique_common><accessor source="#in-a" count="2"><param name="TIME" type="float"/></accessor></technique_common></source>
+<source id="out"><float_array id="out-a" count="2">100 200</float_array><technique_common><accessor source="#out-a" count="2"><param name="Z" type="float"/></accessor></technique_common></source>
+<sampler id="s"><input semantic="INPUT" source="#in"/><input semantic="OUTPUT" source="#out"/></sampler>
+<channel source="#s" target="n/t.Z"/></animation></library_animations>
+<library_cameras><camera id="cam"><optics><technique_common><perspective><yfov>45</yfov><znear>10</znear><zfar>1000</zfar></perspective></technique_common></optics></camera></library_cameras>
+<library_geometries><geometry id="g"><mesh>
+<source id="pos"><float_array id="pos-a" count="3">1 2 3</float_array><technique_common><accessor source="#pos-a" count="1" stride="3"><param name="X" type="float"/><param name="Y" type="float"/><param name="Z" type="float"/></accessor></technique_common></source>
+<vertices id="v"><input semantic="POSITION" source="#pos"/></vertices>
+</mesh></geometry></library_geometries>
+<library_nodes><node id="n"><translate sid="t">100 200 300</translate><rotate sid="r">0 0 1 90</rotate><scale sid="s">1 2 3</scale><instance_camera url="#cam"/>
+<node id="x"><asset><up_axis>X_UP</up_axis></asset><translate sid="t">100 0 0</translate></node>
+</node></library_nodes>
+</COLLADA>`
+	near := func(a, b []float64) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if math.Abs(a[i]-b[i]) > 1e-9 {
+				return false
+			}
+		}
+		return true
+	}
+	for _, stream := range []bool{false, true} {
+		var (
+			doc *cdom.Document
+			err error
+		)
+		bag := collimp.NewImportBag()
+		bag.Log, bag.Registry = nil, cdom.NewRegistry()
+		bag.Normalize.UnitMeter, bag.Normalize.UnitName, bag.Normalize.UpAxis = 1, "meter", "Y"
+		if stream {
+			doc, _, err = collimp.ImportColladaStream(bytes.NewReader([]byte(src)), bag)
+		} else {
+			doc, err = collimp.ImportCollada([]byte(src), bag)
+		}
+		if err != nil {
+			t.Fatalf("stream %v: import: %v", stream, err)
+		}
+		reg := doc.Registry
+		if (doc.Asset.UpAxis != "Y") || (doc.Asset.Unit.Meter != 1) {
+			t.Errorf("stream %v: got up-axis %q and unit %v, want Y and 1", stream, doc.Asset.UpAxis, doc.Asset.Unit.Meter)
+		}
+		if pos := reg.GeometryDefs.M["g"].Mesh.Sources["pos"].Array.Floats; !near(pos, []float64{0.01, 0.03, -0.02}) {
+			t.Errorf("stream %v: got position %v, want [0.01 0.03 -0.02]", stream, pos)
+		}
+		node := reg.NodeDefs.M["n"]
+		for i, want := range [][]float64{{1, 3, -2}, {0, 1, 0, 90}, {1, 3, 2}} {
+			if f := node.Transforms[i].F; !near(f, want) {
+				t.Errorf("stream %v: got transform %d %v, want %v", stream, i, f, want)
+			}
+		}
+		if f := reg.NodeDefs.M["x"].Transforms[0].F; !near(f, []float64{0, 1, 0}) {
+			t.Errorf("stream %v: got X-up child translate %v, want [0 1 0]", stream, f)
+		}
+		if optics := reg.CameraDefs.M["cam"].Optics; !near([]float64{optics.TC.Znear.F, optics.TC.Zfar.F}, []float64{0.1, 10}) {
+			t.Errorf("stream %v: got znear %v and zfar %v, want 0.1 and 10", stream, optics.TC.Znear.F, optics.TC.Zfar.F)
+		}
+		if len(node.Insts.Camera) > 0 {
+			t.Errorf("stream %v: got camera instance in the rotated node, want it moved into a child node", stream)
+		} else if len(node.Nodes) != 2 {
+			t.Errorf("stream %v: got %d child nodes, want 2", stream, len(node.Nodes))
+		} else if cn := node.Nodes[1].Def; (cn == nil) || (len(cn.Insts.Camera) != 1) || (len(cn.Transforms) != 1) || !near(cn.Transforms[0].F, []float64{1, 0, 0, 0, 0, 0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1}) {
+			t.Errorf("stream %v: got camera node without a matrix re-orienting it from Z-up", stream)
+		}
+		anim := reg.AnimationDefs.M["anim"]
+		if target := anim.Channels[0].Target.S; target != "n/t.Y" {
+			t.Errorf("stream %v: got channel target %q, want %q", stream, target, "n/t.Y")
+		}
+		if out := anim.Sources["out"].Array.Floats; !near(out, []float64{1, 2}) {
+			t.Errorf("stream %v: got animation outputs %v, want [1 2]", stream, out)
+		}
+	}
+}
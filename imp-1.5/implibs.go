@@ -6,18 +6,21 @@ import (
 	cdom "github.com/metaleap/go-collada/dom"
 )
 
-func libs_animation_clips(xn *xmlx.Node) {
+func (me *importState) libs_animation_clips(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibAnimationClipDefs
 		def *cdom.AnimationClipDef
 		id  string
 	)
+	if me.bag.SkipLibs["animation_clips"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_animation_clips") {
 		id = xas(ln, "id")
-		if lib = cdom.AllAnimationClipDefLibs[id]; lib == nil {
-			lib = cdom.AllAnimationClipDefLibs.AddNew(id)
+		if lib = me.reg.AnimationClipDefLibs[id]; lib == nil {
+			lib = me.reg.AnimationClipDefLibs.AddNew(id)
 		}
-		for _, def = range objs_AnimationClipDef(ln, "animation_clip") {
+		for _, def = range me.objs_AnimationClipDef(ln, "animation_clip") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -26,18 +29,21 @@ func libs_animation_clips(xn *xmlx.Node) {
 	}
 }
 
-func libs_animations(xn *xmlx.Node) {
+func (me *importState) libs_animations(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibAnimationDefs
 		def *cdom.AnimationDef
 		id  string
 	)
+	if me.bag.SkipLibs["animations"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_animations") {
 		id = xas(ln, "id")
-		if lib = cdom.AllAnimationDefLibs[id]; lib == nil {
-			lib = cdom.AllAnimationDefLibs.AddNew(id)
+		if lib = me.reg.AnimationDefLibs[id]; lib == nil {
+			lib = me.reg.AnimationDefLibs.AddNew(id)
 		}
-		for _, def = range objs_AnimationDef(ln, "animation") {
+		for _, def = range me.objs_AnimationDef(ln, "animation") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -46,18 +52,21 @@ func libs_animations(xn *xmlx.Node) {
 	}
 }
 
-func libs_cameras(xn *xmlx.Node) {
+func (me *importState) libs_cameras(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibCameraDefs
 		def *cdom.CameraDef
 		id  string
 	)
+	if me.bag.SkipLibs["cameras"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_cameras") {
 		id = xas(ln, "id")
-		if lib = cdom.AllCameraDefLibs[id]; lib == nil {
-			lib = cdom.AllCameraDefLibs.AddNew(id)
+		if lib = me.reg.CameraDefLibs[id]; lib == nil {
+			lib = me.reg.CameraDefLibs.AddNew(id)
 		}
-		for _, def = range objs_CameraDef(ln, "camera") {
+		for _, def = range me.objs_CameraDef(ln, "camera") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -66,18 +75,21 @@ func libs_cameras(xn *xmlx.Node) {
 	}
 }
 
-func libs_controllers(xn *xmlx.Node) {
+func (me *importState) libs_controllers(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibControllerDefs
 		def *cdom.ControllerDef
 		id  string
 	)
+	if me.bag.SkipLibs["controllers"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_controllers") {
 		id = xas(ln, "id")
-		if lib = cdom.AllControllerDefLibs[id]; lib == nil {
-			lib = cdom.AllControllerDefLibs.AddNew(id)
+		if lib = me.reg.ControllerDefLibs[id]; lib == nil {
+			lib = me.reg.ControllerDefLibs.AddNew(id)
 		}
-		for _, def = range objs_ControllerDef(ln, "controller") {
+		for _, def = range me.objs_ControllerDef(ln, "controller") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -86,18 +98,21 @@ func libs_controllers(xn *xmlx.Node) {
 	}
 }
 
-func libs_formulas(xn *xmlx.Node) {
+func (me *importState) libs_formulas(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibFormulaDefs
 		def *cdom.FormulaDef
 		id  string
 	)
+	if me.bag.SkipLibs["formulas"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_formulas") {
 		id = xas(ln, "id")
-		if lib = cdom.AllFormulaDefLibs[id]; lib == nil {
-			lib = cdom.AllFormulaDefLibs.AddNew(id)
+		if lib = me.reg.FormulaDefLibs[id]; lib == nil {
+			lib = me.reg.FormulaDefLibs.AddNew(id)
 		}
-		for _, def = range objs_FormulaDef(ln, "formula") {
+		for _, def = range me.objs_FormulaDef(ln, "formula") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -106,18 +121,21 @@ func libs_formulas(xn *xmlx.Node) {
 	}
 }
 
-func libs_geometries(xn *xmlx.Node) {
+func (me *importState) libs_geometries(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibGeometryDefs
 		def *cdom.GeometryDef
 		id  string
 	)
+	if me.bag.SkipLibs["geometries"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_geometries") {
 		id = xas(ln, "id")
-		if lib = cdom.AllGeometryDefLibs[id]; lib == nil {
-			lib = cdom.AllGeometryDefLibs.AddNew(id)
+		if lib = me.reg.GeometryDefLibs[id]; lib == nil {
+			lib = me.reg.GeometryDefLibs.AddNew(id)
 		}
-		for _, def = range objs_GeometryDef(ln, "geometry") {
+		for _, def = range me.objs_GeometryDef(ln, "geometry") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -126,18 +144,21 @@ func libs_geometries(xn *xmlx.Node) {
 	}
 }
 
-func libs_lights(xn *xmlx.Node) {
+func (me *importState) libs_lights(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibLightDefs
 		def *cdom.LightDef
 		id  string
 	)
+	if me.bag.SkipLibs["lights"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_lights") {
 		id = xas(ln, "id")
-		if lib = cdom.AllLightDefLibs[id]; lib == nil {
-			lib = cdom.AllLightDefLibs.AddNew(id)
+		if lib = me.reg.LightDefLibs[id]; lib == nil {
+			lib = me.reg.LightDefLibs.AddNew(id)
 		}
-		for _, def = range objs_LightDef(ln, "light") {
+		for _, def = range me.objs_LightDef(ln, "light") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -146,18 +167,21 @@ func libs_lights(xn *xmlx.Node) {
 	}
 }
 
-func libs_nodes(xn *xmlx.Node) {
+func (me *importState) libs_nodes(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibNodeDefs
 		def *cdom.NodeDef
 		id  string
 	)
+	if me.bag.SkipLibs["nodes"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_nodes") {
 		id = xas(ln, "id")
-		if lib = cdom.AllNodeDefLibs[id]; lib == nil {
-			lib = cdom.AllNodeDefLibs.AddNew(id)
+		if lib = me.reg.NodeDefLibs[id]; lib == nil {
+			lib = me.reg.NodeDefLibs.AddNew(id)
 		}
-		for _, def = range objs_NodeDef(ln, "node") {
+		for _, def = range me.objs_NodeDef(ln, "node") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -166,18 +190,21 @@ func libs_nodes(xn *xmlx.Node) {
 	}
 }
 
-func libs_visual_scenes(xn *xmlx.Node) {
+func (me *importState) libs_visual_scenes(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibVisualSceneDefs
 		def *cdom.VisualSceneDef
 		id  string
 	)
+	if me.bag.SkipLibs["visual_scenes"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_visual_scenes") {
 		id = xas(ln, "id")
-		if lib = cdom.AllVisualSceneDefLibs[id]; lib == nil {
-			lib = cdom.AllVisualSceneDefLibs.AddNew(id)
+		if lib = me.reg.VisualSceneDefLibs[id]; lib == nil {
+			lib = me.reg.VisualSceneDefLibs.AddNew(id)
 		}
-		for _, def = range objs_VisualSceneDef(ln, "visual_scene") {
+		for _, def = range me.objs_VisualSceneDef(ln, "visual_scene") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -186,18 +213,21 @@ func libs_visual_scenes(xn *xmlx.Node) {
 	}
 }
 
-func libs_force_fields(xn *xmlx.Node) {
+func (me *importState) libs_force_fields(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibPxForceFieldDefs
 		def *cdom.PxForceFieldDef
 		id  string
 	)
+	if me.bag.SkipLibs["force_fields"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_force_fields") {
 		id = xas(ln, "id")
-		if lib = cdom.AllPxForceFieldDefLibs[id]; lib == nil {
-			lib = cdom.AllPxForceFieldDefLibs.AddNew(id)
+		if lib = me.reg.PxForceFieldDefLibs[id]; lib == nil {
+			lib = me.reg.PxForceFieldDefLibs.AddNew(id)
 		}
-		for _, def = range objs_PxForceFieldDef(ln, "force_field") {
+		for _, def = range me.objs_PxForceFieldDef(ln, "force_field") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -206,18 +236,21 @@ func libs_force_fields(xn *xmlx.Node) {
 	}
 }
 
-func libs_physics_materials(xn *xmlx.Node) {
+func (me *importState) libs_physics_materials(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibPxMaterialDefs
 		def *cdom.PxMaterialDef
 		id  string
 	)
+	if me.bag.SkipLibs["physics_materials"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_physics_materials") {
 		id = xas(ln, "id")
-		if lib = cdom.AllPxMaterialDefLibs[id]; lib == nil {
-			lib = cdom.AllPxMaterialDefLibs.AddNew(id)
+		if lib = me.reg.PxMaterialDefLibs[id]; lib == nil {
+			lib = me.reg.PxMaterialDefLibs.AddNew(id)
 		}
-		for _, def = range objs_PxMaterialDef(ln, "physics_material") {
+		for _, def = range me.objs_PxMaterialDef(ln, "physics_material") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -226,18 +259,21 @@ func libs_physics_materials(xn *xmlx.Node) {
 	}
 }
 
-func libs_physics_models(xn *xmlx.Node) {
+func (me *importState) libs_physics_models(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibPxModelDefs
 		def *cdom.PxModelDef
 		id  string
 	)
+	if me.bag.SkipLibs["physics_models"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_physics_models") {
 		id = xas(ln, "id")
-		if lib = cdom.AllPxModelDefLibs[id]; lib == nil {
-			lib = cdom.AllPxModelDefLibs.AddNew(id)
+		if lib = me.reg.PxModelDefLibs[id]; lib == nil {
+			lib = me.reg.PxModelDefLibs.AddNew(id)
 		}
-		for _, def = range objs_PxModelDef(ln, "physics_model") {
+		for _, def = range me.objs_PxModelDef(ln, "physics_model") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -246,18 +282,21 @@ func libs_physics_models(xn *xmlx.Node) {
 	}
 }
 
-func libs_physics_scenes(xn *xmlx.Node) {
+func (me *importState) libs_physics_scenes(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibPxSceneDefs
 		def *cdom.PxSceneDef
 		id  string
 	)
+	if me.bag.SkipLibs["physics_scenes"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_physics_scenes") {
 		id = xas(ln, "id")
-		if lib = cdom.AllPxSceneDefLibs[id]; lib == nil {
-			lib = cdom.AllPxSceneDefLibs.AddNew(id)
+		if lib = me.reg.PxSceneDefLibs[id]; lib == nil {
+			lib = me.reg.PxSceneDefLibs.AddNew(id)
 		}
-		for _, def = range objs_PxSceneDef(ln, "physics_scene") {
+		for _, def = range me.objs_PxSceneDef(ln, "physics_scene") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -266,18 +305,21 @@ func libs_physics_scenes(xn *xmlx.Node) {
 	}
 }
 
-func libs_effects(xn *xmlx.Node) {
+func (me *importState) libs_effects(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibFxEffectDefs
 		def *cdom.FxEffectDef
 		id  string
 	)
+	if me.bag.SkipLibs["effects"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_effects") {
 		id = xas(ln, "id")
-		if lib = cdom.AllFxEffectDefLibs[id]; lib == nil {
-			lib = cdom.AllFxEffectDefLibs.AddNew(id)
+		if lib = me.reg.FxEffectDefLibs[id]; lib == nil {
+			lib = me.reg.FxEffectDefLibs.AddNew(id)
 		}
-		for _, def = range objs_FxEffectDef(ln, "effect") {
+		for _, def = range me.objs_FxEffectDef(ln, "effect") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -286,18 +328,21 @@ func libs_effects(xn *xmlx.Node) {
 	}
 }
 
-func libs_images(xn *xmlx.Node) {
+func (me *importState) libs_images(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibFxImageDefs
 		def *cdom.FxImageDef
 		id  string
 	)
+	if me.bag.SkipLibs["images"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_images") {
 		id = xas(ln, "id")
-		if lib = cdom.AllFxImageDefLibs[id]; lib == nil {
-			lib = cdom.AllFxImageDefLibs.AddNew(id)
+		if lib = me.reg.FxImageDefLibs[id]; lib == nil {
+			lib = me.reg.FxImageDefLibs.AddNew(id)
 		}
-		for _, def = range objs_FxImageDef(ln, "image") {
+		for _, def = range me.objs_FxImageDef(ln, "image") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -306,18 +351,21 @@ func libs_images(xn *xmlx.Node) {
 	}
 }
 
-func libs_materials(xn *xmlx.Node) {
+func (me *importState) libs_materials(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibFxMaterialDefs
 		def *cdom.FxMaterialDef
 		id  string
 	)
+	if me.bag.SkipLibs["materials"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_materials") {
 		id = xas(ln, "id")
-		if lib = cdom.AllFxMaterialDefLibs[id]; lib == nil {
-			lib = cdom.AllFxMaterialDefLibs.AddNew(id)
+		if lib = me.reg.FxMaterialDefLibs[id]; lib == nil {
+			lib = me.reg.FxMaterialDefLibs.AddNew(id)
 		}
-		for _, def = range objs_FxMaterialDef(ln, "material") {
+		for _, def = range me.objs_FxMaterialDef(ln, "material") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -326,18 +374,21 @@ func libs_materials(xn *xmlx.Node) {
 	}
 }
 
-func libs_articulated_systems(xn *xmlx.Node) {
+func (me *importState) libs_articulated_systems(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibKxArticulatedSystemDefs
 		def *cdom.KxArticulatedSystemDef
 		id  string
 	)
+	if me.bag.SkipLibs["articulated_systems"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_articulated_systems") {
 		id = xas(ln, "id")
-		if lib = cdom.AllKxArticulatedSystemDefLibs[id]; lib == nil {
-			lib = cdom.AllKxArticulatedSystemDefLibs.AddNew(id)
+		if lib = me.reg.KxArticulatedSystemDefLibs[id]; lib == nil {
+			lib = me.reg.KxArticulatedSystemDefLibs.AddNew(id)
 		}
-		for _, def = range objs_KxArticulatedSystemDef(ln, "articulated_system") {
+		for _, def = range me.objs_KxArticulatedSystemDef(ln, "articulated_system") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -346,18 +397,21 @@ func libs_articulated_systems(xn *xmlx.Node) {
 	}
 }
 
-func libs_joints(xn *xmlx.Node) {
+func (me *importState) libs_joints(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibKxJointDefs
 		def *cdom.KxJointDef
 		id  string
 	)
+	if me.bag.SkipLibs["joints"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_joints") {
 		id = xas(ln, "id")
-		if lib = cdom.AllKxJointDefLibs[id]; lib == nil {
-			lib = cdom.AllKxJointDefLibs.AddNew(id)
+		if lib = me.reg.KxJointDefLibs[id]; lib == nil {
+			lib = me.reg.KxJointDefLibs.AddNew(id)
 		}
-		for _, def = range objs_KxJointDef(ln, "joint") {
+		for _, def = range me.objs_KxJointDef(ln, "joint") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -366,18 +420,21 @@ func libs_joints(xn *xmlx.Node) {
 	}
 }
 
-func libs_kinematics_models(xn *xmlx.Node) {
+func (me *importState) libs_kinematics_models(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibKxModelDefs
 		def *cdom.KxModelDef
 		id  string
 	)
+	if me.bag.SkipLibs["kinematics_models"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_kinematics_models") {
 		id = xas(ln, "id")
-		if lib = cdom.AllKxModelDefLibs[id]; lib == nil {
-			lib = cdom.AllKxModelDefLibs.AddNew(id)
+		if lib = me.reg.KxModelDefLibs[id]; lib == nil {
+			lib = me.reg.KxModelDefLibs.AddNew(id)
 		}
-		for _, def = range objs_KxModelDef(ln, "kinematics_model") {
+		for _, def = range me.objs_KxModelDef(ln, "kinematics_model") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -386,18 +443,21 @@ func libs_kinematics_models(xn *xmlx.Node) {
 	}
 }
 
-func libs_kinematics_scenes(xn *xmlx.Node) {
+func (me *importState) libs_kinematics_scenes(xn *xmlx.Node) {
 	var (
 		lib *cdom.LibKxSceneDefs
 		def *cdom.KxSceneDef
 		id  string
 	)
+	if me.bag.SkipLibs["kinematics_scenes"] {
+		return
+	}
 	for _, ln := range xcns(xn, "library_kinematics_scenes") {
 		id = xas(ln, "id")
-		if lib = cdom.AllKxSceneDefLibs[id]; lib == nil {
-			lib = cdom.AllKxSceneDefLibs.AddNew(id)
+		if lib = me.reg.KxSceneDefLibs[id]; lib == nil {
+			lib = me.reg.KxSceneDefLibs.AddNew(id)
 		}
-		for _, def = range objs_KxSceneDef(ln, "kinematics_scene") {
+		for _, def = range me.objs_KxSceneDef(ln, "kinematics_scene") {
 			if def != nil {
 				lib.Add(def)
 			}
@@ -406,25 +466,48 @@ func libs_kinematics_scenes(xn *xmlx.Node) {
 	}
 }
 
-func libs_All(xn *xmlx.Node) {
-	libs_animation_clips(xn)
-	libs_animations(xn)
-	libs_cameras(xn)
-	libs_controllers(xn)
-	libs_formulas(xn)
-	libs_geometries(xn)
-	libs_lights(xn)
-	libs_nodes(xn)
-	libs_visual_scenes(xn)
-	libs_force_fields(xn)
-	libs_physics_materials(xn)
-	libs_physics_models(xn)
-	libs_physics_scenes(xn)
-	libs_effects(xn)
-	libs_images(xn)
-	libs_materials(xn)
-	libs_articulated_systems(xn)
-	libs_joints(xn)
-	libs_kinematics_models(xn)
-	libs_kinematics_scenes(xn)
+func (me *importState) libs_All(xn *xmlx.Node) {
+	me.libs_animation_clips(xn)
+	me.libs_animations(xn)
+	me.libs_cameras(xn)
+	me.libs_controllers(xn)
+	me.libs_formulas(xn)
+	me.libs_geometries(xn)
+	me.libs_lights(xn)
+	me.libs_nodes(xn)
+	me.libs_visual_scenes(xn)
+	me.libs_force_fields(xn)
+	me.libs_physics_materials(xn)
+	me.libs_physics_models(xn)
+	me.libs_physics_scenes(xn)
+	me.libs_effects(xn)
+	me.libs_images(xn)
+	me.libs_materials(xn)
+	me.libs_articulated_systems(xn)
+	me.libs_joints(xn)
+	me.libs_kinematics_models(xn)
+	me.libs_kinematics_scenes(xn)
+}
+
+var libsByKind = map[string]func(*importState, *xmlx.Node){
+	"animation_clips":     (*importState).libs_animation_clips,
+	"animations":          (*importState).libs_animations,
+	"cameras":             (*importState).libs_cameras,
+	"controllers":         (*importState).libs_controllers,
+	"formulas":            (*importState).libs_formulas,
+	"geometries":          (*importState).libs_geometries,
+	"lights":              (*importState).libs_lights,
+	"nodes":               (*importState).libs_nodes,
+	"visual_scenes":       (*importState).libs_visual_scenes,
+	"force_fields":        (*importState).libs_force_fields,
+	"physics_materials":   (*importState).libs_physics_materials,
+	"physics_models":      (*importState).libs_physics_models,
+	"physics_scenes":      (*importState).libs_physics_scenes,
+	"effects":             (*importState).libs_effects,
+	"images":              (*importState).libs_images,
+	"materials":           (*importState).libs_materials,
+	"articulated_systems": (*importState).libs_articulated_systems,
+	"joints":              (*importState).libs_joints,
+	"kinematics_models":   (*importState).libs_kinematics_models,
+	"kinematics_scenes":   (*importState).libs_kinematics_scenes,
 }
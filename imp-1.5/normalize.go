@@ -0,0 +1,596 @@
+package collimp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	"github.com/metaleap/go-util/num"
+)
+
+var (
+	//	For each up-axis (X, Y, Z), the rotation into the Y-up coordinate system,
+	//	as per the <up_axis> table in the Collada 1.5 specification.
+	normRotToY = [3][3][3]float64{
+		{{0, -1, 0}, {1, 0, 0}, {0, 0, 1}},
+		{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+		{{1, 0, 0}, {0, 0, 1}, {0, -1, 0}},
+	}
+)
+
+//	The unit and up-axis in effect for an element, as declared by the nearest <asset>
+//	up its ancestry, together with the conversion into ImportBag.Normalize.
+type normScope struct {
+	unitMeter float64
+	upAxis    int
+	rot       [3][3]float64
+	rotates   bool
+	scale     float64
+}
+
+//	An animation channel of the document being imported, kept for norm_Animations().
+type normChannel struct {
+	anim *cdom.AnimationDef
+	ch   *cdom.AnimationChannel
+	path string
+	line int
+}
+
+//	For each value of a transform converted by normScope.transform(): the index that it moves to,
+//	and the factor that it gets multiplied with.
+type normMap struct {
+	to     []int
+	factor []float64
+}
+
+func (me normMap) apply(f []float64) {
+	old := append([]float64(nil), f...)
+	for i, to := range me.to {
+		f[to] = old[i] * me.factor[i]
+	}
+}
+
+//	Like apply(), but for the (time, value) pairs of 2D tangents: only the values are converted.
+func (me normMap) apply2D(f []float64) {
+	vals := make([]float64, len(me.to))
+	for i := range vals {
+		vals[i] = f[2*i+1]
+	}
+	me.apply(vals)
+	for i, v := range vals {
+		f[2*i+1] = v
+	}
+}
+
+func normUpAxis(s string) int {
+	if len(s) > 0 {
+		switch strings.ToUpper(s[:1]) {
+		case "X":
+			return 0
+		case "Z":
+			return 2
+		}
+	}
+	return 1
+}
+
+func (me *normScope) dir(f []float64) {
+	me.vec(f, 1)
+}
+
+func (me *normScope) length(f *float64) {
+	*f *= me.scale
+}
+
+func (me *normScope) lengths(v *unum.Vec3) {
+	v.X, v.Y, v.Z = v.X*me.scale, v.Y*me.scale, v.Z*me.scale
+}
+
+//	Swaps and negates the per-axis limits in min and max as rotated, then scales them.
+func (me *normScope) limits(min, max *unum.Vec3, scale float64) {
+	lo, hi := []float64{min.X, min.Y, min.Z}, []float64{max.X, max.Y, max.Z}
+	var nlo, nhi [3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if r := me.rot[i][j]; r > 0 {
+				nlo[i], nhi[i] = lo[j]*scale, hi[j]*scale
+			} else if r < 0 {
+				nlo[i], nhi[i] = -hi[j]*scale, -lo[j]*scale
+			}
+		}
+	}
+	min.X, min.Y, min.Z = nlo[0], nlo[1], nlo[2]
+	max.X, max.Y, max.Z = nhi[0], nhi[1], nhi[2]
+}
+
+//	Converts the row-major 4x4 matrix m by conjugation: C * m * inverse(C), where C = scale * rot.
+func (me *normScope) mat4(m []float64) {
+	var a [3][3]float64
+	if len(m) < 16 {
+		return
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				for l := 0; l < 3; l++ {
+					a[i][j] += me.rot[i][k] * m[k*4+l] * me.rot[j][l]
+				}
+			}
+		}
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i*4+j] = a[i][j]
+		}
+	}
+	t, p := []float64{m[3], m[7], m[11]}, []float64{m[12], m[13], m[14]}
+	me.point(t)
+	me.vec(p, 1/me.scale)
+	m[3], m[7], m[11], m[12], m[13], m[14] = t[0], t[1], t[2], p[0], p[1], p[2]
+}
+
+//	Returns the row-major 4x4 matrix of me.rot.
+func (me *normScope) matRot() (m []float64) {
+	m = make([]float64, 16)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i*4+j] = me.rot[i][j]
+		}
+	}
+	m[15] = 1
+	return
+}
+
+//	Moves the per-axis magnitudes in f to their rotated axes, then multiplies them with scale.
+func (me *normScope) permute(f []float64, scale float64) {
+	if len(f) >= 3 {
+		var n [3]float64
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if me.rot[i][j] != 0 {
+					n[i] = f[j] * scale
+				}
+			}
+		}
+		copy(f, n[:])
+	}
+}
+
+func (me *normScope) point(f []float64) {
+	me.vec(f, me.scale)
+}
+
+//	Returns where transform() moves each of the n values of a transform of the specified kind, and by which
+//	factor. Since me.rot is a signed permutation, every value ends up in exactly one place (else, ok is false).
+func (me *normScope) transformMap(kind cdom.TransformKind, n int) (m normMap, ok bool) {
+	m.to, m.factor = make([]int, n), make([]float64, n)
+	f := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := range f {
+			f[j] = 0
+		}
+		f[i], m.to[i] = 1, -1
+		me.transform(kind, f)
+		for j, v := range f {
+			if v != 0 {
+				if m.to[i] >= 0 {
+					return
+				}
+				m.to[i], m.factor[i] = j, v
+			}
+		}
+		if m.to[i] < 0 {
+			return
+		}
+	}
+	ok = true
+	return
+}
+
+func (me *normScope) vec(f []float64, scale float64) {
+	if len(f) >= 3 {
+		var n [3]float64
+		for i := 0; i < 3; i++ {
+			n[i] = scale * (me.rot[i][0]*f[0] + me.rot[i][1]*f[1] + me.rot[i][2]*f[2])
+		}
+		copy(f, n[:])
+	}
+}
+
+func (me *normScope) vec3(v *unum.Vec3, scale float64) {
+	f := []float64{v.X, v.Y, v.Z}
+	me.vec(f, scale)
+	v.X, v.Y, v.Z = f[0], f[1], f[2]
+}
+
+//	Returns the conversion in effect for xn, or nil if ImportBag.Normalize does not apply to xn.
+func (me *importState) normScope(xn *xmlx.Node) (sc *normScope) {
+	if me.normalize {
+		if sc = me.scopeOf(xn); (sc.scale == 1) && !sc.rotates {
+			sc = nil
+		}
+	}
+	return
+}
+
+func (me *importState) scopeOf(xn *xmlx.Node) (sc *normScope) {
+	if sc = me.scopes[xn]; sc == nil {
+		if (xn == nil) || (xn.Type != xmlx.NT_ELEMENT) {
+			sc = &normScope{unitMeter: 1, upAxis: 1}
+		} else {
+			sc = me.scopeOf(xn.Parent)
+			for _, an := range xn.Children {
+				if (an.Type == xmlx.NT_ELEMENT) && (an.Name.Local == "asset") {
+					psc := sc
+					sc = &normScope{unitMeter: psc.unitMeter, upAxis: psc.upAxis}
+					if un := xcn(an, "unit"); un != nil {
+						if f, err := strconv.ParseFloat(xas(un, "meter"), 64); (err == nil) && (f > 0) {
+							sc.unitMeter = f
+						}
+					}
+					if ua := xs(an, "up_axis"); len(ua) > 0 {
+						sc.upAxis = normUpAxis(ua)
+					}
+					break
+				}
+			}
+		}
+		if sc.scale == 0 {
+			me.setScopeConversion(sc)
+		}
+		me.scopes[xn] = sc
+	}
+	return
+}
+
+//	Records that vals were converted as per sc, for norm_Animations().
+func (me *importState) setNormed(sc *normScope, vals ...interface{}) {
+	if me.normed == nil {
+		me.normed = map[interface{}]*normScope{}
+	}
+	for _, val := range vals {
+		me.normed[val] = sc
+	}
+}
+
+func (me *importState) setScopeConversion(sc *normScope) {
+	sc.scale = 1
+	if me.bag.Normalize.UnitMeter > 0 {
+		sc.scale = sc.unitMeter / me.bag.Normalize.UnitMeter
+	}
+	src, dst := sc.upAxis, sc.upAxis
+	if len(me.bag.Normalize.UpAxis) > 0 {
+		dst = normUpAxis(me.bag.Normalize.UpAxis)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				sc.rot[i][j] += normRotToY[dst][k][i] * normRotToY[src][k][j]
+			}
+		}
+	}
+	sc.rotates = (src != dst)
+}
+
+func (me *importState) norm_AnimationDef(xn *xmlx.Node, obj *cdom.AnimationDef) {
+	if me.normalize {
+		for i, cn := range xcns(xn, "channel") {
+			me.normChannels = append(me.normChannels, normChannel{anim: obj, ch: obj.Channels[i], path: xpath(cn), line: me.lines[cn]})
+		}
+	}
+}
+
+//	Converts the OUTPUT and tangent Sources of all animation channels that target a transform converted
+//	by norm_Transform(), and re-targets those channels that select a single value of such a transform to
+//	wherever that value has moved. Channels targeting any other converted value are reported instead.
+//	Runs once all resource definitions are loaded, since channels may target nodes defined after them.
+func (me *importState) norm_Animations() {
+	done := map[*cdom.Source]string{}
+	for _, nc := range me.normChannels {
+		if path, err := nc.ch.Target.Path(); err == nil {
+			val := (&cdom.SidPath{Id: path.Id, Sids: path.Sids}).Resolve(me.reg)
+			if sc := me.normed[val]; sc != nil {
+				if xf, _ := val.(*cdom.Transform); (xf == nil) || !me.norm_AnimationChannel(nc, path, xf, sc, done) {
+					me.diagRef(ImportDiagWarning, importRef{path: nc.path, line: nc.line, id: nc.ch.Target.S}, "animation channel target '%s' was converted by Normalize, but its animation outputs could not be")
+				}
+			}
+		}
+	}
+	me.normChannels = nil
+}
+
+//	Converts the outputs of nc, which targets xf (or one of its values), and returns whether it could.
+//	Every Source is converted at most once: done records how, so that conflicting conversions are refused.
+func (me *importState) norm_AnimationChannel(nc normChannel, path *cdom.SidPath, xf *cdom.Transform, sc *normScope, done map[*cdom.Source]string) bool {
+	var (
+		sampler      *cdom.AnimationSampler
+		srcs, srcs2D []*cdom.Source
+	)
+	m, ok := sc.transformMap(xf.Kind, len(xf.F))
+	if !ok {
+		return false
+	}
+	target := nc.ch.Target.S
+	if len(path.Selectors) > 0 {
+		i, ptr := 0, path.Resolve(me.reg)
+		for ; (i < len(xf.F)) && (ptr != &xf.F[i]); i++ {
+		}
+		if (len(path.Selectors) > 1) || (i == len(xf.F)) {
+			return false
+		}
+		target = normSidTarget(target, path.Selectors[0], xf, m.to[i])
+		m = normMap{to: []int{0}, factor: []float64{m.factor[i]}}
+	}
+	for _, s := range nc.anim.Samplers {
+		if s.Id == nc.ch.Source.S() {
+			sampler = s
+		}
+	}
+	if sampler == nil {
+		return false
+	}
+	n, key := uint64(len(m.to)), fmt.Sprint(m)
+	for _, in := range sampler.Inputs {
+		if (in.Semantic == "OUTPUT") || (in.Semantic == "IN_TANGENT") || (in.Semantic == "OUT_TANGENT") {
+			src := nc.anim.Sources[in.Source.S()]
+			if (src == nil) || (src.TC.Accessor == nil) || (src.TC.Accessor.Stride < n) || ((len(done[src]) > 0) && (done[src] != key)) {
+				return false
+			}
+			if (in.Semantic != "OUTPUT") && (src.TC.Accessor.Stride >= 2*n) {
+				srcs2D = append(srcs2D, src)
+			} else {
+				srcs = append(srcs, src)
+			}
+		}
+	}
+	for _, src := range srcs {
+		if len(done[src]) == 0 {
+			done[src] = key
+			normSourceFloats(src, n, m.apply)
+		}
+	}
+	for _, src := range srcs2D {
+		if len(done[src]) == 0 {
+			done[src] = key
+			normSourceFloats(src, 2*n, m.apply2D)
+		}
+	}
+	if target != nc.ch.Target.S {
+		nc.ch.Target.SetSidRef(target)
+	}
+	return true
+}
+
+func (me *importState) norm_Asset(obj *cdom.Asset) {
+	if me.normalize {
+		if me.bag.Normalize.UnitMeter > 0 {
+			obj.Unit.Meter, obj.Unit.Name = me.bag.Normalize.UnitMeter, me.bag.Normalize.UnitName
+		}
+		if len(me.bag.Normalize.UpAxis) > 0 {
+			obj.UpAxis = [3]string{"X", "Y", "Z"}[normUpAxis(me.bag.Normalize.UpAxis)]
+		}
+	}
+}
+
+func (me *importState) norm_CameraOptics(xn *xmlx.Node, obj *cdom.CameraOptics) {
+	if sc := me.normScope(xn); sc != nil {
+		sc.length(&obj.TC.Znear.F)
+		sc.length(&obj.TC.Zfar.F)
+		me.setNormed(sc, &obj.TC.Znear, &obj.TC.Zfar)
+		if o := obj.TC.Orthographic; o != nil {
+			for _, sf := range []*cdom.SidFloat{o.MagX, o.MagY} {
+				if sf != nil {
+					sc.length(&sf.F)
+					me.setNormed(sc, sf)
+				}
+			}
+		}
+	}
+}
+
+func (me *importState) norm_ControllerSkin(xn *xmlx.Node, obj *cdom.ControllerSkin) {
+	if sc := me.normScope(xn); sc != nil {
+		sc.mat4(obj.BindShapeMatrix[:])
+		for _, in := range obj.Joints.Inputs {
+			if src := obj.Sources[in.Source.S()]; (in.Semantic == "INV_BIND_MATRIX") && (src != nil) && (src.TC.Accessor != nil) {
+				normSourceFloats(src, 16, sc.mat4)
+			}
+		}
+	}
+}
+
+func (me *importState) norm_KxJoint(xn *xmlx.Node, obj *cdom.KxJoint) {
+	if sc := me.normScope(xn); sc != nil {
+		sc.vec3(&obj.Axis.Vec3, 1)
+		me.setNormed(sc, &obj.Axis)
+		if (obj.Kind == cdom.KxJointKindPrismatic) && (obj.Limits != nil) {
+			for _, sf := range []*cdom.SidFloat{obj.Limits.Min, obj.Limits.Max} {
+				if sf != nil {
+					sc.length(&sf.F)
+					me.setNormed(sc, sf)
+				}
+			}
+		}
+	}
+}
+
+func (me *importState) norm_LightAttenuation(xn *xmlx.Node, obj *cdom.LightAttenuation) {
+	if sc := me.normScope(xn); sc != nil {
+		obj.Linear.F /= sc.scale
+		obj.Quadratic.F /= sc.scale * sc.scale
+		me.setNormed(sc, &obj.Linear, &obj.Quadratic)
+	}
+}
+
+//	Converts the vertex data of a mesh or spline: positions are scaled and rotated,
+//	normals and tangents are rotated only. Every Source is converted at most once.
+func (me *importState) norm_MeshSources(xn *xmlx.Node, sources cdom.Sources, inputs []*cdom.Input) {
+	if sc := me.normScope(xn); sc != nil {
+		done := map[*cdom.Source]bool{}
+		for _, in := range inputs {
+			if src := sources[in.Source.S()]; (src != nil) && (src.TC.Accessor != nil) && !done[src] {
+				switch in.Semantic {
+				case "POSITION":
+					normSourceFloats(src, 3, sc.point)
+				case "NORMAL", "TANGENT", "BINORMAL", "TEXTANGENT", "TEXBINORMAL":
+					normSourceFloats(src, 3, sc.dir)
+				default:
+					continue
+				}
+				done[src] = true
+			}
+		}
+	}
+}
+
+func (me *importState) norm_NodeDef(xn *xmlx.Node, obj *cdom.NodeDef) {
+	if sc := me.normScope(xn); (sc != nil) && sc.rotates && ((len(obj.Insts.Camera) > 0) || (len(obj.Insts.Light) > 0)) {
+		//	cameras and lights look down their local -Z axis regardless of the up-axis,
+		//	so they get re-oriented in a child node that undoes the conjugated node transforms.
+		nd := new(cdom.NodeDef)
+		nd.Init()
+		nd.Transforms = []*cdom.Transform{&cdom.Transform{Kind: cdom.TransformKindMatrix, F: sc.matRot()}}
+		nd.Insts.Camera, nd.Insts.Light = obj.Insts.Camera, obj.Insts.Light
+		obj.Insts.Camera, obj.Insts.Light = nil, nil
+		obj.Nodes = append(obj.Nodes, cdom.ChildNode{Def: nd})
+	}
+}
+
+func (me *importState) norm_PxRigidBodyCommon(xn *xmlx.Node, obj *cdom.PxRigidBodyCommon) {
+	if sc := me.normScope(xn); (sc != nil) && (obj.Inertia != nil) {
+		sc.permute(obj.Inertia.F[:], sc.scale*sc.scale)
+		me.setNormed(sc, obj.Inertia)
+	}
+}
+
+func (me *importState) norm_PxRigidBodyInst(xn *xmlx.Node, obj *cdom.PxRigidBodyInst) {
+	if sc := me.normScope(xn); sc != nil {
+		sc.vec3(&obj.TC.AngularVelocity, 1)
+		sc.vec3(&obj.TC.LinearVelocity, sc.scale)
+	}
+}
+
+func (me *importState) norm_PxRigidConstraintDef(xn *xmlx.Node, obj *cdom.PxRigidConstraintDef) {
+	if sc := me.normScope(xn); sc != nil {
+		if l := obj.TC.Limits.Angular; l != nil {
+			sc.limits(&l.Min.Vec3, &l.Max.Vec3, 1)
+			me.setNormed(sc, &l.Min, &l.Max)
+		}
+		if l := obj.TC.Limits.Linear; l != nil {
+			sc.limits(&l.Min.Vec3, &l.Max.Vec3, sc.scale)
+			me.setNormed(sc, &l.Min, &l.Max)
+		}
+	}
+}
+
+func (me *importState) norm_PxSceneDef(xn *xmlx.Node, obj *cdom.PxSceneDef) {
+	if sc := me.normScope(xn); (sc != nil) && (obj.TC.Gravity != nil) {
+		sc.vec3(&obj.TC.Gravity.Vec3, sc.scale)
+		me.setNormed(sc, obj.TC.Gravity)
+	}
+}
+
+func (me *importState) norm_PxShape(xn *xmlx.Node, obj *cdom.PxShape) {
+	if sc := me.normScope(xn); sc != nil {
+		g := &obj.Geometry
+		if g.Plane != nil {
+			sc.dir(g.Plane.Equation[:3])
+			sc.length(&g.Plane.Equation[3])
+		}
+		if g.Box != nil {
+			sc.lengths(&g.Box.HalfExtents)
+		}
+		if g.Sphere != nil {
+			sc.length(&g.Sphere.Radius)
+		}
+		if g.Cylinder != nil {
+			sc.length(&g.Cylinder.Height)
+			sc.length(&g.Cylinder.Radii[0])
+			sc.length(&g.Cylinder.Radii[1])
+		}
+		if g.Capsule != nil {
+			sc.length(&g.Capsule.Height)
+			sc.lengths(&g.Capsule.Radii)
+		}
+		if obj.Density != nil {
+			obj.Density.F /= sc.scale * sc.scale * sc.scale
+			me.setNormed(sc, obj.Density)
+		}
+		if sc.rotates && ((g.Box != nil) || (g.Cylinder != nil) || (g.Capsule != nil)) {
+			//	these shapes are aligned to their local axes, so re-orient them like the node contents in norm_NodeDef()
+			obj.Transforms = append(obj.Transforms, &cdom.Transform{Kind: cdom.TransformKindMatrix, F: sc.matRot()})
+		}
+	}
+}
+
+func (me *importState) norm_Transform(xn *xmlx.Node, obj *cdom.Transform) {
+	if sc := me.normScope(xn); sc != nil {
+		sc.transform(obj.Kind, obj.F)
+		me.setNormed(sc, obj)
+	}
+}
+
+func (me *normScope) transform(kind cdom.TransformKind, f []float64) {
+	switch kind {
+	case cdom.TransformKindLookat:
+		if len(f) >= 9 {
+			me.point(f[0:3])
+			me.point(f[3:6])
+			me.dir(f[6:9])
+		}
+	case cdom.TransformKindMatrix:
+		me.mat4(f)
+	case cdom.TransformKindRotate:
+		me.dir(f)
+	case cdom.TransformKindScale:
+		me.permute(f, 1)
+	case cdom.TransformKindSkew:
+		if len(f) >= 7 {
+			me.dir(f[1:4])
+			me.dir(f[4:7])
+		}
+	case cdom.TransformKindTranslate:
+		me.point(f)
+	}
+}
+
+func normSourceFloats(src *cdom.Source, n uint64, conv func([]float64)) {
+	acc, fl := src.TC.Accessor, src.Array.Floats
+	if acc.Stride >= n {
+		for i, pos := uint64(0), acc.Offset; (i < acc.Count) && ((pos + n) <= uint64(len(fl))); i, pos = i+1, pos+acc.Stride {
+			conv(fl[pos : pos+n])
+		}
+	}
+}
+
+//	Returns the Sid path s, its one selector sel replaced with one that selects the value at index i of xf:
+//	a member name if sel was one and xf has a member for i, else an array index like that of sel.
+func normSidTarget(s string, sel cdom.SidSelector, xf *cdom.Transform, i int) string {
+	pos := len(s)
+	for j := 0; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '/':
+			pos = len(s)
+		case '.', '(':
+			if pos == len(s) {
+				pos = j
+			}
+		}
+	}
+	if len(sel.Member) > 0 {
+		for _, name := range []string{"X", "Y", "Z", "ANGLE"} {
+			if xf.AccessField(name) == &xf.F[i] {
+				return s[:pos] + "." + name
+			}
+		}
+	}
+	if len(sel.Index) > 1 {
+		return s[:pos] + fmt.Sprintf("(%d)(%d)", i/4, i%4)
+	}
+	return s[:pos] + fmt.Sprintf("(%d)", i)
+}
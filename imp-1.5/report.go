@@ -0,0 +1,295 @@
+package collimp
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	"github.com/metaleap/go-util/str"
+)
+
+//	Categorizes an ImportDiag.
+type ImportDiagSeverity int
+
+const (
+	//	Purely informational, such as external references that were not followed.
+	ImportDiagInfo ImportDiagSeverity = iota
+
+	//	Something is off but the import could proceed sensibly, such as unresolvable references
+	//	or unknown elements. Fails the import only if ImportBag.WarningsFail is set.
+	ImportDiagWarning
+
+	//	The imported data is broken, such as malformed numeric arrays or out-of-range indices.
+	//	Always fails the import.
+	ImportDiagError
+)
+
+//	Returns "INFO", "WARNING" or "ERROR".
+func (me ImportDiagSeverity) String() string {
+	switch me {
+	case ImportDiagWarning:
+		return "WARNING"
+	case ImportDiagError:
+		return "ERROR"
+	}
+	return "INFO"
+}
+
+//	A single diagnostic collected during import.
+type ImportDiag struct {
+	//	How severe this diagnostic is.
+	Severity ImportDiagSeverity
+
+	//	The path of the document in which this diagnostic was collected, as passed to ImportFile() or
+	//	ImportReader() or to ImportBag.Resolver. Empty for the document being imported if its path is not known.
+	Doc string
+
+	//	The XML path of the offending element, such as "/COLLADA/library_geometries/geometry[@id='g1']/mesh".
+	Path string
+
+	//	The line number in the source document, or 0 if not known. Available for diagnostics that originate
+	//	in the XML parser and, if the document was streamed (as by ImportColladaStream(), ImportReader() or
+	//	ImportFile()), for those about an element: then, it is the line on which the start tag of Path ends.
+	Line int
+
+	//	Describes the issue.
+	Msg string
+}
+
+//	Returns a human-readable single-line representation of me.
+func (me *ImportDiag) String() (s string) {
+	s = me.Severity.String()
+	if len(me.Doc) > 0 {
+		s += " " + me.Doc
+	}
+	if me.Line > 0 {
+		s += fmt.Sprintf(" line %d", me.Line)
+	}
+	if len(me.Path) > 0 {
+		s += " " + me.Path
+	}
+	return s + ": " + me.Msg
+}
+
+//	Collects all diagnostics of an import.
+//	Returned by ImportColladaReport(), and also returned as the error of a failed import.
+type ImportReport struct {
+	//	All diagnostics, in the order in which they were collected.
+	Diags []*ImportDiag
+
+	warningsFail bool
+}
+
+func newImportReport(bag *ImportBag) (me *ImportReport) {
+	me = &ImportReport{warningsFail: bag.WarningsFail}
+	return
+}
+
+//	Adds a new ImportDiag to me and returns it.
+func (me *ImportReport) Add(severity ImportDiagSeverity, path string, line int, msg string) (diag *ImportDiag) {
+	diag = &ImportDiag{Severity: severity, Path: path, Line: line, Msg: msg}
+	me.Diags = append(me.Diags, diag)
+	return
+}
+
+//	Returns the number of diagnostics in me with the specified severity.
+func (me *ImportReport) Count(severity ImportDiagSeverity) (n int) {
+	for _, diag := range me.Diags {
+		if diag.Severity == severity {
+			n++
+		}
+	}
+	return
+}
+
+//	Returns all diagnostics, one per line.
+func (me *ImportReport) Error() string {
+	lines := make([]string, len(me.Diags))
+	for i, diag := range me.Diags {
+		lines[i] = diag.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+//	Returns true if me contains errors, or warnings while ImportBag.WarningsFail was set.
+func (me *ImportReport) Failed() bool {
+	return (me.Count(ImportDiagError) > 0) || (me.warningsFail && (me.Count(ImportDiagWarning) > 0))
+}
+
+var (
+	knownChildren = map[string][]string{
+		"COLLADA":        []string{"asset", "scene", "extra"},
+		"asset":          []string{"contributor", "coverage", "created", "keywords", "modified", "revision", "subject", "title", "unit", "up_axis", "extra"},
+		"geometry":       []string{"asset", "convex_mesh", "mesh", "spline", "brep", "extra"},
+		"mesh":           []string{"source", "vertices", "lines", "linestrips", "polygons", "polylist", "triangles", "trifans", "tristrips", "extra"},
+		"convex_mesh":    []string{"source", "vertices", "lines", "linestrips", "polygons", "polylist", "triangles", "trifans", "tristrips", "extra"},
+		"source":         []string{"asset", "bool_array", "float_array", "IDREF_array", "int_array", "Name_array", "SIDREF_array", "token_array", "technique_common", "technique"},
+		"vertices":       []string{"input", "extra"},
+		"lines":          []string{"input", "p", "extra"},
+		"linestrips":     []string{"input", "p", "extra"},
+		"polygons":       []string{"input", "p", "ph", "extra"},
+		"polylist":       []string{"input", "vcount", "p", "extra"},
+		"triangles":      []string{"input", "p", "extra"},
+		"trifans":        []string{"input", "p", "extra"},
+		"tristrips":      []string{"input", "p", "extra"},
+		"node":           []string{"asset", "lookat", "matrix", "rotate", "scale", "skew", "translate", "instance_camera", "instance_controller", "instance_geometry", "instance_light", "instance_node", "node", "extra"},
+		"visual_scene":   []string{"asset", "node", "evaluate_scene", "extra"},
+		"scene":          []string{"instance_physics_scene", "instance_visual_scene", "instance_kinematics_scene", "extra"},
+		"skin":           []string{"bind_shape_matrix", "source", "joints", "vertex_weights", "extra"},
+		"vertex_weights": []string{"input", "vcount", "v", "extra"},
+	}
+)
+
+func init() {
+	for _, lm := range []string{"animation_clips", "animations", "articulated_systems", "cameras", "controllers", "effects", "force_fields", "formulas", "geometries", "images", "joints", "kinematics_models", "kinematics_scenes", "lights", "materials", "nodes", "physics_materials", "physics_models", "physics_scenes", "visual_scenes"} {
+		knownChildren["COLLADA"] = append(knownChildren["COLLADA"], "library_"+lm)
+	}
+	for lib, def := range map[string]string{"animation_clips": "animation_clip", "animations": "animation", "articulated_systems": "articulated_system", "cameras": "camera", "controllers": "controller", "effects": "effect", "force_fields": "force_field", "formulas": "formula", "geometries": "geometry", "images": "image", "joints": "joint", "kinematics_models": "kinematics_model", "kinematics_scenes": "kinematics_scene", "lights": "light", "materials": "material", "nodes": "node", "physics_materials": "physics_material", "physics_models": "physics_model", "physics_scenes": "physics_scene", "visual_scenes": "visual_scene"} {
+		knownChildren["library_"+lib] = []string{"asset", def, "extra"}
+	}
+}
+
+func (me *importState) check_Element(xn, cn *xmlx.Node) {
+	if known, ok := knownChildren[xn.Name.Local]; ok && !ustr.IsOneOf(cn.Name.Local, known...) {
+		me.diagf(ImportDiagWarning, cn, "unknown element <%s> in <%s>", cn.Name.Local, xn.Name.Local)
+	}
+	if (cn.Name.Local != "extra") && (cn.Name.Local != "technique") {
+		me.check_Elements(cn)
+	}
+}
+
+func (me *importState) check_Elements(xn *xmlx.Node) {
+	for _, cn := range xn.Children {
+		if cn.Type == xmlx.NT_ELEMENT {
+			me.check_Element(xn, cn)
+		}
+	}
+}
+
+func (me *importState) check_IndexedInputs(xn *xmlx.Node, ii *cdom.IndexedInputs, counts func(*cdom.InputShared) (uint64, bool)) {
+	stride := ii.Stride()
+	if (stride == 0) || (len(ii.Indices) == 0) {
+		return
+	}
+	if (uint64(len(ii.Indices)) % stride) != 0 {
+		me.diagf(ImportDiagWarning, xn, "%d indices are not a multiple of the %d inputs per vertex", len(ii.Indices), stride)
+	}
+	for _, in := range ii.Inputs {
+		if count, ok := counts(in); ok {
+			for i := in.Offset; i < uint64(len(ii.Indices)); i += stride {
+				if ii.Indices[i] >= count {
+					me.diagf(ImportDiagError, xn, "index %d for input '%s' out of range: source '%s' has %d elements", ii.Indices[i], in.Semantic, in.Source, count)
+					break
+				}
+			}
+		}
+	}
+}
+
+func (me *importState) check_Mesh(xn *xmlx.Node, obj *cdom.GeometryMesh) {
+	srcCount := func(id cdom.RefId) (count uint64, ok bool) {
+		if src := obj.Sources[id.S()]; (src != nil) && (src.TC.Accessor != nil) {
+			count, ok = src.TC.Accessor.Count, true
+		}
+		return
+	}
+	counts := func(in *cdom.InputShared) (count uint64, ok bool) {
+		if (in.Semantic == "VERTEX") && (obj.Vertices != nil) && (obj.Vertices.Id == in.Source.S()) {
+			for _, vin := range obj.Vertices.Inputs {
+				if vin.Semantic == "POSITION" {
+					return srcCount(vin.Source)
+				}
+			}
+			return
+		}
+		return srcCount(in.Source)
+	}
+	pns := xcns(xn, "lines", "linestrips", "polygons", "polylist", "triangles", "trifans", "tristrips")
+	for i, p := range obj.Primitives {
+		if i < len(pns) {
+			me.check_IndexedInputs(pns[i], &p.IndexedInputs, counts)
+		}
+	}
+}
+
+func (me *importState) check_Refs(ids map[string]bool) {
+	me.docs[me.docPath] = &importDoc{ids: ids, renamed: me.renamed}
+	for id, newId := range me.renamed {
+		if ids[newId] {
+			me.diagf(ImportDiagError, nil, "id '%s' was renamed to '%s', which is also used by this document", id, newId)
+		}
+	}
+	for _, ref := range me.refs {
+		if ref.isExternal() {
+			me.resolveRef(ref)
+		} else if (!strings.Contains(ref.id, "/")) && !ids[ref.id] {
+			me.diagRef(ImportDiagWarning, ref, "unresolvable reference '%s'")
+		}
+	}
+}
+
+func (me *importRef) isExternal() bool {
+	return strings.Contains(me.id, "#") || strings.Contains(me.id, "://")
+}
+
+func collectIds(xn *xmlx.Node, ids map[string]bool) {
+	if id := xas(xn, "id"); len(id) > 0 {
+		ids[id] = true
+	}
+	for _, cn := range xn.Children {
+		if cn.Type == xmlx.NT_ELEMENT {
+			collectIds(cn, ids)
+		}
+	}
+}
+
+func (me *importState) diagErr(err error) {
+	line := 0
+	if se, ok := err.(*xml.SyntaxError); ok {
+		line = se.Line
+	}
+	me.logDiag(me.report.Add(ImportDiagError, "", line, err.Error()))
+}
+
+func (me *importState) diagMalformed(xn *xmlx.Node, vals []string, bad []int) {
+	if len(bad) > 0 {
+		me.diagMalformedN(xn, len(bad), vals[bad[0]], bad[0])
+	}
+}
+
+func (me *importState) diagMalformedN(xn *xmlx.Node, numBad int, firstBad string, firstPos int) {
+	me.diagf(ImportDiagError, xn, "%d malformed value(s) in <%s>, the first one being '%s' at position %d", numBad, xn.Name.Local, firstBad, firstPos)
+}
+
+func (me *importState) diagRef(severity ImportDiagSeverity, ref importRef, format string, args ...interface{}) {
+	path, line := ref.path, ref.line
+	if ref.xn != nil {
+		path, line = xpath(ref.xn), me.lines[ref.xn]
+	}
+	me.logDiag(me.report.Add(severity, path, line, fmt.Sprintf(format, append([]interface{}{ref.id}, args...)...)))
+}
+
+func (me *importState) diagf(severity ImportDiagSeverity, xn *xmlx.Node, format string, args ...interface{}) {
+	me.logDiag(me.report.Add(severity, xpath(xn), me.lines[xn], fmt.Sprintf(format, args...)))
+}
+
+func (me *importState) logDiag(diag *ImportDiag) {
+	diag.Doc = me.docPath
+	if me.bag.Log != nil {
+		me.bag.Log("%s\n", diag)
+	}
+}
+
+func xpath(xn *xmlx.Node) (path string) {
+	for ; (xn != nil) && (xn.Type == xmlx.NT_ELEMENT); xn = xn.Parent {
+		if id := xas(xn, "id"); len(id) > 0 {
+			path = fmt.Sprintf("/%s[@id='%s']%s", xn.Name.Local, id, path)
+		} else {
+			path = "/" + xn.Name.Local + path
+		}
+	}
+	return
+}
@@ -0,0 +1,161 @@
+package collimp
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+)
+
+type importDoc struct {
+	ids    map[string]bool
+	failed bool
+
+	//	the Ids (keys) that were renamed (values) because previously imported documents already used them
+	renamed map[string]string
+}
+
+//	Returns an ImportBag.Resolver that opens external documents in fsys.
+//	Note, fsys.Open() only accepts unrooted paths that do not leave fsys via "..".
+func FSResolver(fsys fs.FS) func(string) (io.ReadCloser, error) {
+	return func(docPath string) (io.ReadCloser, error) {
+		return fsys.Open(docPath)
+	}
+}
+
+//	Imports the Collada document at filePath, like ImportReader().
+//	If importBag.Resolver is nil, external documents are opened relative to filePath via os.Open().
+func ImportFile(filePath string, importBag *ImportBag) (doc *cdom.Document, report *ImportReport, err error) {
+	var file *os.File
+	if importBag == nil {
+		importBag = NewImportBag()
+	}
+	me := newImportState(importBag)
+	if me.docPath = filepath.ToSlash(filepath.Clean(filePath)); me.resolve == nil {
+		me.resolve = openFile
+	}
+	if file, err = os.Open(filePath); err != nil {
+		me.diagErr(err)
+	} else {
+		defer file.Close()
+		doc = me.importStream(file)
+	}
+	return me.result(doc)
+}
+
+//	Imports the Collada document read from r, like ImportColladaStream(). If importBag.Resolver is set,
+//	docPath is the path of the document being read, as understood by importBag.Resolver, against which
+//	references into external documents are resolved; otherwise, docPath is only used in diagnostics.
+func ImportReader(r io.Reader, docPath string, importBag *ImportBag) (doc *cdom.Document, report *ImportReport, err error) {
+	if importBag == nil {
+		importBag = NewImportBag()
+	}
+	me := newImportState(importBag)
+	if len(docPath) > 0 {
+		me.docPath = path.Clean(docPath)
+	}
+	return me.result(me.importStream(r))
+}
+
+func openFile(docPath string) (io.ReadCloser, error) {
+	return os.Open(filepath.FromSlash(docPath))
+}
+
+func (me *importState) importExternal(ref importRef, docPath string) (ext *importDoc) {
+	rc, err := me.resolve(docPath)
+	if err == nil {
+		sub := newImportState(me.bag)
+		sub.reg, sub.report, sub.docs, sub.docPath, sub.resolve = me.reg, me.report, me.docs, docPath, me.resolve
+		sub.taken, sub.renamed = map[string]bool{}, map[string]string{}
+		for _, doc := range me.docs {
+			for id := range doc.ids {
+				if newId := doc.renamed[id]; len(newId) > 0 {
+					id = newId
+				}
+				sub.taken[id] = true
+			}
+		}
+		sub.importStream(rc)
+		rc.Close()
+	}
+	if ext = me.docs[docPath]; ext == nil {
+		ext = &importDoc{failed: true}
+		me.docs[docPath] = ext
+	}
+	if err != nil {
+		me.diagRef(ImportDiagWarning, ref, "external reference '%s' not resolved: %s", err)
+	}
+	return
+}
+
+func (me *importState) resolveRef(ref importRef) {
+	docPath, id := ref.id, ""
+	if pos := strings.LastIndex(docPath, "#"); pos >= 0 {
+		docPath, id = docPath[:pos], docPath[pos+1:]
+	}
+	if docPath = strings.TrimPrefix(docPath, "file://"); (me.resolve == nil) || (ref.ref == nil) || strings.Contains(docPath, "://") {
+		me.diagRef(ImportDiagInfo, ref, "external reference '%s' not resolved")
+		return
+	}
+	if p, err := url.PathUnescape(docPath); err == nil {
+		docPath = p
+	}
+	if !path.IsAbs(docPath) {
+		docPath = path.Join(path.Dir(me.docPath), docPath)
+	}
+	ext := me.docs[docPath]
+	if ext == nil {
+		ext = me.importExternal(ref, docPath)
+	}
+	if (!ext.failed) && (len(id) > 0) {
+		if (!strings.Contains(id, "/")) && !ext.ids[id] {
+			me.diagRef(ImportDiagWarning, ref, "unresolvable reference '%s'")
+		} else {
+			ref.ref.SetIdRef(renameRef(id, ext.renamed))
+		}
+	}
+}
+
+//	Returns ref (an Id, optionally followed by a Sid path such as "/sid.X") with the Id at its start replaced as per renamed.
+func renameRef(ref string, renamed map[string]string) string {
+	id := ref
+	if pos := strings.Index(ref, "/"); pos >= 0 {
+		id = ref[:pos]
+	}
+	if newId := renamed[id]; len(newId) > 0 {
+		return newId + ref[len(id):]
+	}
+	return ref
+}
+
+//	Returns id, unless an external document is being imported and a previously imported document already uses
+//	id: then, returns the Id that replaces id in the document being imported (such as "geom-2" for "geom").
+func (me *importState) xid(xn *xmlx.Node, id string) (newId string) {
+	if newId = id; me.taken[id] {
+		if newId = me.renamed[id]; len(newId) == 0 {
+			for n := 2; (len(newId) == 0) || me.taken[newId]; n++ {
+				newId = fmt.Sprintf("%s-%d", id, n)
+			}
+			me.renamed[id] = newId
+			me.diagf(ImportDiagWarning, xn, "id '%s' is already used by another document, renamed to '%s'", id, newId)
+		}
+	}
+	return
+}
+
+//	Returns the Sid path ref with the Id before its first "/" replaced as per xid(). A Sid path without
+//	any "/" is relative (as in "./"+ref) rather than starting with an Id, so it is returned as-is.
+func (me *importState) xref(xn *xmlx.Node, ref string) string {
+	if pos := strings.Index(ref, "/"); (pos > 0) && (len(me.taken) > 0) {
+		return me.xid(xn, ref[:pos]) + ref[pos:]
+	}
+	return ref
+}
@@ -0,0 +1,397 @@
+package collimp
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	xmlx "github.com/go-forks/go-pkg-xmlx"
+
+	cdom "github.com/metaleap/go-collada/dom"
+	"github.com/metaleap/go-util"
+)
+
+const (
+	streamFloats = iota + 1
+	streamInts
+	streamUints
+)
+
+var (
+	//	The elements whose contents are parsed straight into numbers by ImportColladaStream().
+	//	Each one is read only by the corresponding list_Floats(), list_Ints() or list_Uints().
+	streamListKinds = map[string]int{"float_array": streamFloats, "int_array": streamInts, "vcount": streamInts, "p": streamUints, "h": streamUints, "v": streamInts}
+)
+
+//	Like ImportColladaReport(), but reads the Collada document incrementally from r instead of
+//	loading it into memory in its entirety first. The contents of every <library_*> element are
+//	imported and then discarded one resource definition at a time, and the contents of all
+//	<float_array>, <int_array>, <p>, <h>, <v> and <vcount> elements are parsed straight into numbers.
+//	The results are the same as with ImportColladaReport(), except that the diagnostics in report
+//	may be collected in a different order.
+//	Only Collada 1.5 documents can be streamed. Any other document (or if importBag.ConvOptions.Force
+//	is set) first needs to be converted in-memory: in that case, r is read in its entirety and handed
+//	to ImportColladaReport().
+func ImportColladaStream(r io.Reader, importBag *ImportBag) (doc *cdom.Document, report *ImportReport, err error) {
+	if importBag == nil {
+		importBag = NewImportBag()
+	}
+	me := newImportState(importBag)
+	return me.result(me.importStream(r))
+}
+
+func (me *importState) importStream(r io.Reader) (doc *cdom.Document) {
+	var (
+		prefix bytes.Buffer
+		tok    xml.Token
+		root   *xmlx.Node
+		err    error
+	)
+	tee := &streamTee{r: r, buf: &prefix}
+	dec := xml.NewDecoder(tee)
+	for (root == nil) && (err == nil) {
+		if tok, err = dec.Token(); err == nil {
+			if se, ok := tok.(xml.StartElement); ok {
+				me.lines = map[*xmlx.Node]int{}
+				root = me.newStreamNode(dec, se)
+			}
+		}
+	}
+	if err == io.EOF {
+		me.diagf(ImportDiagError, nil, "no <COLLADA> root element")
+	} else if err != nil {
+		me.diagErr(err)
+	} else if !me.canStream(root) {
+		var rest []byte
+		tee.buf = nil
+		if rest, err = ioutil.ReadAll(r); err != nil {
+			me.diagErr(err)
+		} else {
+			doc = me.importBytes(append(prefix.Bytes(), rest...))
+		}
+	} else {
+		tee.buf = nil
+		prefix.Reset()
+		if doc, err = me.stream_Document(dec, root); err != nil {
+			doc = nil
+			me.diagErr(err)
+		}
+	}
+	return
+}
+
+func (me *importState) canStream(root *xmlx.Node) bool {
+	if convOpt := me.convOptions(); (root.Name.Local == "COLLADA") && !convOpt.Force {
+		_, ver := umisc.ParseVersion(xas(root, "version"))
+		return ver >= 1.5
+	}
+	return false
+}
+
+func (me *importState) flushRefs(ids map[string]bool) {
+	refs := me.refs[:0]
+	for _, ref := range me.refs {
+		if ref.isExternal() || ((!strings.Contains(ref.id, "/")) && !ids[ref.id]) {
+			if ref.xn != nil {
+				ref.path, ref.line, ref.xn = xpath(ref.xn), me.lines[ref.xn], nil
+			}
+			refs = append(refs, ref)
+		}
+	}
+	for i := len(refs); i < len(me.refs); i++ {
+		me.refs[i] = importRef{}
+	}
+	me.refs = refs
+}
+
+func (me *importState) newStreamList(xn *xmlx.Node) (sl *streamList) {
+	if kind := streamListKinds[xn.Name.Local]; kind > 0 {
+		capacity := me.xau64(xn, "count")
+		if max := uint64(me.bag.MaxArraySize); (max > 0) && (capacity > max) {
+			capacity = max
+		}
+		if capacity > (1 << 24) {
+			capacity = 1 << 24
+		}
+		sl = &streamList{kind: kind, max: me.bag.MaxArraySize}
+		switch kind {
+		case streamFloats:
+			sl.floats = make([]float64, 0, capacity)
+		case streamInts:
+			sl.ints = make([]int64, 0, capacity)
+		case streamUints:
+			sl.uints = make([]uint64, 0, capacity)
+		}
+		me.lists[xn] = sl
+	}
+	return
+}
+
+func (me *importState) streamExtras(extras []*cdom.Extra, xn *xmlx.Node) []*cdom.Extra {
+	if !me.bag.IgnoreExtras {
+		if xn.Name.Local == "extra" {
+			return append(extras, me.obj_Extra(xn, ""))
+		}
+		extras = append(extras, me.objs_Extra(xn, "extra")...)
+	}
+	return extras
+}
+
+//	Reports the diagnostics that list_Floats(), list_Ints() or list_Uints() would report for xn.
+func (me *importState) streamed(xn *xmlx.Node, sl *streamList) *streamList {
+	if (me.bag.MaxArraySize > 0) && !me.arraySizeOk(xn, uint64(sl.n)) {
+		return sl
+	}
+	if sl.numBad > 0 {
+		me.diagMalformedN(xn, sl.numBad, sl.firstBad, sl.firstPos)
+	}
+	return sl
+}
+
+func (me *importState) stream_Document(dec *xml.Decoder, root *xmlx.Node) (doc *cdom.Document, err error) {
+	var (
+		tok    xml.Token
+		cn     *xmlx.Node
+		extras []*cdom.Extra
+	)
+	ids := map[string]bool{}
+	collectIds(root, ids)
+	me.lists = map[*xmlx.Node]*streamList{}
+	for {
+		if tok, err = dec.Token(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if libs := libsByKind[strings.TrimPrefix(t.Name.Local, "library_")]; (libs != nil) && strings.HasPrefix(t.Name.Local, "library_") {
+				if extras, err = me.stream_Library(dec, t, root, libs, ids, extras); err != nil {
+					return
+				}
+			} else {
+				if cn, err = me.stream_Node(dec, t, false); err != nil {
+					return
+				}
+				root.AddChild(cn)
+				collectIds(cn, ids)
+				extras = me.streamExtras(extras, cn)
+			}
+		case xml.EndElement:
+			doc = me.obj_Document(root, "")
+			doc.Registry = me.reg
+			if !me.bag.IgnoreExtras {
+				doc.Extras = extras
+			}
+			me.norm_Animations()
+			me.check_Elements(root)
+			me.check_Refs(ids)
+			return
+		}
+	}
+}
+
+//	Imports the resource definitions of a <library_*> element one at a time: each one is attached
+//	to the otherwise-empty library node (so that libs() finds it, and so that it can see the <asset>
+//	of both the library and the document root), imported, and then detached again.
+func (me *importState) stream_Library(dec *xml.Decoder, se xml.StartElement, root *xmlx.Node, libs func(*importState, *xmlx.Node), ids map[string]bool, extras []*cdom.Extra) (_ []*cdom.Extra, err error) {
+	var (
+		tok      xml.Token
+		dn       *xmlx.Node
+		imported bool
+	)
+	ln := me.newStreamNode(dec, se)
+	root.AddChild(ln)
+	defer root.RemoveChild(ln)
+	collectIds(ln, ids)
+	for {
+		if tok, err = dec.Token(); err != nil {
+			return extras, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if dn, err = me.stream_Node(dec, t, false); err != nil {
+				return extras, err
+			}
+			ln.AddChild(dn)
+			me.check_Element(ln, dn)
+			collectIds(dn, ids)
+			extras = me.streamExtras(extras, dn)
+			if dn.Name.Local != "asset" {
+				libs(me, root)
+				imported = true
+				me.flushRefs(ids)
+				ln.RemoveChild(dn)
+				me.forgetLines(dn)
+				me.lists = map[*xmlx.Node]*streamList{}
+				if len(me.scopes) > 0 {
+					me.scopes = map[*xmlx.Node]*normScope{}
+				}
+			}
+		case xml.EndElement:
+			if !imported {
+				libs(me, root)
+			}
+			return extras, nil
+		}
+	}
+}
+
+//	Reads the element started by se, including all its descendants, from dec.
+//	Contents of <extra> and <technique> elements are always kept as-is (raw),
+//	since they are imported in their XML form rather than being parsed.
+//	Like the in-memory import, the text of an element is trimmed only as a whole, so that
+//	any comments or processing instructions within it do not swallow the whitespace around them.
+func (me *importState) stream_Node(dec *xml.Decoder, se xml.StartElement, raw bool) (xn *xmlx.Node, err error) {
+	var (
+		tok  xml.Token
+		cn   *xmlx.Node
+		sl   *streamList
+		text []byte
+	)
+	xn = me.newStreamNode(dec, se)
+	if raw = raw || (se.Name.Local == "extra") || (se.Name.Local == "technique"); !raw {
+		sl = me.newStreamList(xn)
+	}
+	for {
+		if tok, err = dec.Token(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if cn, err = me.stream_Node(dec, t, raw); err != nil {
+				return
+			}
+			xn.AddChild(cn)
+		case xml.CharData:
+			if sl != nil {
+				sl.write(t)
+			} else {
+				text = append(text, t...)
+			}
+		case xml.EndElement:
+			if sl != nil {
+				sl.finish()
+			} else {
+				xn.Value = strings.TrimSpace(string(text))
+			}
+			return
+		}
+	}
+}
+
+//	Returns a new node for the element started by se, the line of which (as just read from dec) is recorded in me.lines.
+func (me *importState) newStreamNode(dec *xml.Decoder, se xml.StartElement) (xn *xmlx.Node) {
+	xn = xmlx.NewNode(xmlx.NT_ELEMENT)
+	xn.Name = se.Name
+	xn.Attributes = make([]*xmlx.Attr, len(se.Attr))
+	for i, att := range se.Attr {
+		xn.Attributes[i] = &xmlx.Attr{Name: att.Name, Value: att.Value}
+	}
+	me.lines[xn], _ = dec.InputPos()
+	return
+}
+
+//	Removes the lines of xn and all its descendants from me.lines, once xn has been imported and discarded.
+func (me *importState) forgetLines(xn *xmlx.Node) {
+	delete(me.lines, xn)
+	for _, cn := range xn.Children {
+		me.forgetLines(cn)
+	}
+}
+
+//	The numbers parsed from the contents of a single element by ImportColladaStream().
+type streamList struct {
+	kind, max, n int
+	floats       []float64
+	ints         []int64
+	uints        []uint64
+
+	numBad, firstPos int
+	firstBad         string
+
+	//	The beginning of a value cut off at the end of the previous xml.CharData.
+	tail []byte
+}
+
+func (me *streamList) add(val []byte) {
+	var err error
+	if me.n++; (me.max > 0) && (me.n > me.max) {
+		return
+	}
+	switch me.kind {
+	case streamFloats:
+		var f float64
+		if f, err = strconv.ParseFloat(string(val), 64); err != nil {
+			f = 0
+		}
+		me.floats = append(me.floats, f)
+	case streamInts:
+		var i int64
+		if i, err = strconv.ParseInt(string(val), 10, 64); err != nil {
+			i = 0
+		}
+		me.ints = append(me.ints, i)
+	case streamUints:
+		var u uint64
+		if u, err = strconv.ParseUint(string(val), 10, 64); err != nil {
+			u = 0
+		}
+		me.uints = append(me.uints, u)
+	}
+	if err != nil {
+		if me.numBad++; me.numBad == 1 {
+			me.firstBad, me.firstPos = string(val), me.n-1
+		}
+	}
+}
+
+func (me *streamList) finish() {
+	if len(me.tail) > 0 {
+		me.add(me.tail)
+		me.tail = nil
+	}
+	if (me.max > 0) && (me.n > me.max) {
+		me.floats, me.ints, me.uints, me.numBad = me.floats[:0], me.ints[:0], me.uints[:0], 0
+	}
+}
+
+func (me *streamList) write(data []byte) {
+	start := 0
+	for i, b := range data {
+		if (b == ' ') || (b == '\n') || (b == '\r') || (b == '\t') || (b == '\v') || (b == '\f') {
+			if len(me.tail) > 0 {
+				me.tail = append(me.tail, data[start:i]...)
+				me.add(me.tail)
+				me.tail = me.tail[:0]
+			} else if i > start {
+				me.add(data[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(data) {
+		me.tail = append(me.tail, data[start:]...)
+	}
+}
+
+//	Copies everything read from r into buf (until buf is set to nil), so that a document
+//	that cannot be streamed can be handed to ImportColladaReport() in its entirety.
+type streamTee struct {
+	r   io.Reader
+	buf *bytes.Buffer
+}
+
+func (me *streamTee) Read(p []byte) (n int, err error) {
+	if n, err = me.r.Read(p); (n > 0) && (me.buf != nil) {
+		me.buf.Write(p[:n])
+	}
+	return
+}